@@ -49,12 +49,12 @@ def main():
         alien_img = create_alien_face(100)
 
         # Save as PNG
-        alien_img.save('alien.png', 'PNG')
-        print("✅ Created alien.png successfully!")
+        alien_img.save('pkg/assets/images/alien.png', 'PNG')
+        print("✅ Created pkg/assets/images/alien.png successfully!")
 
     except ImportError:
         print("❌ PIL (Pillow) not installed. Please install with: pip install Pillow")
-        print("Or you can manually create an alien.png file and place it in the project directory.")
+        print("Or you can manually create an alien.png file and place it in your asset directory (BOUNCING_BALLS_ASSETS).")
         sys.exit(1)
     except Exception as e:
         print(f"❌ Error creating alien.png: {e}")
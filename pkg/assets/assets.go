@@ -0,0 +1,60 @@
+// Package assets provides the game's image assets: defaults embedded in the binary, which a user asset
+// directory can override file by file.
+package assets
+
+import (
+	"embed"
+	"os"
+	"path/filepath"
+
+	"fyne.io/fyne/v2"
+)
+
+// Asset names
+const (
+	AlienImage = "alien.png" // face of the drifting aliens
+	HumanImage = "human.png" // human portrait
+)
+
+//go:embed images/*.png
+var embedded embed.FS
+
+// Registry looks up assets by name, preferring a file in the user asset directory over the embedded default
+type Registry struct {
+	Dir       string                   // user asset directory ("" = embedded assets only)
+	resources map[string]fyne.Resource // assets already loaded, by name
+}
+
+// NewRegistry creates a registry that checks dir for overrides before the embedded assets
+func NewRegistry(dir string) *Registry {
+	return &Registry{Dir: dir, resources: make(map[string]fyne.Resource)}
+}
+
+// Resource returns the named asset, loading it the first time it is asked for
+func (r *Registry) Resource(name string) (fyne.Resource, error) {
+	if resource, ok := r.resources[name]; ok {
+		return resource, nil
+	}
+
+	data, err := r.read(name)
+	if err != nil {
+		return nil, err
+	}
+	resource := fyne.NewStaticResource(name, data)
+	r.resources[name] = resource
+	return resource, nil
+}
+
+// read returns the asset's bytes from the user asset directory, or the embedded copy if it has none
+func (r *Registry) read(name string) ([]byte, error) {
+	if r.Dir != "" {
+		data, err := os.ReadFile(filepath.Join(r.Dir, name))
+		if err == nil {
+			return data, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return embedded.ReadFile("images/" + name)
+}
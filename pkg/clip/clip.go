@@ -0,0 +1,154 @@
+// Package clip keeps the last few seconds of the game as a ring buffer of captured frames and exports them
+// as an animated GIF, for sharing.
+package clip
+
+import (
+	"errors"
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"io"
+	"sync"
+)
+
+// Recording defaults
+const (
+	DefaultSeconds = 10 // how much of the game a clip covers
+	DefaultFPS     = 10 // frames per second kept (of the game's 60)
+	DefaultScale   = 2  // frames are shrunk by this factor to keep the buffer small
+)
+
+// ErrEmpty is returned when exporting before any frame has been captured
+var ErrEmpty = errors.New("clip: nothing recorded yet")
+
+// Recorder keeps the most recent frames in a ring buffer. Capture and export may run on different goroutines.
+type Recorder struct {
+	FPS   int // frames kept per second of game time
+	Scale int // frames are shrunk by this factor when they are captured
+
+	mu     sync.Mutex
+	frames []*image.RGBA // ring buffer, reused once full
+	next   int           // slot the next frame goes in
+	count  int           // frames in the buffer
+	tick   int           // game frames since the last capture
+}
+
+// NewRecorder creates a recorder keeping the last seconds of the game at fps frames per second
+func NewRecorder(seconds, fps, scale int) *Recorder {
+	return &Recorder{
+		FPS:    fps,
+		Scale:  max(scale, 1),
+		frames: make([]*image.RGBA, seconds*fps),
+	}
+}
+
+// Due counts one game frame and reports whether a capture is due, assuming the game runs at 60 frames per second
+func (r *Recorder) Due() bool {
+	if r == nil || len(r.frames) == 0 {
+		return false
+	}
+	r.tick++
+	if r.tick < 60/r.FPS {
+		return false
+	}
+	r.tick = 0
+	return true
+}
+
+// Add shrinks a captured frame and stores it, replacing the oldest frame once the buffer is full
+func (r *Recorder) Add(img image.Image) {
+	if r == nil || img == nil || len(r.frames) == 0 {
+		return
+	}
+	bounds := img.Bounds()
+	width, height := bounds.Dx()/r.Scale, bounds.Dy()/r.Scale
+	if width == 0 || height == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Reuse the slot's image unless the window has changed size
+	frame := r.frames[r.next]
+	if frame == nil || frame.Rect.Dx() != width || frame.Rect.Dy() != height {
+		frame = image.NewRGBA(image.Rect(0, 0, width, height))
+	}
+	shrink(frame, img, r.Scale)
+
+	r.frames[r.next] = frame
+	r.next = (r.next + 1) % len(r.frames)
+	r.count = min(r.count+1, len(r.frames))
+}
+
+// shrink fills dst with every scale-th pixel of src (nearest neighbor). RGBA sources are copied straight out
+// of their pixel rows; other images (such as a window capture) are read a pixel at a time.
+func shrink(dst *image.RGBA, src image.Image, scale int) {
+	width, height := dst.Rect.Dx(), dst.Rect.Dy()
+	origin := src.Bounds().Min
+
+	if rgba, ok := src.(*image.RGBA); ok {
+		for y := 0; y < height; y++ {
+			srcRow := rgba.Pix[rgba.PixOffset(origin.X, origin.Y+y*scale):]
+			dstRow := dst.Pix[y*dst.Stride : y*dst.Stride+width*4]
+			for x := 0; x < width; x++ {
+				copy(dstRow[x*4:x*4+4], srcRow[x*scale*4:])
+			}
+		}
+		return
+	}
+
+	for y := 0; y < height; y++ {
+		dstRow := dst.Pix[y*dst.Stride : y*dst.Stride+width*4]
+		for x := 0; x < width; x++ {
+			r, g, b, a := src.At(origin.X+x*scale, origin.Y+y*scale).RGBA()
+			dstRow[x*4] = uint8(r >> 8)
+			dstRow[x*4+1] = uint8(g >> 8)
+			dstRow[x*4+2] = uint8(b >> 8)
+			dstRow[x*4+3] = uint8(a >> 8)
+		}
+	}
+}
+
+// Frames returns copies of the buffered frames, oldest first
+func (r *Recorder) Frames() []*image.RGBA {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	frames := make([]*image.RGBA, 0, r.count)
+	start := (r.next - r.count + len(r.frames)) % len(r.frames)
+	for i := 0; i < r.count; i++ {
+		frame := r.frames[(start+i)%len(r.frames)]
+		copied := image.NewRGBA(frame.Rect)
+		copy(copied.Pix, frame.Pix)
+		frames = append(frames, copied)
+	}
+	return frames
+}
+
+// WriteGIF encodes the buffered frames as a looping animated GIF
+func (r *Recorder) WriteGIF(w io.Writer) error {
+	return WriteGIF(w, r.Frames(), r.FPS)
+}
+
+// WriteGIF encodes frames captured at fps frames per second as a looping animated GIF
+func WriteGIF(w io.Writer, frames []*image.RGBA, fps int) error {
+	if len(frames) == 0 {
+		return ErrEmpty
+	}
+
+	delay := 100 / fps // GIF delays are in hundredths of a second
+	anim := &gif.GIF{}
+	for _, frame := range frames {
+		// Every frame is dithered onto the same fixed palette so colors don't flicker between frames
+		paletted := image.NewPaletted(frame.Rect, palette.Plan9)
+		draw.FloydSteinberg.Draw(paletted, frame.Rect, frame, image.Point{})
+		anim.Image = append(anim.Image, paletted)
+		anim.Delay = append(anim.Delay, delay)
+	}
+	return gif.EncodeAll(w, anim)
+}
@@ -0,0 +1,109 @@
+package effects
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// MotionGhosts leaves translucent copies of a fast entity's silhouette behind it that fade over a few
+// frames, a cheap motion blur. The copies are rounded rectangles, so a square silhouette gives a circle
+// and a tall one a capsule. A nil MotionGhosts ignores every call, so entities opt in by creating one.
+type MotionGhosts struct {
+	Threshold float32    // speed in pixels per frame above which ghosts are left behind
+	Interval  int        // frames between ghosts
+	Life      int        // frames each ghost takes to fade out
+	Color     color.RGBA // color of a fresh ghost; it fades to transparent
+	ghosts    []*ghost   // fixed pool, one per copy on screen at once
+	next      int        // pool slot the next ghost goes into
+	timer     int        // frames until the next ghost
+}
+
+// ghost is one fading copy
+type ghost struct {
+	visual *canvas.Rectangle
+	age    int
+}
+
+// NewMotionGhosts creates count ghosts in the given color, left behind above threshold pixels per frame
+func NewMotionGhosts(count int, threshold float32, tint color.RGBA) *MotionGhosts {
+	g := &MotionGhosts{
+		Threshold: threshold,
+		Interval:  2,
+		Life:      count * 2, // The oldest ghost fades out just as the pool wraps round to it
+		Color:     tint,
+		ghosts:    make([]*ghost, count),
+	}
+	for i := range g.ghosts {
+		visual := &canvas.Rectangle{}
+		visual.Hide()
+		g.ghosts[i] = &ghost{visual: visual, age: g.Life}
+	}
+	return g
+}
+
+// Update fades the ghosts and, while the entity centered at (x, y) moves faster than the threshold,
+// drops a new width-by-height ghost where it is
+func (g *MotionGhosts) Update(x, y, width, height, speed float32) {
+	if g == nil {
+		return
+	}
+
+	for _, ghost := range g.ghosts {
+		if ghost.age >= g.Life {
+			continue
+		}
+		ghost.age++
+		if ghost.age >= g.Life {
+			ghost.visual.Hide()
+			continue
+		}
+		fade := g.Color
+		fade.A = uint8(float32(g.Color.A) * (1 - float32(ghost.age)/float32(g.Life)))
+		ghost.visual.FillColor = fade
+		ghost.visual.Refresh()
+	}
+
+	if g.timer > 0 {
+		g.timer--
+	}
+	if speed <= g.Threshold || g.timer > 0 || len(g.ghosts) == 0 {
+		return
+	}
+	g.timer = g.Interval
+
+	ghost := g.ghosts[g.next]
+	g.next = (g.next + 1) % len(g.ghosts)
+	ghost.age = 0
+	ghost.visual.FillColor = g.Color
+	ghost.visual.CornerRadius = min(width, height) / 2
+	ghost.visual.Resize(fyne.NewSize(width, height))
+	ghost.visual.Move(fyne.NewPos(x-width/2, y-height/2))
+	ghost.visual.Show()
+	ghost.visual.Refresh()
+}
+
+// Clear hides every ghost at once
+func (g *MotionGhosts) Clear() {
+	if g == nil {
+		return
+	}
+	for _, ghost := range g.ghosts {
+		ghost.age = g.Life
+		ghost.visual.Hide()
+	}
+	g.timer = 0
+}
+
+// GetVisualComponents returns the ghosts' rectangles, to be drawn behind the entity
+func (g *MotionGhosts) GetVisualComponents() []fyne.CanvasObject {
+	if g == nil {
+		return nil
+	}
+	components := make([]fyne.CanvasObject, len(g.ghosts))
+	for i, ghost := range g.ghosts {
+		components[i] = ghost.visual
+	}
+	return components
+}
@@ -3,11 +3,23 @@ package effects
 import (
 	"image/color"
 	"math"
+	"math/rand"
 	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
-	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// Lightning bolt shape
+const (
+	lightningSegments   = 8             // jagged segments in each link of a bolt
+	lightningJag        = float32(0.12) // largest sideways jag, as a fraction of the link's length
+	lightningMaxJag     = float32(20)   // sideways jags never go further than this
+	lightningForkChance = float32(0.6)  // chance that a link sprouts a fork
+	lightningForkLength = float32(0.35) // fork length, as a fraction of the link's length
+	lightningForkAngle  = float32(0.7)  // largest angle in radians between a fork and its link
+	lightningWidth      = float32(3.0)  // stroke width of the main bolt
+	lightningForkWidth  = float32(1.5)  // stroke width of the forks
 )
 
 // Lightning represents a lightning effect between two points
@@ -17,52 +29,106 @@ type Lightning struct {
 	Duration  int64 // in milliseconds
 }
 
-// NewLightning creates a lightning bolt effect between two balls
-func NewLightning(ball1, ball2 *physics.Ball) *Lightning {
+// NewLightning creates a lightning bolt effect from (x1, y1) to (x2, y2)
+func NewLightning(x1, y1, x2, y2 float32) *Lightning {
+	return NewChainLightning([]fyne.Position{fyne.NewPos(x1, y1), fyne.NewPos(x2, y2)})
+}
+
+// NewChainLightning creates a lightning bolt that jumps from point to point in order,
+// with short forks branching off some of the links
+func NewChainLightning(points []fyne.Position) *Lightning {
 	lightning := &Lightning{
 		StartTime: time.Now().UnixMilli(),
 		Duration:  300, // 300ms lightning effect
 	}
 
-	// Create multiple jagged lines for lightning effect
-	numSegments := 8
-	lightning.Lines = make([]*canvas.Line, numSegments)
+	for i := 1; i < len(points); i++ {
+		joints := lightning.addBolt(points[i-1], points[i], lightningSegments, lightningWidth)
+
+		// Now and then a fork splits off partway along the link
+		if rand.Float32() < lightningForkChance && len(joints) > 2 {
+			from := joints[1+rand.Intn(len(joints)-2)]
+			dx := points[i].X - points[i-1].X
+			dy := points[i].Y - points[i-1].Y
+			angle := float32(math.Atan2(float64(dy), float64(dx))) + (rand.Float32()*2-1)*lightningForkAngle
+			length := float32(math.Sqrt(float64(dx*dx+dy*dy))) * lightningForkLength
+			to := fyne.NewPos(from.X+float32(math.Cos(float64(angle)))*length, from.Y+float32(math.Sin(float64(angle)))*length)
+			lightning.addBolt(from, to, lightningSegments/2, lightningForkWidth)
+		}
+	}
 
-	for i := 0; i < numSegments; i++ {
-		// Calculate intermediate points with random jaggedness
-		t := float32(i) / float32(numSegments-1)
+	return lightning
+}
 
-		// Linear interpolation between ball centers
-		x := ball1.X + (ball2.X-ball1.X)*t
-		y := ball1.Y + (ball2.Y-ball1.Y)*t
+// addBolt adds a jagged bolt of the given number of segments from one point to another,
+// returning the joints between the segments (including both ends)
+func (l *Lightning) addBolt(from, to fyne.Position, segments int, width float32) []fyne.Position {
+	dx := to.X - from.X
+	dy := to.Y - from.Y
+	length := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	jag := min(length*lightningJag, lightningMaxJag)
+
+	// Sideways direction for the jags
+	var normalX, normalY float32
+	if length > 0 {
+		normalX, normalY = -dy/length, dx/length
+	}
 
-		// Add random jaggedness
-		if i > 0 && i < numSegments-1 {
-			x += (float32(math.Sin(float64(i)*0.5)) * 20)
-			y += (float32(math.Cos(float64(i)*0.7)) * 15)
-		}
+	joints := make([]fyne.Position, segments+1)
+	joints[0] = from
+	joints[segments] = to
+	for i := 1; i < segments; i++ {
+		t := float32(i) / float32(segments)
+		offset := (rand.Float32()*2 - 1) * jag
+		joints[i] = fyne.NewPos(from.X+dx*t+normalX*offset, from.Y+dy*t+normalY*offset)
+	}
 
-		line := &canvas.Line{
+	for i := 1; i <= segments; i++ {
+		l.Lines = append(l.Lines, &canvas.Line{
+			Position1:   joints[i-1],
+			Position2:   joints[i],
 			StrokeColor: color.RGBA{R: 255, G: 255, B: 0, A: 255}, // Bright yellow
-			StrokeWidth: 3.0,
-		}
+			StrokeWidth: width,
+		})
+	}
+	return joints
+}
 
-		if i == 0 {
-			line.Position1 = fyne.NewPos(ball1.X, ball1.Y)
-		} else {
-			line.Position1 = lightning.Lines[i-1].Position2
-		}
+// NearestNeighborPath builds a chain through points starting at points[start], each link jumping to the
+// closest point not yet in the chain, for up to maxLinks links. A link never jumps further than reach.
+// It returns the indices of the points in chain order, beginning with start.
+func NearestNeighborPath(points []fyne.Position, start, maxLinks int, reach float32) []int {
+	if start < 0 || start >= len(points) {
+		return nil
+	}
 
-		if i == numSegments-1 {
-			line.Position2 = fyne.NewPos(ball2.X, ball2.Y)
-		} else {
-			line.Position2 = fyne.NewPos(x, y)
+	path := []int{start}
+	used := make([]bool, len(points))
+	used[start] = true
+	current := start
+	for len(path) <= maxLinks {
+		next := -1
+		closest := reach * reach
+		for i, point := range points {
+			if used[i] {
+				continue
+			}
+			dx := point.X - points[current].X
+			dy := point.Y - points[current].Y
+			if distance := dx*dx + dy*dy; distance <= closest {
+				closest = distance
+				next = i
+			}
+		}
+		if next < 0 {
+			break
 		}
 
-		lightning.Lines[i] = line
+		path = append(path, next)
+		used[next] = true
+		current = next
 	}
-
-	return lightning
+	return path
 }
 
 // Update updates lightning animation and returns true if still active
@@ -0,0 +1,255 @@
+package effects
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// DefaultMaxParticles is how many particles a system keeps alive at once unless told otherwise
+const DefaultMaxParticles = 400
+
+// Emitter describes a burst of particles: how many, which way they fly, how long they live and
+// how they look over their life. Emit the same emitter every frame for a continuous stream.
+type Emitter struct {
+	Count       int          // particles per emit
+	Speed       float32      // starting speed in pixels per frame
+	SpeedJitter float32      // random extra starting speed, up to this much
+	Direction   float32      // center of the spray in radians (0 = right)
+	Spread      float32      // radians either side of Direction; Pi or more sprays all the way round
+	Even        bool         // space the particles evenly across the spread instead of at random
+	Life        int          // frames each particle lives
+	Size        float32      // particle diameter
+	Gravity     float32      // added to the vertical speed every frame
+	Drag        float32      // fraction of the speed lost every frame (0 = none)
+	Colors      []color.RGBA // birth colors, handed out to the particles in turn
+	EndColor    color.RGBA   // color at the end of life; the zero value fades the birth color out
+	Stroke      color.RGBA   // outline color, faded along with the fill
+	StrokeWidth float32      // outline width (0 = no outline)
+}
+
+// Confetti is a celebratory burst of multicolored confetti that shoots upward and flutters back down,
+// held back by drag
+var Confetti = Emitter{
+	Count:       60,
+	Speed:       5,
+	SpeedJitter: 4,
+	Direction:   -math.Pi / 2, // Straight up
+	Spread:      0.8,
+	Life:        150,
+	Size:        6,
+	Gravity:     0.08,
+	Drag:        0.04,
+	Colors: []color.RGBA{
+		{R: 255, G: 80, B: 80, A: 255},   // Red
+		{R: 255, G: 200, B: 50, A: 255},  // Gold
+		{R: 80, G: 255, B: 120, A: 255},  // Green
+		{R: 80, G: 180, B: 255, A: 255},  // Blue
+		{R: 220, G: 100, B: 255, A: 255}, // Purple
+		{R: 255, G: 255, B: 255, A: 255}, // White
+	},
+}
+
+// Particle is one live particle
+type Particle struct {
+	X, Y        float32        // center
+	VX, VY      float32        // velocity in pixels per frame
+	Age         int            // frames lived so far
+	Life        int            // frames the particle lives
+	Size        float32        // diameter
+	Gravity     float32        // added to VY every frame
+	Drag        float32        // fraction of the speed lost every frame
+	StartColor  color.RGBA     // color at birth
+	EndColor    color.RGBA     // color at the end of life
+	Stroke      color.RGBA     // outline color at birth
+	StrokeWidth float32        // outline width
+	Visual      *canvas.Circle // what is drawn
+}
+
+// ParticleSystem moves, fades and recycles the particles of every emitter sharing it. Finished particles
+// keep their circles, hidden, for the next emit, so a busy scene doesn't allocate a circle per spark.
+// A nil system ignores every call, so entities without one simply show no particles.
+type ParticleSystem struct {
+	Particles    []*Particle      // live particles
+	MaxParticles int              // emits beyond this many live particles are dropped
+	Quality      float32          // fraction of each emit's particles actually spawned (1 = all; see QualityScales)
+	visuals      []*canvas.Circle // every circle the system has made
+	pool         []*canvas.Circle // hidden circles ready for reuse
+	fresh        []*canvas.Circle // circles made since the last TakeNewVisuals
+}
+
+// NewParticleSystem creates an empty particle system
+func NewParticleSystem() *ParticleSystem {
+	return &ParticleSystem{MaxParticles: DefaultMaxParticles, Quality: 1}
+}
+
+// Emit spawns the emitter's particles at (x, y) and returns them, for callers that track their own
+// particles. The returned slice is only valid until the next Update.
+func (ps *ParticleSystem) Emit(e *Emitter, x, y float32) []*Particle {
+	if ps == nil || e == nil || e.Count <= 0 || e.Life <= 0 {
+		return nil
+	}
+
+	// Lower effect qualities spawn fewer particles, spread the same way
+	if ps.Quality < 1 {
+		scaled := *e
+		scaled.Count = max(int(float32(e.Count)*ps.Quality+0.5), 1)
+		e = &scaled
+	}
+
+	first := len(ps.Particles)
+
+	for i := 0; i < e.Count && len(ps.Particles) < ps.MaxParticles; i++ {
+		angle := e.Direction + e.angleOffset(i)
+		speed := e.Speed + rand.Float32()*e.SpeedJitter
+
+		start := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+		if len(e.Colors) > 0 {
+			start = e.Colors[i%len(e.Colors)]
+		}
+		end := e.EndColor
+		if end == (color.RGBA{}) {
+			end = color.RGBA{R: start.R, G: start.G, B: start.B, A: 0}
+		}
+
+		p := &Particle{
+			X:           x,
+			Y:           y,
+			VX:          float32(math.Cos(float64(angle))) * speed,
+			VY:          float32(math.Sin(float64(angle))) * speed,
+			Life:        e.Life,
+			Size:        e.Size,
+			Gravity:     e.Gravity,
+			Drag:        e.Drag,
+			StartColor:  start,
+			EndColor:    end,
+			Stroke:      e.Stroke,
+			StrokeWidth: e.StrokeWidth,
+			Visual:      ps.circle(),
+		}
+		p.Visual.Resize(fyne.NewSize(p.Size, p.Size))
+		p.draw()
+		p.Visual.Show()
+		ps.Particles = append(ps.Particles, p)
+	}
+	return ps.Particles[first:]
+}
+
+// Alive reports whether the particle is still on screen
+func (p *Particle) Alive() bool {
+	return p.Age < p.Life
+}
+
+// angleOffset returns the i-th particle's angle from the emitter's direction
+func (e *Emitter) angleOffset(i int) float32 {
+	if e.Spread >= math.Pi {
+		if e.Even {
+			return float32(i) * 2 * math.Pi / float32(e.Count)
+		}
+		return rand.Float32() * 2 * math.Pi
+	}
+	if e.Even && e.Count > 1 {
+		return -e.Spread + 2*e.Spread*float32(i)/float32(e.Count-1)
+	}
+	return (rand.Float32()*2 - 1) * e.Spread
+}
+
+// circle returns a pooled circle, or a new one the UI still has to add
+func (ps *ParticleSystem) circle() *canvas.Circle {
+	if n := len(ps.pool); n > 0 {
+		circle := ps.pool[n-1]
+		ps.pool = ps.pool[:n-1]
+		return circle
+	}
+	circle := &canvas.Circle{}
+	ps.visuals = append(ps.visuals, circle)
+	ps.fresh = append(ps.fresh, circle)
+	return circle
+}
+
+// Update ages and moves every particle, recycling those at the end of their life
+func (ps *ParticleSystem) Update() {
+	if ps == nil {
+		return
+	}
+
+	live := ps.Particles[:0]
+	for _, p := range ps.Particles {
+		p.Age++
+		if p.Age >= p.Life {
+			p.Visual.Hide()
+			ps.pool = append(ps.pool, p.Visual)
+			continue
+		}
+
+		p.VY += p.Gravity
+		p.VX *= 1 - p.Drag
+		p.VY *= 1 - p.Drag
+		p.X += p.VX
+		p.Y += p.VY
+		p.draw()
+		live = append(live, p)
+	}
+	for i := len(live); i < len(ps.Particles); i++ {
+		ps.Particles[i] = nil
+	}
+	ps.Particles = live
+}
+
+// draw places the particle's circle and colors it for its age
+func (p *Particle) draw() {
+	t := float32(p.Age) / float32(p.Life)
+	fill := lerpColor(p.StartColor, p.EndColor, t)
+	p.Visual.FillColor = fill
+	p.Visual.StrokeWidth = p.StrokeWidth
+	if p.StrokeWidth > 0 {
+		stroke := p.Stroke
+		stroke.A = uint8(uint16(stroke.A) * uint16(fill.A) / 255) // Fade the outline with the fill
+		p.Visual.StrokeColor = stroke
+	}
+	p.Visual.Move(fyne.NewPos(p.X-p.Size/2, p.Y-p.Size/2))
+	p.Visual.Refresh()
+}
+
+// TakeNewVisuals returns the circles made since the last call so the UI can add them once;
+// recycled circles are already on screen
+func (ps *ParticleSystem) TakeNewVisuals() []*canvas.Circle {
+	if ps == nil || len(ps.fresh) == 0 {
+		return nil
+	}
+	fresh := ps.fresh
+	ps.fresh = nil
+	return fresh
+}
+
+// Clear ends every live particle at once
+func (ps *ParticleSystem) Clear() {
+	if ps == nil {
+		return
+	}
+	for _, p := range ps.Particles {
+		p.Age = p.Life
+		p.Visual.Hide()
+		ps.pool = append(ps.pool, p.Visual)
+	}
+	ps.Particles = nil
+}
+
+// GetVisuals returns every circle the system has made, live or pooled
+func (ps *ParticleSystem) GetVisuals() []*canvas.Circle {
+	if ps == nil {
+		return nil
+	}
+	return ps.visuals
+}
+
+// lerpColor blends from a to b by t (0 to 1)
+func lerpColor(a, b color.RGBA, t float32) color.RGBA {
+	mix := func(a, b uint8) uint8 {
+		return uint8(float32(a) + (float32(b)-float32(a))*t + 0.5)
+	}
+	return color.RGBA{R: mix(a.R, b.R), G: mix(a.G, b.G), B: mix(a.B, b.B), A: mix(a.A, b.A)}
+}
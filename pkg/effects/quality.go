@@ -0,0 +1,58 @@
+package effects
+
+import "strings"
+
+// Quality is a preset for how much drawing the visual effects do, for weaker machines
+type Quality int
+
+// Effect qualities
+const (
+	QualityHigh   Quality = iota // every particle, full trails, the full star field
+	QualityMedium                // fewer particles, shorter trails and stars
+	QualityLow                   // the bare minimum of each
+)
+
+// Qualities lists every quality in the order they are offered
+var Qualities = []Quality{QualityLow, QualityMedium, QualityHigh}
+
+// QualityScales is what a quality preset scales, each relative to the full effect
+type QualityScales struct {
+	Particles     float32 // fraction of each emitter's particles that are spawned
+	Trails        float32 // fraction of each ribbon trail's segments that are drawn
+	Stars         float32 // fraction of the star field's stars
+	TwinkleStride int     // the stars twinkle this many times less often
+}
+
+// String returns the quality's display name
+func (q Quality) String() string {
+	switch q {
+	case QualityLow:
+		return "Low"
+	case QualityMedium:
+		return "Medium"
+	default:
+		return "High"
+	}
+}
+
+// QualityByName returns the quality with the given display name (any case), or QualityHigh for unknown names
+func QualityByName(name string) Quality {
+	for _, quality := range Qualities {
+		if strings.EqualFold(quality.String(), name) {
+			return quality
+		}
+	}
+	return QualityHigh
+}
+
+// Scales returns how much of each effect the quality keeps
+func (q Quality) Scales() QualityScales {
+	switch q {
+	case QualityLow:
+		return QualityScales{Particles: 0.3, Trails: 0.4, Stars: 0.4, TwinkleStride: 4}
+	case QualityMedium:
+		return QualityScales{Particles: 0.6, Trails: 0.7, Stars: 0.7, TwinkleStride: 2}
+	default:
+		return QualityScales{Particles: 1, Trails: 1, Stars: 1, TwinkleStride: 1}
+	}
+}
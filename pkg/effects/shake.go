@@ -0,0 +1,83 @@
+package effects
+
+import (
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+)
+
+// ScreenShake jolts a canvas object, usually the whole game area, by a random offset that dies down over
+// the shake, so big impacts are felt as well as seen
+type ScreenShake struct {
+	Target    fyne.CanvasObject // what is shaken
+	Enabled   bool              // false ignores every shake
+	Amplitude float32           // largest offset of the current shake in pixels
+	Duration  int               // frames the current shake lasts
+	Timer     int               // frames left in the current shake
+	origin    fyne.Position     // where the target rests
+}
+
+// NewScreenShake creates an enabled shake for the target
+func NewScreenShake(target fyne.CanvasObject) *ScreenShake {
+	return &ScreenShake{Target: target, Enabled: true}
+}
+
+// Shake jolts the target by up to amplitude pixels, dying down over the given number of frames.
+// A weaker shake doesn't cut short a stronger one already in progress.
+func (s *ScreenShake) Shake(amplitude float32, frames int) {
+	if s == nil || !s.Enabled || amplitude <= 0 || frames <= 0 {
+		return
+	}
+	if s.Timer > 0 && s.strength() >= amplitude {
+		return
+	}
+	if s.Timer == 0 {
+		s.origin = s.Target.Position()
+	}
+	s.Amplitude = amplitude
+	s.Duration = frames
+	s.Timer = frames
+}
+
+// strength returns the largest offset for the current frame, easing out toward the end of the shake
+func (s *ScreenShake) strength() float32 {
+	t := float32(s.Timer) / float32(s.Duration)
+	return s.Amplitude * t * t
+}
+
+// Update moves the target to this frame's offset, settling it back in place when the shake ends
+func (s *ScreenShake) Update() {
+	if s == nil || s.Timer == 0 {
+		return
+	}
+
+	s.Timer--
+	if s.Timer == 0 {
+		s.Target.Move(s.origin)
+		return
+	}
+	strength := s.strength()
+	offsetX := (rand.Float32()*2 - 1) * strength
+	offsetY := (rand.Float32()*2 - 1) * strength
+	s.Target.Move(fyne.NewPos(s.origin.X+offsetX, s.origin.Y+offsetY))
+}
+
+// SetEnabled turns shaking on or off; turning it off ends any shake in progress
+func (s *ScreenShake) SetEnabled(enabled bool) {
+	if s == nil {
+		return
+	}
+	if !enabled {
+		s.Stop()
+	}
+	s.Enabled = enabled
+}
+
+// Stop ends the shake and puts the target back in place
+func (s *ScreenShake) Stop() {
+	if s == nil || s.Timer == 0 {
+		return
+	}
+	s.Timer = 0
+	s.Target.Move(s.origin)
+}
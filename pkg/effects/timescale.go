@@ -0,0 +1,82 @@
+package effects
+
+// TimeScale slows the simulation down without touching the simulation rate: each fixed simulation step adds
+// the scale to a step budget and the step only runs once a whole one has built up. A hit-stop freezes it
+// outright for a moment, then eases it back up to speed. Hold and ramp lengths count simulation steps.
+type TimeScale struct {
+	Scale   float32 // steps taken per simulation step when no hit-stop is playing (1 = normal, 0 = frozen; never faster than normal)
+	Enabled bool    // false ignores every hit-stop
+	budget  float32 // steps built up but not yet taken
+	hold    int     // steps left frozen in the current hit-stop
+	ramp    int     // steps the current hit-stop takes to ease back up to speed
+	rampAge int     // steps into the ramp so far
+}
+
+// NewTimeScale creates a normal-speed time scale with hit-stops enabled
+func NewTimeScale() *TimeScale {
+	return &TimeScale{Scale: 1, Enabled: true}
+}
+
+// HitStop freezes the simulation for hold steps, then eases it back to full speed over ramp steps.
+// A shorter hit-stop doesn't cut short a longer one already in progress.
+func (t *TimeScale) HitStop(hold, ramp int) {
+	if t == nil || !t.Enabled || hold+ramp <= 0 {
+		return
+	}
+	if t.hold+t.ramp-t.rampAge >= hold+ramp {
+		return
+	}
+	t.hold = hold
+	t.ramp = ramp
+	t.rampAge = 0
+	t.budget = 0
+}
+
+// Current returns the simulation speed for this step, taking any hit-stop into account
+func (t *TimeScale) Current() float32 {
+	if t == nil {
+		return 1
+	}
+	if t.hold > 0 {
+		return 0
+	}
+	if t.rampAge < t.ramp {
+		// Ease in: barely moving at first, picking up speed toward the end of the ramp
+		p := float32(t.rampAge) / float32(t.ramp)
+		return t.Scale * p * p * (3 - 2*p)
+	}
+	return t.Scale
+}
+
+// Tick advances one simulation step and reports whether the step should run or be skipped
+func (t *TimeScale) Tick() bool {
+	if t == nil {
+		return true
+	}
+
+	t.budget = min(t.budget+t.Current(), 1)
+	if t.hold > 0 {
+		t.hold--
+	} else if t.rampAge < t.ramp {
+		t.rampAge++
+	}
+
+	if t.budget < 1 {
+		return false
+	}
+	t.budget--
+	return true
+}
+
+// SetEnabled turns hit-stops on or off; turning them off ends any hit-stop in progress
+func (t *TimeScale) SetEnabled(enabled bool) {
+	if t == nil {
+		return
+	}
+	if !enabled {
+		t.hold = 0
+		t.ramp = 0
+		t.rampAge = 0
+	}
+	t.Enabled = enabled
+}
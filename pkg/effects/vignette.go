@@ -0,0 +1,130 @@
+package effects
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Vignette overlay
+const (
+	vignetteDangerAlpha = float32(150)  // edge opacity at the peak of the low-health pulse
+	vignettePulseRate   = float32(0.1)  // pulse phase advance per frame in radians (about one beat a second)
+	vignettePulseFloor  = float32(0.35) // share of the pulse's strength that never fades between beats
+	vignetteDangerEase  = float32(0.05) // how quickly the pulse fades in and out each frame
+	vignetteFlashAlpha  = float32(200)  // edge opacity of a full-strength flash
+	vignetteFlashCenter = float32(0.4)  // center opacity of a flash, as a fraction of its edge opacity
+	vignetteFlashFrames = 12            // frames a flash takes to fade
+)
+
+// Vignette is a full-screen radial gradient drawn over the game: its edges pulse red while the player is
+// in danger and the whole screen flashes white on explosions. It is clear when neither is happening.
+type Vignette struct {
+	Gradient *canvas.RadialGradient // the overlay, clear in the middle and tinted at the edges
+	Enabled  bool                   // false keeps the overlay hidden
+	danger   float32                // current strength of the low-health pulse, 0 to 1
+	inDanger bool                   // whether the pulse should be showing
+	phase    float32                // pulse phase in radians
+	flash    float32                // strength of the current flash, 0 to 1
+	flashAge int                    // frames since the current flash began
+}
+
+// NewVignette creates a clear, enabled vignette covering an area of the given size
+func NewVignette(size fyne.Size) *Vignette {
+	gradient := canvas.NewRadialGradient(color.Transparent, color.Transparent)
+	gradient.Resize(size)
+	gradient.Hide()
+	return &Vignette{Gradient: gradient, Enabled: true}
+}
+
+// SetDanger turns the low-health pulse on or off; it fades in and out rather than snapping
+func (v *Vignette) SetDanger(inDanger bool) {
+	if v == nil {
+		return
+	}
+	v.inDanger = inDanger
+}
+
+// Flash flashes the screen white at the given strength (0 to 1), fading over a few frames.
+// A weaker flash doesn't cut short a stronger one still fading.
+func (v *Vignette) Flash(strength float32) {
+	if v == nil || !v.Enabled || strength <= 0 {
+		return
+	}
+	if v.currentFlash() >= strength {
+		return
+	}
+	v.flash = min(strength, 1)
+	v.flashAge = 0
+}
+
+// currentFlash returns this frame's flash strength, easing out as it fades
+func (v *Vignette) currentFlash() float32 {
+	if v.flashAge >= vignetteFlashFrames {
+		return 0
+	}
+	t := 1 - float32(v.flashAge)/float32(vignetteFlashFrames)
+	return v.flash * t * t
+}
+
+// Update advances the pulse and flash and recolors the overlay
+func (v *Vignette) Update() {
+	if v == nil {
+		return
+	}
+	if !v.Enabled {
+		v.Gradient.Hide()
+		return
+	}
+
+	if v.inDanger {
+		v.danger = min(v.danger+vignetteDangerEase, 1)
+	} else {
+		v.danger = max(v.danger-vignetteDangerEase, 0)
+	}
+	v.phase += vignettePulseRate
+	if v.phase > 2*math.Pi {
+		v.phase -= 2 * math.Pi
+	}
+	if v.flashAge < vignetteFlashFrames {
+		v.flashAge++
+	}
+
+	flash := v.currentFlash()
+	if v.danger == 0 && flash == 0 {
+		v.Gradient.Hide()
+		return
+	}
+
+	beat := (1 + float32(math.Sin(float64(v.phase)))) / 2
+	pulse := v.danger * (vignettePulseFloor + (1-vignettePulseFloor)*beat)
+	edgeRed := pulse * vignetteDangerAlpha
+	edgeWhite := flash * vignetteFlashAlpha
+	edgeAlpha := min(edgeRed+edgeWhite, 255)
+
+	// The flash whitens the red edges in proportion to how much of the tint it makes up
+	whiteShare := float32(0)
+	if edgeAlpha > 0 {
+		whiteShare = edgeWhite / (edgeRed + edgeWhite)
+	}
+	channel := uint8(255 * whiteShare)
+	v.Gradient.EndColor = color.RGBA{R: 255, G: channel, B: channel, A: uint8(edgeAlpha)}
+	v.Gradient.StartColor = color.RGBA{R: 255, G: 255, B: 255, A: uint8(edgeWhite * vignetteFlashCenter)}
+	v.Gradient.Show()
+	v.Gradient.Refresh()
+}
+
+// SetEnabled turns the overlay on or off; turning it off clears any pulse or flash in progress
+func (v *Vignette) SetEnabled(enabled bool) {
+	if v == nil {
+		return
+	}
+	if !enabled {
+		v.danger = 0
+		v.flashAge = vignetteFlashFrames
+		v.Gradient.Hide()
+	}
+	v.Enabled = enabled
+}
@@ -0,0 +1,151 @@
+//go:build !js && !wasm && !android && !ios && !mobile
+
+package input
+
+import (
+	"sync"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// Gamepad tuning
+const (
+	stickDeadzone    = float32(0.2) // stick tilt ignored as drift
+	triggerThreshold = float32(0.3) // trigger travel (0-1) that counts as a pull
+)
+
+// Gamepad is a controller read through GLFW's gamepad mappings:
+// the left stick moves, the right stick aims, either trigger fires and
+// pressing the left stick (or holding the left bumper) sprints and X (or the right bumper) swipes.
+//
+// GLFW is initialized and shut down by Fyne's desktop driver. Controllers are plugged in and unplugged
+// through the joystick callback WatchGamepads registers on the driver's main thread; once a controller is
+// detached (unplugged, or every gamepad stopped before the app quits) it is never read again.
+type Gamepad struct {
+	Joystick glfw.Joystick // GLFW joystick slot
+
+	name     string     // controller name, read when it was connected
+	mu       sync.Mutex // held while GLFW is read, so detaching waits for a read in progress
+	detached bool       // GLFW must no longer be asked about this controller
+}
+
+// WatchGamepads adds a device for each controller with a gamepad mapping that is already connected and
+// registers GLFW's joystick callback, so controllers plugged in or unplugged later are added and removed too.
+// connected and disconnected are told about each device added or removed.
+//
+// It must run on the driver's main thread once GLFW is initialized, i.e. from the app's OnStarted hook.
+func (m *Manager) WatchGamepads(connected, disconnected func(Device)) {
+	for joystick := glfw.Joystick1; joystick <= glfw.JoystickLast; joystick++ {
+		if pad := m.attachGamepad(joystick); pad != nil {
+			connected(pad)
+		}
+	}
+	glfw.SetJoystickCallback(func(joystick glfw.Joystick, event glfw.PeripheralEvent) {
+		switch event {
+		case glfw.Connected:
+			if pad := m.attachGamepad(joystick); pad != nil {
+				connected(pad)
+			}
+		case glfw.Disconnected:
+			if pad := m.gamepad(joystick); pad != nil {
+				m.detachGamepad(pad)
+				disconnected(pad)
+			}
+		}
+	})
+}
+
+// StopGamepads detaches every controller and ignores any connected later. Call it before the app quits:
+// the driver shuts GLFW down once its main loop ends, and reading a controller after that panics.
+func (m *Manager) StopGamepads() {
+	m.mu.Lock()
+	m.gamepadsStopped = true
+	m.mu.Unlock()
+
+	for joystick := glfw.Joystick1; joystick <= glfw.JoystickLast; joystick++ {
+		if pad := m.gamepad(joystick); pad != nil {
+			m.detachGamepad(pad)
+		}
+	}
+}
+
+// attachGamepad adds a device for the controller in the given slot and returns it, or returns nil if the slot
+// holds no controller with a gamepad mapping, already has a device, or gamepads were stopped. It must run on
+// the driver's main thread.
+func (m *Manager) attachGamepad(joystick glfw.Joystick) *Gamepad {
+	if !joystick.IsGamepad() {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.gamepadsStopped {
+		return nil
+	}
+	for _, device := range m.Devices {
+		if pad, ok := device.(*Gamepad); ok && pad.Joystick == joystick {
+			return nil
+		}
+	}
+	pad := &Gamepad{Joystick: joystick, name: joystick.GetGamepadName()}
+	m.Devices = append(m.Devices, pad)
+	return pad
+}
+
+// detachGamepad removes a controller's device and stops it being read
+func (m *Manager) detachGamepad(pad *Gamepad) {
+	m.Remove(pad)
+
+	pad.mu.Lock()
+	pad.detached = true
+	pad.mu.Unlock()
+}
+
+// gamepad returns the device reading the given joystick slot, or nil
+func (m *Manager) gamepad(joystick glfw.Joystick) *Gamepad {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, device := range m.Devices {
+		if pad, ok := device.(*Gamepad); ok && pad.Joystick == joystick {
+			return pad
+		}
+	}
+	return nil
+}
+
+// Name returns the controller's name
+func (g *Gamepad) Name() string {
+	return g.name
+}
+
+// Poll reads the sticks and triggers (a detached or unplugged controller reports nothing)
+func (g *Gamepad) Poll() State {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var state State
+	if g.detached {
+		return state
+	}
+	if pad := g.Joystick.GetGamepadState(); pad != nil {
+		state.MoveX, state.MoveY = deadzone(pad.Axes[glfw.AxisLeftX], pad.Axes[glfw.AxisLeftY])
+		state.AimX, state.AimY = deadzone(pad.Axes[glfw.AxisRightX], pad.Axes[glfw.AxisRightY])
+
+		// Triggers rest at -1 and go to 1 when fully pulled
+		leftTrigger := (pad.Axes[glfw.AxisLeftTrigger] + 1) / 2
+		rightTrigger := (pad.Axes[glfw.AxisRightTrigger] + 1) / 2
+		state.Fire = leftTrigger > triggerThreshold || rightTrigger > triggerThreshold
+
+		state.Sprint = pad.Buttons[glfw.ButtonLeftThumb] == glfw.Press || pad.Buttons[glfw.ButtonLeftBumper] == glfw.Press
+		state.Melee = pad.Buttons[glfw.ButtonX] == glfw.Press || pad.Buttons[glfw.ButtonRightBumper] == glfw.Press
+	}
+	return state
+}
+
+// deadzone zeroes a stick reading that is only slightly tilted
+func deadzone(x, y float32) (float32, float32) {
+	if x*x+y*y < stickDeadzone*stickDeadzone {
+		return 0, 0
+	}
+	return x, y
+}
@@ -0,0 +1,9 @@
+//go:build js || wasm || android || ios || mobile
+
+package input
+
+// WatchGamepads adds nothing; gamepads are only supported by the desktop driver
+func (m *Manager) WatchGamepads(connected, disconnected func(Device)) {}
+
+// StopGamepads does nothing; gamepads are only supported by the desktop driver
+func (m *Manager) StopGamepads() {}
@@ -0,0 +1,112 @@
+// Package input turns keyboard, mouse and gamepad events into one control state,
+// so every device drives the human through the same interface.
+package input
+
+import (
+	"math"
+	"slices"
+	"sync"
+)
+
+// State is a snapshot of the player's controls
+type State struct {
+	MoveX, MoveY     float32 // movement direction, each -1 to 1 (y grows downward)
+	AimX, AimY       float32 // aim direction (zero = not aiming with a direction)
+	HasTarget        bool    // whether TargetX/TargetY hold an aim point
+	TargetX, TargetY float32 // point to aim at in game-area coordinates (mouse)
+	Fire             bool    // whether fire is held
+	Sprint           bool    // whether sprint is held
+	Melee            bool    // whether melee is held
+}
+
+// Aiming reports whether the state holds an aim direction or target
+func (s State) Aiming() bool {
+	return s.HasTarget || s.AimX != 0 || s.AimY != 0
+}
+
+// AimAngle returns the aim direction in radians as seen from (originX, originY)
+func (s State) AimAngle(originX, originY float32) float32 {
+	if s.HasTarget {
+		return float32(math.Atan2(float64(s.TargetY-originY), float64(s.TargetX-originX)))
+	}
+	return float32(math.Atan2(float64(s.AimY), float64(s.AimX)))
+}
+
+// Device is a source of player input
+type Device interface {
+	// Name returns the device's display name
+	Name() string
+	// Poll returns the device's current control state
+	Poll() State
+}
+
+// Manager combines the states of several devices. Devices can be added and removed while another
+// goroutine polls it.
+type Manager struct {
+	mu      sync.Mutex
+	Devices []Device // devices in priority order (earlier devices win the aim); guarded by mu once in use
+
+	gamepadsStopped bool // no more controllers are read or added (see StopGamepads); guarded by mu
+}
+
+// NewManager creates a manager for the given devices
+func NewManager(devices ...Device) *Manager {
+	return &Manager{Devices: devices}
+}
+
+// Add adds a device to the manager
+func (m *Manager) Add(device Device) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Devices = append(m.Devices, device)
+}
+
+// Remove removes a device from the manager
+func (m *Manager) Remove(device Device) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Devices = slices.DeleteFunc(m.Devices, func(d Device) bool { return d == device })
+}
+
+// Poll merges every device's state: movement adds up (limited to full speed),
+// the first device that is aiming sets the aim, and fire, sprint and melee are held if any device holds them
+func (m *Manager) Poll() State {
+	m.mu.Lock()
+	devices := slices.Clone(m.Devices)
+	m.mu.Unlock()
+
+	var combined State
+	aimSet := false
+
+	for _, device := range devices {
+		state := device.Poll()
+
+		combined.MoveX += state.MoveX
+		combined.MoveY += state.MoveY
+		combined.Fire = combined.Fire || state.Fire
+		combined.Sprint = combined.Sprint || state.Sprint
+		combined.Melee = combined.Melee || state.Melee
+
+		if !aimSet && state.Aiming() {
+			combined.AimX, combined.AimY = state.AimX, state.AimY
+			combined.HasTarget = state.HasTarget
+			combined.TargetX, combined.TargetY = state.TargetX, state.TargetY
+			aimSet = true
+		}
+	}
+
+	combined.MoveX = clampAxis(combined.MoveX)
+	combined.MoveY = clampAxis(combined.MoveY)
+	return combined
+}
+
+// clampAxis limits an axis value to -1..1
+func clampAxis(value float32) float32 {
+	if value > 1 {
+		return 1
+	}
+	if value < -1 {
+		return -1
+	}
+	return value
+}
@@ -0,0 +1,129 @@
+package input
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+// KeySet maps keys to the keyboard device's controls
+type KeySet struct {
+	Up, Down, Left, Right []fyne.KeyName // movement keys
+	Sprint                []fyne.KeyName // sprint keys
+	Melee                 []fyne.KeyName // melee swipe keys
+}
+
+// Key sets for one player or two players sharing a keyboard
+var (
+	// AllKeys lets one player use either the arrow keys or WASD, with either Shift to sprint and F or / to swipe
+	AllKeys = KeySet{
+		Up:     []fyne.KeyName{fyne.KeyUp, fyne.KeyW},
+		Down:   []fyne.KeyName{fyne.KeyDown, fyne.KeyS},
+		Left:   []fyne.KeyName{fyne.KeyLeft, fyne.KeyA},
+		Right:  []fyne.KeyName{fyne.KeyRight, fyne.KeyD},
+		Sprint: []fyne.KeyName{desktop.KeyShiftLeft, desktop.KeyShiftRight},
+		Melee:  []fyne.KeyName{fyne.KeyF, fyne.KeySlash},
+	}
+	// WASDKeys is player 1's half of a shared keyboard
+	WASDKeys = KeySet{
+		Up:     []fyne.KeyName{fyne.KeyW},
+		Down:   []fyne.KeyName{fyne.KeyS},
+		Left:   []fyne.KeyName{fyne.KeyA},
+		Right:  []fyne.KeyName{fyne.KeyD},
+		Sprint: []fyne.KeyName{desktop.KeyShiftLeft},
+		Melee:  []fyne.KeyName{fyne.KeyF},
+	}
+	// ArrowKeys is player 2's half of a shared keyboard
+	ArrowKeys = KeySet{
+		Up:     []fyne.KeyName{fyne.KeyUp},
+		Down:   []fyne.KeyName{fyne.KeyDown},
+		Left:   []fyne.KeyName{fyne.KeyLeft},
+		Right:  []fyne.KeyName{fyne.KeyRight},
+		Sprint: []fyne.KeyName{desktop.KeyShiftRight},
+		Melee:  []fyne.KeyName{fyne.KeySlash},
+	}
+)
+
+// Keyboard is a device driven by a set of movement, sprint and melee keys
+type Keyboard struct {
+	Keys                  KeySet // keys this keyboard device listens to
+	up, down, left, right bool
+	sprint                bool
+	melee                 bool
+}
+
+// NewKeyboard creates a keyboard device for one player (arrow keys or WASD, Shift to sprint, F or / to swipe) with no keys held
+func NewKeyboard() *Keyboard {
+	return NewKeyboardWithKeys(AllKeys)
+}
+
+// NewKeyboardWithKeys creates a keyboard device listening to the given keys, with no keys held
+func NewKeyboardWithKeys(keys KeySet) *Keyboard {
+	return &Keyboard{Keys: keys}
+}
+
+// Name returns the device's display name
+func (k *Keyboard) Name() string {
+	return "Keyboard"
+}
+
+// SetKey records a key press or release. It returns false for keys the keyboard device doesn't use.
+func (k *Keyboard) SetKey(key fyne.KeyName, pressed bool) bool {
+	switch {
+	case hasKey(k.Keys.Up, key):
+		k.up = pressed
+	case hasKey(k.Keys.Down, key):
+		k.down = pressed
+	case hasKey(k.Keys.Left, key):
+		k.left = pressed
+	case hasKey(k.Keys.Right, key):
+		k.right = pressed
+	case hasKey(k.Keys.Sprint, key):
+		k.sprint = pressed
+	case hasKey(k.Keys.Melee, key):
+		k.melee = pressed
+	default:
+		return false
+	}
+	return true
+}
+
+// SetKeys switches the keys the device listens to, letting go of every key
+func (k *Keyboard) SetKeys(keys KeySet) {
+	k.Release()
+	k.Keys = keys
+}
+
+// Release lets go of every key
+func (k *Keyboard) Release() {
+	k.up, k.down, k.left, k.right = false, false, false, false
+	k.sprint = false
+	k.melee = false
+}
+
+// Poll returns the movement from the held direction keys
+func (k *Keyboard) Poll() State {
+	state := State{Sprint: k.sprint, Melee: k.melee}
+	if k.up {
+		state.MoveY--
+	}
+	if k.down {
+		state.MoveY++
+	}
+	if k.left {
+		state.MoveX--
+	}
+	if k.right {
+		state.MoveX++
+	}
+	return state
+}
+
+// hasKey reports whether key is one of keys
+func hasKey(keys []fyne.KeyName, key fyne.KeyName) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
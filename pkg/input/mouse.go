@@ -0,0 +1,52 @@
+package input
+
+// Mouse is a device that aims at the pointer and fires while the button is held.
+// The secondary button swipes.
+type Mouse struct {
+	x, y   float32 // pointer position in game-area coordinates
+	inside bool    // whether the pointer is over the game area
+	held   bool    // whether the button is held
+	melee  bool    // whether the secondary button is held
+}
+
+// NewMouse creates a mouse device
+func NewMouse() *Mouse {
+	return &Mouse{}
+}
+
+// Name returns the device's display name
+func (m *Mouse) Name() string {
+	return "Mouse"
+}
+
+// Move records the pointer position over the game area
+func (m *Mouse) Move(x, y float32) {
+	m.x = x
+	m.y = y
+	m.inside = true
+}
+
+// Leave records that the pointer left the game area (and releases the button)
+func (m *Mouse) Leave() {
+	m.inside = false
+	m.held = false
+	m.melee = false
+}
+
+// SetButton records the button being pressed or released
+func (m *Mouse) SetButton(pressed bool) {
+	m.held = pressed
+}
+
+// SetMelee records the secondary button being pressed or released
+func (m *Mouse) SetMelee(pressed bool) {
+	m.melee = pressed
+}
+
+// Poll aims at the pointer while the button is held
+func (m *Mouse) Poll() State {
+	if !m.inside || !m.held {
+		return State{Melee: m.melee}
+	}
+	return State{HasTarget: true, TargetX: m.x, TargetY: m.y, Fire: true, Melee: m.melee}
+}
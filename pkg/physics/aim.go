@@ -0,0 +1,203 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Lock-on reticle look
+var reticleColor = color.RGBA{R: 255, G: 60, B: 60, A: 220}
+
+const (
+	reticleGap   = float32(8)  // space between the ball's edge and the reticle ring
+	reticleTicks = float32(10) // length of the crosshair ticks poking inside the ring
+)
+
+// Reticle is a crosshair ring drawn around the locked-on ball
+type Reticle struct {
+	Ring                   *canvas.Circle // Ring around the ball
+	Left, Right, Top, Down *canvas.Line   // Crosshair ticks on the ring
+}
+
+// NewReticle creates a hidden reticle
+func NewReticle() *Reticle {
+	r := &Reticle{
+		Ring: &canvas.Circle{StrokeColor: reticleColor, StrokeWidth: 2},
+	}
+	for _, tick := range []**canvas.Line{&r.Left, &r.Right, &r.Top, &r.Down} {
+		*tick = &canvas.Line{StrokeColor: reticleColor, StrokeWidth: 2}
+	}
+	r.Hide()
+	return r
+}
+
+// Update centers the reticle on (x, y) around a ball of the given radius
+func (r *Reticle) Update(x, y, radius float32) {
+	ring := radius + reticleGap
+	r.Ring.Resize(fyne.NewSize(ring*2, ring*2))
+	r.Ring.Move(fyne.NewPos(x-ring, y-ring))
+
+	inner := ring - reticleTicks
+	r.Left.Position1, r.Left.Position2 = fyne.NewPos(x-ring, y), fyne.NewPos(x-inner, y)
+	r.Right.Position1, r.Right.Position2 = fyne.NewPos(x+inner, y), fyne.NewPos(x+ring, y)
+	r.Top.Position1, r.Top.Position2 = fyne.NewPos(x, y-ring), fyne.NewPos(x, y-inner)
+	r.Down.Position1, r.Down.Position2 = fyne.NewPos(x, y+inner), fyne.NewPos(x, y+ring)
+	for _, object := range r.GetVisualComponents() {
+		object.Refresh()
+	}
+}
+
+// Show shows the reticle
+func (r *Reticle) Show() {
+	for _, object := range r.GetVisualComponents() {
+		object.Show()
+	}
+}
+
+// Hide hides the reticle
+func (r *Reticle) Hide() {
+	for _, object := range r.GetVisualComponents() {
+		object.Hide()
+	}
+}
+
+// GetVisualComponents returns the reticle's ring and ticks
+func (r *Reticle) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.Ring, r.Left, r.Right, r.Top, r.Down}
+}
+
+// CycleLockTarget locks onto the next ball, going from the closest ball outward.
+// Cycling past the farthest ball releases the lock and goes back to auto-targeting.
+func (h *Human) CycleLockTarget(balls []*Ball) {
+	targets := make([]*Ball, 0, len(balls))
+	for _, ball := range balls {
+		if ball.IsAnimated {
+			targets = append(targets, ball)
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return h.distanceTo(targets[i]) < h.distanceTo(targets[j])
+	})
+
+	next := 0
+	for i, ball := range targets {
+		if ball == h.LockedTarget {
+			next = i + 1
+			break
+		}
+	}
+	if next >= len(targets) {
+		h.ReleaseLock()
+		return
+	}
+	h.LockedTarget = targets[next]
+}
+
+// ReleaseLock drops the locked-on ball and goes back to auto-targeting
+func (h *Human) ReleaseLock() {
+	h.LockedTarget = nil
+	h.Reticle.Hide()
+}
+
+// lockedTarget returns the locked-on ball, releasing the lock once the ball is destroyed or absorbed
+func (h *Human) lockedTarget() *Ball {
+	if h.LockedTarget != nil && !h.LockedTarget.IsAnimated {
+		h.ReleaseLock()
+	}
+	return h.LockedTarget
+}
+
+// shootingTarget returns the ball the human shoots at: the locked-on ball, or else the closest one
+func (h *Human) shootingTarget(balls []*Ball) *Ball {
+	if target := h.lockedTarget(); target != nil {
+		return target
+	}
+	return h.findClosestBall(balls)
+}
+
+// updateReticle keeps the reticle on the locked-on ball
+func (h *Human) updateReticle() {
+	target := h.lockedTarget()
+	if target == nil {
+		return
+	}
+	h.Reticle.Update(target.X, target.Y, target.Radius)
+	h.Reticle.Show()
+}
+
+// distanceTo returns the distance from the human to a ball's center
+func (h *Human) distanceTo(ball *Ball) float32 {
+	dx := ball.X - h.X
+	dy := ball.Y - h.Y
+	return float32(math.Sqrt(float64(dx*dx + dy*dy)))
+}
+
+// bulletSpeed returns how fast the current weapon's bullets fly
+func (h *Human) bulletSpeed() float32 {
+	switch h.CurrentWeapon().(type) {
+	case *PiercingLaser:
+		return Tuning().BulletSpeed * laserSpeedScale
+	case *HomingMissiles:
+		return Tuning().BulletSpeed * missileSpeedScale
+	default:
+		return Tuning().BulletSpeed
+	}
+}
+
+// InterceptPoint returns where to aim so a bullet from the human meets the ball, leading it by its
+// current velocity. When the ball can't be caught it aims at the ball's current position.
+func (h *Human) InterceptPoint(ball *Ball) (float32, float32) {
+	return ball.interceptFrom(h.X, h.Y, h.bulletSpeed())
+}
+
+// effectiveVelocity returns how the ball is really moving: stunned balls hold still and frozen ones crawl
+func (b *Ball) effectiveVelocity() (float32, float32) {
+	if b.StunTimer > 0 {
+		return 0, 0
+	}
+	factor := b.statusSpeedFactor()
+	return b.VX * factor, b.VY * factor
+}
+
+// interceptFrom returns where something leaving (x, y) at speed meets the ball if the ball keeps its
+// current velocity, or the ball's current position when it can't be caught
+func (b *Ball) interceptFrom(x, y, speed float32) (float32, float32) {
+	vx, vy := b.effectiveVelocity()
+	t, ok := interceptTime(b.X-x, b.Y-y, vx, vy, speed)
+	if !ok {
+		return b.X, b.Y
+	}
+	return b.X + vx*t, b.Y + vy*t
+}
+
+// interceptTime solves |d + v*t| = s*t for the earliest positive time t, where d is the offset to a
+// target moving at velocity v and s is the chaser's speed. It reports false when the target can't be caught.
+func interceptTime(dx, dy, vx, vy, speed float32) (float32, bool) {
+	a := vx*vx + vy*vy - speed*speed
+	b := 2 * (dx*vx + dy*vy)
+	c := dx*dx + dy*dy
+
+	if math.Abs(float64(a)) < 1e-6 {
+		if b >= 0 {
+			return 0, false
+		}
+		return -c / b, true
+	}
+
+	discriminant := b*b - 4*a*c
+	if discriminant < 0 {
+		return 0, false
+	}
+	root := float32(math.Sqrt(float64(discriminant)))
+	t1 := (-b - root) / (2 * a)
+	t2 := (-b + root) / (2 * a)
+	t := t1
+	if t <= 0 || (t2 > 0 && t2 < t) {
+		t = t2
+	}
+	return t, t > 0
+}
@@ -1,15 +1,19 @@
 package physics
 
 import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/png" // alien face images are PNGs
 	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
-	"fyne.io/fyne/v2/storage"
 )
 
 // Alien represents a mysterious alien face that drifts through the star field
@@ -20,17 +24,69 @@ type Alien struct {
 	Bounds        fyne.Size // movement bounds
 	IsActive      bool      // whether the alien is active
 	// Visual components
-	Image         *canvas.Image     // Alien face image
-	ImageContainer *fyne.Container // Container for the image
+	Face          *canvas.Raster    // Alien face, drawn from faded copies of the image (see alienfade.go)
+	Drawn         *AlienDrawnFace   // Face drawn from primitives, shown when there is no face image (see alienface.go)
+	ImageContainer *fyne.Container // Container for the face
+	source        image.Image       // decoded face image (nil = nothing to draw)
 	// Drift behavior
 	DriftTimer    int     // frames until direction change
 	DriftDuration int     // frames between direction changes
 	Alpha         float32 // transparency (0.0 to 1.0)
+	// Phasing in and out (see alienfade.go)
+	fading     int                 // +1 materializing, -1 dissolving, 0 steady
+	phaseOut   bool                // dissolve and teleport when the drift timer runs out
+	frames     map[int]*image.RGBA // faded face frames by alpha step, at frameSize
+	frameSize  image.Point         // pixel size the cached frames were drawn at
+	frameMu    sync.Mutex          // guards the frames, which Fyne draws from its own goroutine
 	// Mysterious behavior
 	PhaseOffset   float32 // for subtle floating motion
 	FloatAmplitude float32 // how much it bobs up and down
+	// Curiosity toward the firing eye
+	Curiosity         float32        // builds up as the human fires, fades over time
+	IsCurious         bool           // drifting toward the firing location
+	IsObserving       bool           // hovering near the human and scanning them
+	IsDeparting       bool           // leaving the arena after observing
+	ObserveTimer      int            // frames left observing
+	CuriosityCooldown int            // frames before curiosity can trigger again
+	ScanRing          *canvas.Circle // Pulsing scan ring drawn on the human while observing
+	scanPhase         float32        // pulse phase of the scan ring
+	Dialogue          *AlienDialogue // Occasional cryptic speech bubbles near the human
+	// Curiosity toward explosions and lightning (see alieninterest.go)
+	IsInvestigating  bool    // drifting toward (or hovering over) a recent event
+	IsHovering       bool    // arrived and hovering over the event
+	InterestX        float32 // where the event happened
+	InterestY        float32
+	InterestTimer    int     // frames left to reach the event, then to hover over it
+	interestCooldown int     // frames before another event catches its interest
+	// Hostile mode (see plasma.go)
+	Hostile   bool      // fires slow plasma orbs at the human
+	Orbs      []*Bullet // plasma orbs in flight
+	fireTimer int       // frames until the next shot
+	// Travel (see wormhole.go)
+	Wormholes *WormholePair // wormhole pair the alien last travelled through
+	lastShotsFired    int            // human shot count seen on the previous frame
 }
 
+// AlienContext is the world information the alien reacts to
+type AlienContext struct {
+	HumanX, HumanY   float32 // human position
+	HumanSize        float32 // human size (for the scan ring)
+	HumanActive      bool    // whether the human is alive
+	FiringX, FiringY float32 // where the human's bullets leave the firing circle
+	ShotsFired       int     // total bullets the human has fired
+}
+
+// Alien curiosity tuning
+const (
+	alienCuriosityThreshold = float32(6.0) // ~3 seconds of steady firing
+	alienCuriosityDecay     = float32(0.99)
+	alienHoverDistance      = float32(90)  // how close the alien hovers to the firing eye
+	alienApproachSpeed      = float32(1.2) // drift speed toward the firing eye
+	alienDepartSpeed        = float32(1.5) // drift speed when leaving
+	alienObserveFrames      = 180          // 3 seconds observing
+	alienCuriosityCooldown  = 1800         // 30 seconds before it gets curious again
+)
+
 // NewAlien creates a new alien entity that drifts through space
 func NewAlien(x, y, size float32) *Alien {
 	alien := &Alien{
@@ -43,19 +99,32 @@ func NewAlien(x, y, size float32) *Alien {
 		IsActive:      true,
 		DriftTimer:    rand.Intn(300) + 180, // 3-8 seconds at 60fps
 		DriftDuration: 300,                   // 5 seconds default
-		Alpha:         0.7,                   // Semi-transparent
+		Alpha:         alienMaxAlpha,         // Semi-transparent
 		PhaseOffset:   rand.Float32() * 2 * math.Pi,
 		FloatAmplitude: 2.0, // Subtle floating motion
 	}
 
-	// Create alien face image placeholder (will be replaced in NewAlienFromFile)
-	alien.Image = canvas.NewImageFromResource(nil)
-	alien.Image.FillMode = canvas.ImageFillOriginal
-	alien.Image.ScaleMode = canvas.ImageScaleSmooth
-	alien.Image.Resize(fyne.NewSize(size, size))
+	// Create the alien face (drawn from primitives until it is given an image)
+	alien.Face = canvas.NewRaster(alien.faceFrame)
+	alien.Face.ScaleMode = canvas.ImageScaleSmooth
+	alien.Face.Resize(fyne.NewSize(size, size))
+	alien.Face.Hide()
+	alien.Drawn = newAlienDrawnFace()
+	alien.Drawn.setAlpha(alienAlphaLevel(alien.Alpha))
 
 	// Create container
-	alien.ImageContainer = container.NewWithoutLayout(alien.Image)
+	alien.ImageContainer = container.NewWithoutLayout(append(alien.Drawn.objects(), alien.Face)...)
+
+	// Create the scan ring (hidden until the alien observes the human)
+	alien.ScanRing = &canvas.Circle{
+		FillColor:   color.RGBA{R: 0, G: 255, B: 150, A: 20},  // Faint green glow
+		StrokeColor: color.RGBA{R: 0, G: 255, B: 150, A: 200}, // Alien green
+		StrokeWidth: 2.0,
+	}
+	alien.ScanRing.Hide()
+
+	alien.Dialogue = NewAlienDialogue()
+	alien.Wormholes = NewWormholePair()
 
 	// Set initial position
 	alien.UpdatePosition()
@@ -65,54 +134,95 @@ func NewAlien(x, y, size float32) *Alien {
 	return alien
 }
 
-// NewAlienFromResource creates an alien with a specific image resource
+// NewAlienFromResource creates an alien with a specific image resource (keeping the drawn face if it is nil or can't be decoded)
 func NewAlienFromResource(x, y, size float32, resource fyne.Resource) *Alien {
-	// For now, just create a simple circle alien
-	return NewAlien(x, y, size)
+	alien := NewAlien(x, y, size)
+	if resource != nil {
+		if img, _, err := image.Decode(bytes.NewReader(resource.Content())); err == nil {
+			alien.setSource(img)
+		}
+	}
+	return alien
 }
 
-// NewAlienFromFile creates an alien with an image from file
+// NewAlienFromFile creates an alien with an image from file (keeping the drawn face if it can't be read)
 func NewAlienFromFile(x, y, size float32, filename string) *Alien {
 	alien := NewAlien(x, y, size)
 
-		// Load image from file using absolute path
+	// Load image from file using absolute path
 	cwd, _ := os.Getwd()
 	fullPath := filepath.Join(cwd, filename)
-	resource := storage.NewFileURI(fullPath)
-	alien.Image = canvas.NewImageFromURI(resource)
-
-	// Fallback to human.png if alien.png doesn't exist
-	if _, err := os.Stat(fullPath); os.IsNotExist(err) {
-		humanPath := filepath.Join(cwd, "human.png")
-		resource = storage.NewFileURI(humanPath)
-		alien.Image = canvas.NewImageFromURI(resource)
-	}
 
-	alien.Image.FillMode = canvas.ImageFillOriginal
-	alien.Image.ScaleMode = canvas.ImageScaleSmooth
-	alien.Image.Resize(fyne.NewSize(size, size))
+	if img, err := decodeImageFile(fullPath); err == nil {
+		alien.setSource(img)
+	}
+	return alien
+}
 
-	// Update container with new image
-	alien.ImageContainer = container.NewWithoutLayout(alien.Image)
+// decodeImageFile reads and decodes an image file
+func decodeImageFile(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
 
-	return alien
+	img, _, err := image.Decode(file)
+	return img, err
 }
 
-// Update handles the alien's drift behavior
-func (a *Alien) Update() {
+// Update handles the alien's drift behavior and its curiosity about the human's firing
+func (a *Alien) Update(ctx *AlienContext) {
 	if !a.IsActive {
 		return
 	}
 
-	// Alien drifting peacefully through space
+	// Notice the human firing
+	if ctx != nil {
+		a.updateCuriosity(ctx)
+	}
+
+	// Materialize or dissolve (teleporting once dissolved)
+	a.updateFade()
+
+	// Now and then, say something to the human in passing
+	a.Dialogue.Update(a, ctx)
+
+	// Move orbs in flight and, if hostile, fire at the human
+	a.updateHostility(ctx)
+
+	// Swirl (and eventually close) the last wormhole
+	a.Wormholes.Update()
+
+	switch {
+	case a.IsCurious:
+		a.approachFiringEye(ctx)
+	case a.IsObserving:
+		a.observeHuman(ctx)
+	case a.IsDeparting:
+		// Keep drifting away, dissolving as it goes
+	case a.IsInvestigating:
+		a.investigate()
+	default:
+		// Alien drifting peacefully through space
+		if a.interestCooldown > 0 {
+			a.interestCooldown--
+		}
 
-	// Update drift timer
-	a.DriftTimer--
+		// Update drift timer
+		a.DriftTimer--
+
+		// A phasing alien starts dissolving so it is gone just as the timer runs out
+		if a.phaseOut && a.fading == 0 && a.DriftTimer <= alienFadeFrames {
+			a.fading = -1
+		}
 
-	// Change direction randomly when timer expires
-	if a.DriftTimer <= 0 {
-		a.changeDirection()
-		a.DriftTimer = rand.Intn(300) + 180 // 3-8 seconds
+		// Change direction randomly when timer expires (sometimes phasing out at the end of the next drift)
+		if a.DriftTimer <= 0 {
+			a.changeDirection()
+			a.DriftTimer = rand.Intn(300) + 180 // 3-8 seconds
+			a.phaseOut = rand.Float32() < alienPhaseChance
+		}
 	}
 
 	// Apply drift movement
@@ -122,13 +232,114 @@ func (a *Alien) Update() {
 	// Add subtle floating motion
 	a.PhaseOffset += 0.02 // Slow phase increment
 
-	// Wrap around screen edges for mysterious appearances
-	a.wrapAroundScreen()
+	if a.IsDeparting {
+		// Once it has left the arena, come back later from a random edge
+		margin := a.Size
+		if a.X < -margin || a.X > a.Bounds.Width+margin || a.Y < -margin || a.Y > a.Bounds.Height+margin {
+			a.Respawn()
+		}
+	} else {
+		// Leaving the screen opens a wormhole for a mysterious reappearance
+		a.travelByWormhole()
+	}
 
 	// Update visual position with floating effect
 	a.UpdatePosition()
 }
 
+// updateCuriosity builds curiosity from new shots and starts an approach when it gets high enough
+func (a *Alien) updateCuriosity(ctx *AlienContext) {
+	newShots := ctx.ShotsFired - a.lastShotsFired
+	a.lastShotsFired = ctx.ShotsFired
+	if newShots < 0 {
+		newShots = 0 // Shot counter was reset
+	}
+	a.Curiosity = a.Curiosity*alienCuriosityDecay + float32(newShots)
+
+	if a.CuriosityCooldown > 0 {
+		a.CuriosityCooldown--
+		return
+	}
+
+	if !a.IsCurious && !a.IsObserving && !a.IsDeparting && ctx.HumanActive && a.Curiosity >= alienCuriosityThreshold {
+		a.IsCurious = true
+		a.IsInvestigating = false // The human's firing is far more interesting
+		a.IsHovering = false
+	}
+}
+
+// approachFiringEye drifts toward the firing location and starts observing once close enough
+func (a *Alien) approachFiringEye(ctx *AlienContext) {
+	if ctx == nil || !ctx.HumanActive {
+		a.startDeparture(ctx)
+		return
+	}
+
+	dx := ctx.FiringX - a.X
+	dy := ctx.FiringY - a.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+
+	if distance <= alienHoverDistance {
+		a.IsCurious = false
+		a.IsObserving = true
+		a.ObserveTimer = alienObserveFrames
+		a.scanPhase = 0
+		a.ScanRing.Show()
+		return
+	}
+
+	a.VX = dx / distance * alienApproachSpeed
+	a.VY = dy / distance * alienApproachSpeed
+}
+
+// observeHuman hovers near the firing eye and pulses the scan ring over the human
+func (a *Alien) observeHuman(ctx *AlienContext) {
+	if ctx == nil || !ctx.HumanActive {
+		a.startDeparture(ctx)
+		return
+	}
+
+	// Gently keep hovering distance as the human moves
+	dx := ctx.FiringX - a.X
+	dy := ctx.FiringY - a.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	a.VX, a.VY = 0, 0
+	if distance > alienHoverDistance {
+		a.VX = dx / distance * alienApproachSpeed * 0.5
+		a.VY = dy / distance * alienApproachSpeed * 0.5
+	}
+
+	// Pulse the scan ring around the human
+	a.scanPhase += 0.15
+	ringRadius := ctx.HumanSize * (0.9 + 0.3*float32(math.Sin(float64(a.scanPhase))))
+	a.ScanRing.Resize(fyne.NewSize(ringRadius*2, ringRadius*2))
+	a.ScanRing.Move(fyne.NewPos(ctx.HumanX-ringRadius, ctx.HumanY-ringRadius))
+
+	a.ObserveTimer--
+	if a.ObserveTimer <= 0 {
+		a.startDeparture(ctx)
+	}
+}
+
+// startDeparture stops observing and sends the alien away from the human
+func (a *Alien) startDeparture(ctx *AlienContext) {
+	a.IsCurious = false
+	a.IsObserving = false
+	a.IsDeparting = true
+	a.ScanRing.Hide()
+	a.Curiosity = 0
+	a.CuriosityCooldown = alienCuriosityCooldown
+	a.fading = -1 // Dissolve on the way out
+
+	// Head directly away from the human (or anywhere if there's no human)
+	angle := rand.Float64() * 2 * math.Pi
+	if ctx != nil {
+		angle = math.Atan2(float64(a.Y-ctx.HumanY), float64(a.X-ctx.HumanX))
+	}
+	a.VX = float32(math.Cos(angle)) * alienDepartSpeed
+	a.VY = float32(math.Sin(angle)) * alienDepartSpeed
+}
+
 // changeDirection randomly changes the alien's drift direction
 func (a *Alien) changeDirection() {
 	// Generate new random drift velocity (very slow)
@@ -143,23 +354,23 @@ func (a *Alien) changeDirection() {
 	}
 }
 
-// wrapAroundScreen makes the alien wrap around screen edges
-func (a *Alien) wrapAroundScreen() {
-	margin := a.Size
-
-	// Wrap horizontally
-	if a.X < -margin {
-		a.X = a.Bounds.Width + margin
-	} else if a.X > a.Bounds.Width + margin {
-		a.X = -margin
+// travelByWormhole opens a wormhole where the alien drifted off screen and brings it out of the paired
+// wormhole at a random spot in the arena
+func (a *Alien) travelByWormhole() {
+	margin := a.Size / 2
+	if a.X >= -margin && a.X <= a.Bounds.Width+margin && a.Y >= -margin && a.Y <= a.Bounds.Height+margin {
+		return
 	}
 
-	// Wrap vertically
-	if a.Y < -margin {
-		a.Y = a.Bounds.Height + margin
-	} else if a.Y > a.Bounds.Height + margin {
-		a.Y = -margin
-	}
+	entranceX := clampCoordinate(a.X, wormholeRadius, a.Bounds.Width-wormholeRadius)
+	entranceY := clampCoordinate(a.Y, wormholeRadius, a.Bounds.Height-wormholeRadius)
+	exitX := wormholeRadius + rand.Float32()*(a.Bounds.Width-2*wormholeRadius)
+	exitY := wormholeRadius + rand.Float32()*(a.Bounds.Height-2*wormholeRadius)
+	a.Wormholes.Open(entranceX, entranceY, exitX, exitY)
+
+	a.X, a.Y = exitX, exitY
+	a.Dialogue.Hide()
+	a.materialize()
 }
 
 // UpdatePosition updates the visual position of the alien
@@ -176,24 +387,26 @@ func (a *Alien) UpdatePosition() {
 	baseX := a.X - a.Size/2
 	baseY := displayY - a.Size/2
 
-	// Update image position
-	a.Image.Move(fyne.NewPos(baseX, baseY))
+	// Update face position
+	a.Face.Move(fyne.NewPos(baseX, baseY))
 
 	// Keep consistent size
-	a.Image.Resize(fyne.NewSize(a.Size, a.Size))
-}
+	a.Face.Resize(fyne.NewSize(a.Size, a.Size))
+	a.Drawn.place(baseX, baseY, a.Size)
 
-// SetAlpha sets the transparency of the alien (0.0 = invisible, 1.0 = opaque)
-func (a *Alien) SetAlpha(alpha float32) {
-	a.Alpha = alpha
-	// Note: Fyne doesn't have direct alpha support for images
-	// This could be implemented with custom rendering if needed
+	// Keep any speech bubble above the face
+	if a.Dialogue.Bubble.IsVisible {
+		a.Dialogue.Bubble.MoveAbove(a.X, baseY)
+	}
 }
 
 // Hide makes the alien invisible
 func (a *Alien) Hide() {
 	a.IsActive = false
 	a.ImageContainer.Hide()
+	a.ScanRing.Hide()
+	a.Dialogue.Hide()
+	a.Wormholes.Close()
 }
 
 // Show makes the alien visible
@@ -204,7 +417,8 @@ func (a *Alien) Show() {
 
 // GetVisualComponents returns the alien's visual components for UI management
 func (a *Alien) GetVisualComponents() []fyne.CanvasObject {
-	return []fyne.CanvasObject{a.ImageContainer}
+	components := append(a.Wormholes.GetVisualComponents(), a.ScanRing, a.ImageContainer)
+	return append(components, a.Dialogue.Bubble.GetVisualComponents()...)
 }
 
 // SetBounds updates the movement bounds for the alien
@@ -216,6 +430,17 @@ func (a *Alien) SetBounds(bounds fyne.Size) {
 func (a *Alien) Respawn() {
 	margin := a.Size
 
+	// Forget any curiosity in progress
+	a.IsCurious = false
+	a.IsObserving = false
+	a.IsDeparting = false
+	a.IsInvestigating = false
+	a.IsHovering = false
+	a.ScanRing.Hide()
+	a.Dialogue.Hide()
+	a.phaseOut = false
+	a.materialize()
+
 	// Choose random edge (0=top, 1=right, 2=bottom, 3=left)
 	edge := rand.Intn(4)
 
@@ -0,0 +1,92 @@
+package physics
+
+import (
+	"fmt"
+	"image/color"
+	"math/rand"
+	"strings"
+)
+
+// Alien message fragments, combined at random into cryptic transmissions
+var (
+	alienWords   = []string{"ZORP", "KRELL", "UUN", "VASH", "QUI'TH", "OROM", "XEL"}
+	alienPhrases = []string{
+		"the eyes are counting you",
+		"we have seen this orbit before",
+		"your dragon is very small",
+		"seven stars, one eye",
+		"do not trust the purple one",
+		"the bullets return to the sun",
+		"you are sample number",
+		"signal lost... signal found",
+		"the balls remember everything",
+		"we come in peace. mostly",
+	}
+	alienEndings = []string{"...", ".", "?", "!", "..?"}
+)
+
+// AlienDialogue schedules the alien's occasional speech bubbles when it drifts past the human
+type AlienDialogue struct {
+	Range     float32       // how close to the human the alien must pass to speak
+	Chance    float32       // chance per frame of speaking while in range
+	Frames    int           // frames each message stays on screen
+	Cooldown  int           // frames of silence after a message
+	Bubble    *SpeechBubble // bubble shown above the alien
+	coolTimer int           // frames until the alien may speak again
+}
+
+// NewAlienDialogue creates a dialogue scheduler with an alien-green speech bubble
+func NewAlienDialogue() *AlienDialogue {
+	bubble := NewSpeechBubble()
+	bubble.Background.FillColor = color.RGBA{R: 10, G: 40, B: 20, A: 210}    // Dark translucent green
+	bubble.Background.StrokeColor = color.RGBA{R: 0, G: 255, B: 150, A: 200} // Alien green
+	bubble.Text.Color = color.RGBA{R: 150, G: 255, B: 190, A: 255}
+	bubble.Text.TextStyle.Monospace = true
+
+	return &AlienDialogue{
+		Range:     160,
+		Chance:    0.01, // Usually speaks within a couple of seconds of passing close
+		Frames:    180,  // 3 seconds per message
+		Cooldown:  900,  // 15 seconds between messages keeps it rare
+		Bubble:    bubble,
+		coolTimer: rand.Intn(600),
+	}
+}
+
+// Update counts down the current message and sometimes starts a new one while the alien is near the human
+func (d *AlienDialogue) Update(a *Alien, ctx *AlienContext) {
+	d.Bubble.Update()
+	if d.coolTimer > 0 {
+		d.coolTimer--
+	}
+
+	// Only a fully materialized alien speaks
+	if d.Bubble.IsVisible || d.coolTimer > 0 || ctx == nil || !ctx.HumanActive || a.fading != 0 || a.Alpha < alienMaxAlpha {
+		return
+	}
+	dx := ctx.HumanX - a.X
+	dy := ctx.HumanY - a.Y
+	if dx*dx+dy*dy > d.Range*d.Range || rand.Float32() >= d.Chance {
+		return
+	}
+
+	d.Bubble.Say(alienMessage(), d.Frames)
+	d.coolTimer = d.Cooldown
+}
+
+// Hide silences the alien mid-message
+func (d *AlienDialogue) Hide() {
+	d.Bubble.Hide()
+}
+
+// alienMessage makes up a cryptic message, sometimes opening with a word of the alien's own language
+func alienMessage() string {
+	message := alienPhrases[rand.Intn(len(alienPhrases))]
+	if strings.HasSuffix(message, "number") {
+		message += fmt.Sprintf(" %02d", rand.Intn(100))
+	}
+	if rand.Float32() < 0.5 {
+		message = alienWords[rand.Intn(len(alienWords))] + ". " + message
+	}
+	return message + alienEndings[rand.Intn(len(alienEndings))]
+}
@@ -0,0 +1,87 @@
+package physics
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Drawn alien face colors (at full opacity; the alien's alpha fades them)
+var (
+	alienSkinColor    = color.RGBA{R: 150, G: 215, B: 160, A: 255} // Pale green skin
+	alienOutlineColor = color.RGBA{R: 40, G: 90, B: 50, A: 255}    // Dark green outline
+	alienEyeColor     = color.RGBA{R: 10, G: 15, B: 20, A: 255}    // Glossy black eyes
+	alienGlintColor   = color.RGBA{R: 220, G: 255, B: 230, A: 255} // Eye highlights
+)
+
+// AlienDrawnFace is the alien's face built from canvas primitives, shown when it has no face image
+type AlienDrawnFace struct {
+	Head       *canvas.Circle // Tall oval head
+	LeftEye    *canvas.Circle // Large almond eyes
+	RightEye   *canvas.Circle
+	LeftGlint  *canvas.Circle // Highlights on the eyes
+	RightGlint *canvas.Circle
+	Mouth      *canvas.Line // Thin slit of a mouth
+}
+
+// newAlienDrawnFace creates the drawn face's parts
+func newAlienDrawnFace() *AlienDrawnFace {
+	return &AlienDrawnFace{
+		Head:       &canvas.Circle{StrokeWidth: 2},
+		LeftEye:    &canvas.Circle{},
+		RightEye:   &canvas.Circle{},
+		LeftGlint:  &canvas.Circle{},
+		RightGlint: &canvas.Circle{},
+		Mouth:      &canvas.Line{StrokeWidth: 1.5},
+	}
+}
+
+// objects returns the face's parts, back to front
+func (f *AlienDrawnFace) objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{f.Head, f.LeftEye, f.RightEye, f.LeftGlint, f.RightGlint, f.Mouth}
+}
+
+// setVisible shows or hides every part of the face
+func (f *AlienDrawnFace) setVisible(visible bool) {
+	for _, object := range f.objects() {
+		setVisible(object, visible)
+	}
+}
+
+// place lays the face out in the size x size box whose top-left corner is (x, y)
+func (f *AlienDrawnFace) place(x, y, size float32) {
+	ellipse := func(circle *canvas.Circle, centerX, centerY, width, height float32) {
+		circle.Move(fyne.NewPos(x+size*(centerX-width/2), y+size*(centerY-height/2)))
+		circle.Resize(fyne.NewSize(size*width, size*height))
+	}
+
+	ellipse(f.Head, 0.5, 0.5, 0.7, 0.95)
+	ellipse(f.LeftEye, 0.36, 0.45, 0.24, 0.16)
+	ellipse(f.RightEye, 0.64, 0.45, 0.24, 0.16)
+	ellipse(f.LeftGlint, 0.32, 0.42, 0.05, 0.04)
+	ellipse(f.RightGlint, 0.60, 0.42, 0.05, 0.04)
+
+	f.Mouth.Position1 = fyne.NewPos(x+size*0.45, y+size*0.75)
+	f.Mouth.Position2 = fyne.NewPos(x+size*0.55, y+size*0.75)
+}
+
+// setAlpha recolors the face at the given opacity
+func (f *AlienDrawnFace) setAlpha(alpha float32) {
+	f.Head.FillColor = fadeColor(alienSkinColor, alpha)
+	f.Head.StrokeColor = fadeColor(alienOutlineColor, alpha)
+	f.LeftEye.FillColor = fadeColor(alienEyeColor, alpha)
+	f.RightEye.FillColor = fadeColor(alienEyeColor, alpha)
+	f.LeftGlint.FillColor = fadeColor(alienGlintColor, alpha)
+	f.RightGlint.FillColor = fadeColor(alienGlintColor, alpha)
+	f.Mouth.StrokeColor = fadeColor(alienOutlineColor, alpha)
+	for _, object := range f.objects() {
+		object.Refresh()
+	}
+}
+
+// fadeColor returns the color with its alpha scaled
+func fadeColor(c color.RGBA, alpha float32) color.RGBA {
+	c.A = uint8(float32(c.A)*alpha + 0.5)
+	return c
+}
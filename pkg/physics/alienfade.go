@@ -0,0 +1,151 @@
+package physics
+
+import (
+	"image"
+	"math/rand"
+)
+
+// Alien phasing tuning
+const (
+	alienMaxAlpha    = float32(0.7) // alpha of a fully materialized alien
+	alienFadeFrames  = 90           // frames to materialize or dissolve (1.5 seconds)
+	alienAlphaSteps  = 16           // distinct fade levels rendered (and cached) for the face
+	alienPhaseChance = float32(0.3) // chance a drift ends with the alien phasing to a new spot
+)
+
+// SetAlpha sets the transparency of the alien (0.0 = invisible, 1.0 = opaque)
+func (a *Alien) SetAlpha(alpha float32) {
+	if alpha < 0 {
+		alpha = 0
+	} else if alpha > 1 {
+		alpha = 1
+	}
+	changed := alphaStep(alpha) != alphaStep(a.Alpha)
+	a.Alpha = alpha
+	if changed {
+		a.Face.Refresh()
+		a.Drawn.setAlpha(alienAlphaLevel(a.Alpha))
+	}
+}
+
+// alphaStep quantizes an alpha to one of the cached fade levels
+func alphaStep(alpha float32) int {
+	return int(alpha*alienAlphaSteps + 0.5)
+}
+
+// alienAlphaLevel returns the fade level an alpha is drawn at
+func alienAlphaLevel(alpha float32) float32 {
+	return float32(alphaStep(alpha)) / alienAlphaSteps
+}
+
+// updateFade advances any materializing or dissolving, and teleports the alien once it has dissolved
+func (a *Alien) updateFade() {
+	// Curiosity wins over a scheduled phase: stay visible to watch the human
+	if a.fading < 0 && !a.IsDeparting && (a.IsCurious || a.IsObserving) {
+		a.phaseOut = false
+		a.fading = 1
+	}
+
+	switch {
+	case a.fading > 0:
+		if alpha := a.Alpha + alienMaxAlpha/alienFadeFrames; alpha < alienMaxAlpha {
+			a.SetAlpha(alpha)
+		} else {
+			a.SetAlpha(alienMaxAlpha)
+			a.fading = 0
+		}
+	case a.fading < 0:
+		if alpha := a.Alpha - alienMaxAlpha/alienFadeFrames; alpha > 0 {
+			a.SetAlpha(alpha)
+		} else {
+			a.SetAlpha(0)
+			a.fading = 0
+			a.dissolved()
+		}
+	}
+}
+
+// dissolved teleports a fully faded alien: a departing alien re-enters from an edge, a drifting one
+// phases to a random spot in the arena. Either way it materializes again.
+func (a *Alien) dissolved() {
+	if a.IsDeparting {
+		a.Respawn()
+		return
+	}
+
+	a.phaseOut = false
+	a.Dialogue.Hide()
+	a.X = rand.Float32() * a.Bounds.Width
+	a.Y = rand.Float32() * a.Bounds.Height
+	a.changeDirection()
+	a.DriftTimer = rand.Intn(300) + 180 // 3-8 seconds
+	a.materialize()
+}
+
+// materialize makes the alien fade in from nothing
+func (a *Alien) materialize() {
+	a.SetAlpha(0)
+	a.fading = 1
+	a.fireTimer = alienFireWarmup // Give the human a moment before a hostile alien opens fire
+}
+
+// faceFrame draws the face image at the current alpha. Fyne has no alpha for images, so each fade level
+// is a pre-multiplied copy of the scaled image, cached until the face is drawn at a different size.
+func (a *Alien) faceFrame(w, h int) image.Image {
+	a.frameMu.Lock()
+	defer a.frameMu.Unlock()
+
+	size := image.Pt(w, h)
+	if a.source == nil || w <= 0 || h <= 0 {
+		return image.NewRGBA(image.Rect(0, 0, 1, 1))
+	}
+	if size != a.frameSize {
+		a.frameSize = size
+		a.frames = map[int]*image.RGBA{alienAlphaSteps: scaleImage(a.source, w, h)}
+	}
+
+	step := alphaStep(a.Alpha)
+	if frame, ok := a.frames[step]; ok {
+		return frame
+	}
+	frame := fadeImage(a.frames[alienAlphaSteps], alienAlphaLevel(a.Alpha))
+	a.frames[step] = frame
+	return frame
+}
+
+// setSource gives the alien a new face image, dropping any frames drawn from the old one.
+// Without an image the alien falls back to its drawn face.
+func (a *Alien) setSource(img image.Image) {
+	a.frameMu.Lock()
+	a.source = img
+	a.frames = nil
+	a.frameSize = image.Point{}
+	a.frameMu.Unlock()
+
+	setVisible(a.Face, img != nil)
+	a.Drawn.setVisible(img == nil)
+	a.Face.Refresh()
+}
+
+// scaleImage resizes an image to w x h (nearest neighbour) as pre-multiplied RGBA
+func scaleImage(src image.Image, w, h int) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		srcY := bounds.Min.Y + y*bounds.Dy()/h
+		for x := 0; x < w; x++ {
+			srcX := bounds.Min.X + x*bounds.Dx()/w
+			dst.Set(x, y, src.At(srcX, srcY))
+		}
+	}
+	return dst
+}
+
+// fadeImage returns a copy of a pre-multiplied image with every channel scaled by alpha
+func fadeImage(src *image.RGBA, alpha float32) *image.RGBA {
+	dst := image.NewRGBA(src.Rect)
+	for i, value := range src.Pix {
+		dst.Pix[i] = uint8(float32(value)*alpha + 0.5)
+	}
+	return dst
+}
@@ -0,0 +1,83 @@
+package physics
+
+import "math"
+
+// Alien interest in game events tuning
+const (
+	alienInterestRange      = float32(400) // how far away an alien notices an explosion or lightning strike
+	alienInterestHover      = float32(50)  // how close the alien hovers over the event
+	alienInterestTimeout    = 600          // frames to reach the event before losing interest (10 seconds)
+	alienInterestFrames     = 180          // frames spent hovering over the event (3 seconds)
+	alienInterestCooldown   = 300          // frames of random drift before it notices another event
+	alienInterestHoverSpeed = float32(0.3) // drift speed while hovering, circling the spot
+)
+
+// WatchEvents makes the alien curious about explosions and lightning strikes published on the bus
+func (a *Alien) WatchEvents(bus *EventBus) {
+	notice := func(event Event) {
+		a.notice(event.X, event.Y)
+	}
+	bus.Subscribe(EventExplosion, notice)
+	bus.Subscribe(EventLightning, notice)
+}
+
+// notice sends a drifting alien toward an event at (x, y) if it is close enough to care.
+// An alien already hovering over an event, or busy with the human, ignores it.
+func (a *Alien) notice(x, y float32) {
+	if !a.IsActive || a.IsCurious || a.IsObserving || a.IsDeparting || a.IsHovering || a.interestCooldown > 0 {
+		return
+	}
+	dx := x - a.X
+	dy := y - a.Y
+	if dx*dx+dy*dy > alienInterestRange*alienInterestRange {
+		return
+	}
+
+	a.IsInvestigating = true
+	a.InterestX, a.InterestY = x, y
+	a.InterestTimer = alienInterestTimeout
+}
+
+// investigate drifts to the latest event, hovers over it for a while, then goes back to drifting at random
+func (a *Alien) investigate() {
+	a.InterestTimer--
+	if a.InterestTimer <= 0 {
+		a.loseInterest()
+		return
+	}
+
+	dx := a.InterestX - a.X
+	dy := a.InterestY - a.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+
+	if !a.IsHovering {
+		if distance <= alienInterestHover {
+			a.IsHovering = true
+			a.InterestTimer = alienInterestFrames
+			return
+		}
+		a.VX = dx / distance * alienApproachSpeed
+		a.VY = dy / distance * alienApproachSpeed
+		return
+	}
+
+	// Circle slowly around the spot, as if studying it
+	a.VX, a.VY = 0, 0
+	if distance > 0 {
+		a.VX = -dy / distance * alienInterestHoverSpeed
+		a.VY = dx / distance * alienInterestHoverSpeed
+		if distance > alienInterestHover {
+			a.VX += dx / distance * alienInterestHoverSpeed
+			a.VY += dy / distance * alienInterestHoverSpeed
+		}
+	}
+}
+
+// loseInterest stops investigating and resumes random drift
+func (a *Alien) loseInterest() {
+	a.IsInvestigating = false
+	a.IsHovering = false
+	a.InterestTimer = 0
+	a.interestCooldown = alienInterestCooldown
+	a.changeDirection()
+}
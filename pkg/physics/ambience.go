@@ -0,0 +1,107 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// LayerAmbience is the name of the tinted backdrop behind every other background layer
+const LayerAmbience = "ambience"
+
+// ambienceKey is one stop of the ambience cycle
+type ambienceKey struct {
+	Tint       color.RGBA // backdrop color
+	Brightness float32    // star brightness multiplier
+}
+
+// ambienceCycle is the loop the backdrop eases through: deep blue, purple, then black, where the stars
+// shine brightest, and back to deep blue
+var ambienceCycle = []ambienceKey{
+	{Tint: color.RGBA{R: 8, G: 16, B: 48, A: 255}, Brightness: 0.75},  // Deep blue
+	{Tint: color.RGBA{R: 36, G: 12, B: 56, A: 255}, Brightness: 0.85}, // Purple
+	{Tint: color.RGBA{R: 0, G: 0, B: 0, A: 255}, Brightness: 1.0},     // Black
+}
+
+// Ambience slowly cycles the backdrop tint and the star brightness so long sessions don't look the same
+// throughout. It sits at the back of the parallax layers and doesn't move with the travel.
+type Ambience struct {
+	Period         float32           // seconds for one full cycle (0 = off)
+	StarBrightness float32           // current star brightness multiplier
+	Backdrop       *canvas.Rectangle // Tinted rectangle behind everything
+	frame          int               // frames into the current cycle
+}
+
+// NewAmbience creates an ambience cycle with the given period in seconds (0 = off)
+func NewAmbience(period float32, bounds fyne.Size) *Ambience {
+	a := &Ambience{StarBrightness: 1, Backdrop: canvas.NewRectangle(ambienceCycle[0].Tint)}
+	a.Backdrop.Resize(bounds)
+	a.SetPeriod(period)
+	return a
+}
+
+// SetPeriod sets the seconds for one full cycle; 0 turns the cycle off, leaving the plain background
+// and full star brightness
+func (a *Ambience) SetPeriod(seconds float32) {
+	if seconds < 0 {
+		seconds = 0
+	}
+	a.Period = seconds
+	setVisible(a.Backdrop, seconds > 0)
+	if seconds == 0 {
+		a.StarBrightness = 1
+		return
+	}
+	a.apply()
+}
+
+// Update advances the cycle by a frame; the backdrop ignores the streaming velocity
+func (a *Ambience) Update(vx, vy, dirX, dirY float32) {
+	if a.Period == 0 {
+		return
+	}
+	a.frame++
+	if a.frame >= a.periodFrames() {
+		a.frame = 0
+	}
+	a.apply()
+}
+
+// periodFrames returns the cycle length in frames at 60 FPS
+func (a *Ambience) periodFrames() int {
+	return max(int(a.Period*60), 1)
+}
+
+// apply sets the backdrop tint and star brightness for the current point in the cycle,
+// easing smoothly between the stops
+func (a *Ambience) apply() {
+	position := float32(a.frame) / float32(a.periodFrames()) * float32(len(ambienceCycle))
+	stop := int(position) % len(ambienceCycle)
+	from := ambienceCycle[stop]
+	to := ambienceCycle[(stop+1)%len(ambienceCycle)]
+	t := position - float32(int(position))
+	t = (1 - float32(math.Cos(float64(t)*math.Pi))) / 2 // Ease in and out
+
+	mix := func(a, b uint8) uint8 {
+		return uint8(float32(a) + (float32(b)-float32(a))*t + 0.5)
+	}
+	tint := color.RGBA{R: mix(from.Tint.R, to.Tint.R), G: mix(from.Tint.G, to.Tint.G), B: mix(from.Tint.B, to.Tint.B), A: 255}
+	a.StarBrightness = from.Brightness + (to.Brightness-from.Brightness)*t
+
+	if tint != a.Backdrop.FillColor {
+		a.Backdrop.FillColor = tint
+		a.Backdrop.Refresh()
+	}
+}
+
+// UpdateBounds resizes the backdrop
+func (a *Ambience) UpdateBounds(bounds fyne.Size) {
+	a.Backdrop.Resize(bounds)
+}
+
+// Visual returns the backdrop
+func (a *Ambience) Visual() fyne.CanvasObject {
+	return a.Backdrop
+}
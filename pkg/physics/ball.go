@@ -7,10 +7,30 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
 )
 
+// ballExplosion is the burst of sparks a ball gives off when it collides or is destroyed
+var ballExplosion = &effects.Emitter{
+	Count:  8,
+	Speed:  1.5,
+	Spread: math.Pi,
+	Even:   true,
+	Life:   30,
+	Size:   6,
+	Colors: []color.RGBA{
+		{R: 255, G: 255, B: 0, A: 255},   // Yellow
+		{R: 255, G: 165, B: 0, A: 255},   // Orange
+		{R: 255, G: 0, B: 0, A: 255},     // Red
+		{R: 255, G: 255, B: 255, A: 255}, // White
+	},
+	Stroke:      color.RGBA{R: 255, G: 255, B: 255, A: 255},
+	StrokeWidth: 1.0,
+}
+
 // Ball represents a ball with position and velocity
 type Ball struct {
+	ID         int     // unique identifier for runtime add/remove
 	X, Y       float32 // current position
 	VX, VY     float32 // velocity
 	Radius     float32 // ball radius
@@ -19,21 +39,69 @@ type Ball struct {
 	Pupil      *canvas.Circle // Black pupil (center)
 	BloodVeins []*canvas.Line  // Red bloodshot veins
 	Text       *canvas.Text // AI LLM name label
+	Shadow     *Shadow      // Ground shadow (drawn on the shadow layer)
+	HPBar      *HPBar       // health bar shown above bosses (nil for other balls)
 	LLMName    string       // AI LLM name
+	Steering   Steering     // movement personality picked from the LLM name (nil = plain bouncing)
+	Charge     float32      // magnetic charge: +1, -1 or 0 (neutral)
+	Kind       BallKind     // collision behavior (standard, ghost, explosive, heavy)
 	Bounds     fyne.Size    // animation bounds
+	World      *World       // rules the ball plays by (nil = the default rules)
 	IsAnimated bool         // whether animation is running
-	// Particle trail system
-	Trail      []*canvas.Circle
-	TrailIndex int
+	Frozen     bool         // stopped in place from the ball menu; stays still until unfrozen
+	// Ribbon trail sampled from position history
+	Trail        *Ribbon         // ribbon trail following the ball (see trail.go)
 	// Jiggle effect for jello-like bouncing
 	JiggleAmplitude float32 // Current jiggle strength
 	JigglePhase     float32 // Current phase of jiggle oscillation
 	JiggleDecay     float32 // How fast jiggle fades
 	OriginalRadius  float32 // Original radius before jiggle
+	// Pupil dilation (the pupil widens as the human gets closer)
+	PupilRatio float32 // current pupil radius as a fraction of the eyeball radius
+	// Heat map mode (iris recolored by speed; the real colors are kept here)
+	isHeatMapped    bool        // whether the iris currently shows the speed color
+	savedIrisFill   color.Color // iris fill before heat map mode
+	savedIrisStroke color.Color // iris stroke before heat map mode
+	// Regrowth after being shrunk
+	FullRadius float32 // size the ball slowly regrows toward
+	GrowthRate float32 // radius regained per frame (0 = no regrowth)
 	// Explosion effects for ball collisions
-	ExplosionParticles []*canvas.Circle
-	ExplosionTimer     int  // frames for explosion animation
-	IsExploding        bool // whether ball is currently exploding
+	Particles        *effects.ParticleSystem // where explosion sparks and burn flames are emitted (set by the UI; nil = none)
+	ExplosionTimer   int                     // frames for explosion animation
+	IsExploding      bool                    // whether ball is currently exploding
+	explosionPending bool                    // explosion not yet reported to the UI
+	pendingBlasts    []Blast                 // explosions and boss slams not yet applied to the humans
+	Ghosts           *effects.MotionGhosts   // fading copies left behind at high speed (nil = off, see ghosts.go)
+	// Health
+	HP          int  // remaining hit points
+	MaxHP       int  // hit points at full health
+	IsDestroyed bool // whether the ball has been destroyed (removed after its explosion)
+	// Status effects (see status.go)
+	StunTimer   int // frames remaining while stunned (ball stops moving)
+	FrozenTimer int // frames remaining while frozen (ball moves at half speed)
+	BurnTimer   int // frames remaining while burning (ball loses HP over time)
+	burnTick    int // frames until the next burn damage
+	// Merge absorption state (this ball is being swallowed by AbsorbTarget)
+	IsAbsorbed   bool  // whether this ball is being absorbed into another
+	AbsorbTarget *Ball // ball absorbing this one
+	AbsorbTimer  int   // frames remaining in the absorption animation
+	// Dragon catch and throw (see carry.go)
+	CarriedBy *Dragon // dragon holding this ball in its claws (nil = free)
+}
+
+// BallSpeedScale multiplies how far every ball travels each frame (set from the settings dialog)
+var BallSpeedScale = float32(1)
+
+// BallScoreValue is the score granted for destroying a regular ball (see ScoreValue)
+const BallScoreValue = 100
+
+// hpForRadius returns the starting hit points for a ball of the given radius (bigger balls are tougher)
+func hpForRadius(radius float32) int {
+	hp := int(radius / 5)
+	if hp < 3 {
+		hp = 3
+	}
+	return hp
 }
 
 // AI LLM names to choose from
@@ -52,6 +120,15 @@ var llmNames = []string{
 	"Llama2",
 }
 
+// lastBallID holds the most recently assigned ball ID
+var lastBallID int
+
+// nextBallID returns a new unique ball ID
+func nextBallID() int {
+	lastBallID++
+	return lastBallID
+}
+
 // getRandomLLMName returns a random AI LLM name
 func getRandomLLMName() string {
 	return llmNames[rand.Intn(len(llmNames))]
@@ -114,6 +191,7 @@ func (b *Ball) updateTextSize() {
 // NewBall creates a new bouncing ball that looks like an eyeball
 func NewBall() *Ball {
 	ball := &Ball{
+		ID:      nextBallID(),
 		X:       100,
 		Y:       100,
 		VX:      3.5, // horizontal velocity
@@ -124,12 +202,16 @@ func NewBall() *Ball {
 		// Initialize jiggle properties
 		JiggleAmplitude: 0.0,
 		JigglePhase:     0.0,
-		JiggleDecay:     0.88, // Decay rate for jiggle amplitude
+		JiggleDecay:     Tuning().JiggleDecay, // Decay rate for jiggle amplitude
 		OriginalRadius:  30,
+		FullRadius:      30,
+		GrowthRate:      Tuning().BallGrowthRate,
+		PupilRatio:      defaultPupilRatio,
+		HP:              hpForRadius(30),
+		MaxHP:           hpForRadius(30),
 		// Initialize explosion properties
-		ExplosionParticles: nil,
-		ExplosionTimer:     0,
-		IsExploding:        false,
+		ExplosionTimer: 0,
+		IsExploding:    false,
 	}
 
 	// Create the eyeball background (white sclera)
@@ -163,6 +245,13 @@ func NewBall() *Ball {
 		ball.BloodVeins[i] = vein
 	}
 
+	// Create the ground shadow
+	ball.Shadow = NewShadow()
+
+	// Pick the movement personality for this LLM
+	ball.Steering = SteeringForLLM(ball.LLMName)
+	ball.Charge = randomCharge()
+
 	// Create the text label for the AI LLM name - bright and visible against star field
 	ball.Text = &canvas.Text{
 		Text:      ball.LLMName,
@@ -188,71 +277,46 @@ func NewBall() *Ball {
 
 	ball.UpdatePosition()
 
-	// Initialize particle trail
+	// Initialize ribbon trail
 	ball.initializeTrail()
 
+	// Draw it in the current theme
+	ball.ApplyTheme()
+
 	return ball
 }
 
-// initializeTrail creates the particle trail for the ball
-func (b *Ball) initializeTrail() {
-	// Clean up existing trail particles first
-	if b.Trail != nil {
-		for _, trail := range b.Trail {
-			if trail != nil {
-				trail.Hide()
-			}
-		}
-	}
+// UpdatePosition updates the visual position of the eyeball components
+func (b *Ball) UpdatePosition() {
+	b.UpdatePositionWithHuman(0, 0) // Default position when no human tracking
+}
 
-	trailLength := 10 // Number of trail particles
-	b.Trail = make([]*canvas.Circle, trailLength)
-	b.TrailIndex = 0 // Reset trail index
+// defaultPupilRatio is the pupil size (as a fraction of the eyeball radius) when no human is tracked
+const defaultPupilRatio = float32(0.3)
 
-	for i := 0; i < trailLength; i++ {
-		trail := &canvas.Circle{
-			FillColor:   color.RGBA{R: 255, G: 255, B: 255, A: uint8(255 - i*20)}, // Fading trail
-			StrokeColor: color.RGBA{R: 255, G: 255, B: 255, A: 0},
-			StrokeWidth: 0,
-		}
-		size := b.Radius * 0.3 * (1.0 - float32(i)*0.1) // Decreasing size
-		trail.Resize(fyne.NewSize(size*2, size*2))
-		trail.Move(fyne.NewPos(b.X-size, b.Y-size))
-		b.Trail[i] = trail
-	}
-}
+// pupilDilationEase is how quickly the pupil eases toward its target size each frame
+const pupilDilationEase = float32(0.1)
 
-// updateTrail updates the particle trail positions
-func (b *Ball) updateTrail() {
-	if len(b.Trail) == 0 {
-		return
-	}
+// updatePupilDilation eases the pupil toward its target size: fully dilated when the human is
+// right next to the ball, fully constricted at Tuning().PupilDilationRange or further away
+func (b *Ball) updatePupilDilation(humanX, humanY float32) {
+	target := defaultPupilRatio
+	if humanX != 0 || humanY != 0 {
+		dx := humanX - b.X
+		dy := humanY - b.Y
+		distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
 
-	// Move current trail position to the ball's old position
-	currentTrail := b.Trail[b.TrailIndex]
-	if currentTrail != nil {
-		size := b.Radius * 0.3 * (1.0 - float32(b.TrailIndex)*0.1)
-		currentTrail.Move(fyne.NewPos(b.X-size, b.Y-size))
-
-		// Update trail color based on ball color
-		ballColor := b.Circle.FillColor.(color.RGBA)
-		alpha := uint8(255 - b.TrailIndex*20)
-		currentTrail.FillColor = color.RGBA{
-			R: ballColor.R,
-			G: ballColor.G,
-			B: ballColor.B,
-			A: alpha,
+		closeness := float32(0)
+		if Tuning().PupilDilationRange > 0 {
+			closeness = 1 - distance/Tuning().PupilDilationRange
+		}
+		if closeness < 0 {
+			closeness = 0
 		}
-		currentTrail.Refresh()
+		target = Tuning().PupilMinRatio + (Tuning().PupilMaxRatio-Tuning().PupilMinRatio)*closeness
 	}
 
-	// Move to next trail index
-	b.TrailIndex = (b.TrailIndex + 1) % len(b.Trail)
-}
-
-// UpdatePosition updates the visual position of the eyeball components
-func (b *Ball) UpdatePosition() {
-	b.UpdatePositionWithHuman(0, 0) // Default position when no human tracking
+	b.PupilRatio += (target - b.PupilRatio) * pupilDilationEase
 }
 
 // UpdatePositionWithHuman updates the visual position of the eyeball components with human tracking
@@ -300,14 +364,18 @@ func (b *Ball) UpdatePositionWithHuman(humanX, humanY float32) {
 	b.Iris.Resize(fyne.NewSize(irisRadius*2, irisRadius*2))
 	b.Iris.Move(fyne.NewPos(b.X-irisRadius+irisOffsetX, b.Y-irisRadius+irisOffsetY))
 
-	// Update pupil position and size (30% of eyeball) - follows iris
-	pupilRadius := currentRadius * 0.3
+	// Update pupil position and size - follows iris, dilating as the human gets closer
+	b.updatePupilDilation(humanX, humanY)
+	pupilRadius := currentRadius * b.PupilRatio
 	b.Pupil.Resize(fyne.NewSize(pupilRadius*2, pupilRadius*2))
 	b.Pupil.Move(fyne.NewPos(b.X-pupilRadius+irisOffsetX, b.Y-pupilRadius+irisOffsetY))
 
 	// Update bloodshot veins position
 	b.updateBloodVeins(currentRadius)
 
+	// Keep the boss HP bar above the eyeball
+	b.updateHPBar(currentRadius)
+
 	// Update text position to be at the bottom of the eyeball (outside the eye)
 	if b.Text != nil {
 		textSize := b.Text.MinSize()
@@ -319,8 +387,14 @@ func (b *Ball) UpdatePositionWithHuman(humanX, humanY float32) {
 		b.Text.Resize(textSize)
 	}
 
-	// Update trail
+	// Update trail and motion ghosts
 	b.updateTrail()
+	b.updateGhosts()
+
+	// Shadow sits under the eyeball and lifts off the ground while jiggling
+	if b.Shadow != nil {
+		b.Shadow.Update(b.X, b.Y+currentRadius*0.85, currentRadius*1.8, b.JiggleAmplitude*2)
+	}
 }
 
 // updateBloodVeins positions the bloodshot veins around the eyeball
@@ -349,15 +423,78 @@ func (b *Ball) updateBloodVeins(radius float32) {
 
 // Update calculates the next position and handles wall bouncing
 func (b *Ball) Update() {
+	// Absorbed balls only play their absorption animation
+	if b.IsAbsorbed {
+		b.updateAbsorb()
+		return
+	}
+
+	// Destroyed balls only finish their explosion
+	if b.IsDestroyed {
+		if b.IsExploding {
+			b.UpdateExplosion()
+		}
+		return
+	}
+
 	if !b.IsAnimated {
 		return
 	}
 
-	// Update position
-	b.X += b.VX
-	b.Y += b.VY
+	// Record the position for the ribbon trail
+	b.sampleTrail()
 
-	// Bounce off walls
+	// Count down frozen/burning effects (burning can destroy the ball)
+	b.updateStatusEffects()
+	if b.IsDestroyed {
+		return
+	}
+
+	// Stunned balls hold still until the stun wears off
+	if b.StunTimer > 0 {
+		b.StunTimer--
+		if b.StunTimer == 0 {
+			b.Circle.StrokeColor = b.kindStrokeColor() // Back to the normal border
+			b.Circle.Refresh()
+		}
+		b.UpdatePosition()
+		if b.IsExploding {
+			b.UpdateExplosion()
+		}
+		return
+	}
+
+	// Slowly regrow after being shrunk
+	b.regrow()
+
+	// Update position (frozen balls cover less ground; the ball speed setting and difficulty scale every ball)
+	speedFactor := b.statusSpeedFactor() * BallSpeedScale * CurrentDifficulty.Config().BallSpeed
+	b.X += b.VX * speedFactor
+	b.Y += b.VY * speedFactor
+
+	// Bounce off, wrap around or leave through the walls
+	switch b.World.wallMode() {
+	case WallWrap:
+		b.wrapAroundWalls()
+	case WallAbsorb:
+		if b.isOutsideArena() {
+			b.leaveArena()
+			return
+		}
+	default:
+		b.bounceOffWalls()
+	}
+
+	b.UpdatePosition()
+
+	// Update explosion effects
+	if b.IsExploding {
+		b.UpdateExplosion()
+	}
+}
+
+// bounceOffWalls reflects the ball off the edges of its bounds with a jiggle
+func (b *Ball) bounceOffWalls() {
 	// Left and right walls
 	if b.X-b.Radius <= 0 || b.X+b.Radius >= b.Bounds.Width {
 		b.VX = -b.VX
@@ -371,6 +508,13 @@ func (b *Ball) Update() {
 		} else if b.X+b.Radius > b.Bounds.Width {
 			b.X = b.Bounds.Width - b.Radius
 		}
+
+		// A boss hitting the wall slams the ground where it hit
+		if b.X <= b.Radius {
+			b.slam(0, b.Y)
+		} else {
+			b.slam(b.Bounds.Width, b.Y)
+		}
 	}
 
 	// Top and bottom walls
@@ -386,19 +530,29 @@ func (b *Ball) Update() {
 		} else if b.Y+b.Radius > b.Bounds.Height {
 			b.Y = b.Bounds.Height - b.Radius
 		}
-	}
 
-	b.UpdatePosition()
-
-	// Update explosion effects
-	if b.IsExploding {
-		b.UpdateExplosion()
+		// A boss hitting the wall slams the ground where it hit
+		if b.Y <= b.Radius {
+			b.slam(b.X, 0)
+		} else {
+			b.slam(b.X, b.Bounds.Height)
+		}
 	}
 }
 
 // CheckCollision checks if this ball collides with another ball
 func (b *Ball) CheckCollision(other *Ball) bool {
-	if b == other {
+	if b == other || b.IsAbsorbed || other.IsAbsorbed || b.IsDestroyed || other.IsDestroyed {
+		return false
+	}
+
+	// A ball in the dragon's claws is out of play until it is thrown
+	if b.CarriedBy != nil || other.CarriedBy != nil {
+		return false
+	}
+
+	// Ghosts pass straight through other balls
+	if b.Kind == KindGhost || other.Kind == KindGhost {
 		return false
 	}
 
@@ -411,9 +565,31 @@ func (b *Ball) CheckCollision(other *Ball) bool {
 	return distance < (b.Radius + other.Radius)
 }
 
-// GetMass returns the mass of the ball based on its area (π * r²)
+// GetMass returns the mass of the ball based on its area (π * r²), scaled up for heavy balls
 func (b *Ball) GetMass() float32 {
-	return float32(math.Pi) * b.Radius * b.Radius
+	mass := float32(math.Pi) * b.Radius * b.Radius
+	if b.Kind == KindHeavy {
+		mass *= heavyDensity
+	}
+	return mass
+}
+
+// limitSpeed scales the ball's velocity down to maxSpeed if it is faster
+func (b *Ball) limitSpeed(maxSpeed float32) {
+	speed := float32(math.Sqrt(float64(b.VX*b.VX + b.VY*b.VY)))
+	if speed > maxSpeed {
+		b.VX = b.VX / speed * maxSpeed
+		b.VY = b.VY / speed * maxSpeed
+	}
+}
+
+// addVelocity adds a velocity change that may speed the ball up to maxSpeed but no further. A ball already
+// moving faster than that (thrown, shot or knocked) keeps its speed and is only turned by the change.
+func (b *Ball) addVelocity(dvx, dvy, maxSpeed float32) {
+	limit := max(maxSpeed, float32(math.Sqrt(float64(b.VX*b.VX+b.VY*b.VY))))
+	b.VX += dvx
+	b.VY += dvy
+	b.limitSpeed(limit)
 }
 
 // HandleCollision handles elastic collision response between two balls with different masses
@@ -422,6 +598,17 @@ func (b *Ball) HandleCollision(other *Ball) {
 		return
 	}
 
+	// Explosive balls detonate on contact instead of bouncing
+	if b.Kind == KindExplosive || other.Kind == KindExplosive {
+		if b.Kind == KindExplosive {
+			b.detonate(other)
+		}
+		if other.Kind == KindExplosive {
+			other.detonate(b)
+		}
+		return
+	}
+
 	// Calculate distance and collision normal
 	dx := b.X - other.X
 	dy := b.Y - other.Y
@@ -491,17 +678,126 @@ func (b *Ball) HandleCollision(other *Ball) {
 	b.triggerJiggle(collisionIntensity)
 	other.triggerJiggle(collisionIntensity)
 
-	// Trigger explosions for both balls
+	// Trigger explosions for both balls (a boss ramming another ball also slams the ground)
 	b.triggerExplosion()
 	other.triggerExplosion()
+	contactX := other.X + nx*other.Radius
+	contactY := other.Y + ny*other.Radius
+	b.slam(contactX, contactY)
+	other.slam(contactX, contactY)
 
 	// Reduce ball sizes by 20%
 	b.shrinkBall(0.8) // 0.8 = reduce to 80% of current size (20% reduction)
 	other.shrinkBall(0.8)
 }
 
+// CanMergeWith reports whether two colliding balls share an iris color and are moving slowly enough to merge
+func (b *Ball) CanMergeWith(other *Ball) bool {
+	if b == other || b.IsAbsorbed || other.IsAbsorbed || b.IsDestroyed || other.IsDestroyed {
+		return false
+	}
+	if b.irisColor() != other.irisColor() || b.Kind != other.Kind || b.Kind == KindBoss {
+		return false
+	}
+
+	relativeVX := b.VX - other.VX
+	relativeVY := b.VY - other.VY
+	relativeSpeed := float32(math.Sqrt(float64(relativeVX*relativeVX + relativeVY*relativeVY)))
+
+	return relativeSpeed < Tuning().MergeSpeedThreshold
+}
+
+// MergeWith absorbs other into this ball, conserving mass (area) and momentum
+func (b *Ball) MergeWith(other *Ball) {
+	m1 := b.GetMass()
+	m2 := other.GetMass()
+	totalMass := m1 + m2
+
+	// Momentum conservation: combined velocity is the mass-weighted average
+	b.VX = (b.VX*m1 + other.VX*m2) / totalMass
+	b.VY = (b.VY*m1 + other.VY*m2) / totalMass
+
+	// Pool the hit points of both balls
+	b.HP += other.HP
+	b.MaxHP += other.MaxHP
+
+	// Move to the center of mass
+	b.X = (b.X*m1 + other.X*m2) / totalMass
+	b.Y = (b.Y*m1 + other.Y*m2) / totalMass
+
+	// Mass conservation: area of the merged ball equals the sum of both areas (up to the maximum ball size)
+	mergedRadius := float32(math.Sqrt(float64(b.Radius*b.Radius + other.Radius*other.Radius)))
+	if _, maxRadius := b.World.ballSizeRange(); mergedRadius > maxRadius {
+		mergedRadius = maxRadius
+	}
+	b.setRadius(mergedRadius)
+	b.FullRadius = b.Radius // The merged size is the new full size
+	b.triggerJiggle(0.6) // Wobble as it swallows the other ball
+
+	// Start the absorption animation on the other ball
+	other.IsAbsorbed = true
+	other.IsAnimated = false
+	other.AbsorbTarget = b
+	other.AbsorbTimer = 20 // ~1/3 second at 60 FPS
+	other.Text.Hide()
+	other.Trail.Hide()
+}
+
+// updateAbsorb shrinks this ball into its absorbing ball
+func (b *Ball) updateAbsorb() {
+	if b.AbsorbTimer <= 0 {
+		return
+	}
+	b.AbsorbTimer--
+
+	// Slide toward the absorbing ball's center while shrinking away
+	if b.AbsorbTarget != nil {
+		b.X += (b.AbsorbTarget.X - b.X) * 0.3
+		b.Y += (b.AbsorbTarget.Y - b.Y) * 0.3
+	}
+	b.Radius *= 0.85
+	b.OriginalRadius = b.Radius
+	b.JiggleAmplitude = 0
+
+	b.UpdatePosition()
+
+	if b.AbsorbTimer == 0 {
+		b.Shadow.Hide()
+		b.Circle.Hide()
+		b.Iris.Hide()
+		b.Pupil.Hide()
+		for _, vein := range b.BloodVeins {
+			vein.Hide()
+		}
+	}
+}
+
+// AbsorptionComplete reports whether this ball has finished being absorbed and can be removed
+func (b *Ball) AbsorptionComplete() bool {
+	return b.IsAbsorbed && b.AbsorbTimer <= 0
+}
+
+// SetName renames the ball, updating its label, label color and font size.
+// Known LLM names also pick up that model's movement personality.
+func (b *Ball) SetName(name string) {
+	b.LLMName = name
+	if b.Kind != KindBoss { // Bosses always home in on the human
+		b.Steering = SteeringForLLM(name)
+	}
+
+	if b.Text == nil {
+		return
+	}
+	b.Text.Text = name
+	b.Text.Color = getTextColorForLLM(name)
+	b.updateTextSize()
+	b.Text.Refresh()
+}
+
 // ChangeColor cycles through different iris colors for the eyeball
 func (b *Ball) ChangeColor() {
+	b.RestoreIrisColor() // Cycle the ball's own colors, not the heat map colors
+
 	switch b.Iris.FillColor {
 	case color.RGBA{R: 100, G: 150, B: 255, A: 255}: // Blue iris
 		b.Iris.FillColor = color.RGBA{R: 100, G: 255, B: 100, A: 255} // Green iris
@@ -525,6 +821,7 @@ func (b *Ball) ChangeColor() {
 // NewCustomBall creates a ball with custom properties that looks like an eyeball
 func NewCustomBall(x, y, vx, vy, radius float32, fillColor, strokeColor color.RGBA) *Ball {
 	ball := &Ball{
+		ID:      nextBallID(),
 		X:       x,
 		Y:       y,
 		VX:      vx,
@@ -535,12 +832,16 @@ func NewCustomBall(x, y, vx, vy, radius float32, fillColor, strokeColor color.RG
 		// Initialize jiggle properties
 		JiggleAmplitude: 0.0,
 		JigglePhase:     0.0,
-		JiggleDecay:     0.88, // Decay rate for jiggle amplitude
+		JiggleDecay:     Tuning().JiggleDecay, // Decay rate for jiggle amplitude
 		OriginalRadius:  radius,
+		FullRadius:      radius,
+		GrowthRate:      Tuning().BallGrowthRate,
+		PupilRatio:      defaultPupilRatio,
+		HP:              hpForRadius(radius),
+		MaxHP:           hpForRadius(radius),
 		// Initialize explosion properties
-		ExplosionParticles: nil,
-		ExplosionTimer:     0,
-		IsExploding:        false,
+		ExplosionTimer: 0,
+		IsExploding:    false,
 	}
 
 	// Create the eyeball background (white sclera)
@@ -574,6 +875,13 @@ func NewCustomBall(x, y, vx, vy, radius float32, fillColor, strokeColor color.RG
 		ball.BloodVeins[i] = vein
 	}
 
+	// Create the ground shadow
+	ball.Shadow = NewShadow()
+
+	// Pick the movement personality for this LLM
+	ball.Steering = SteeringForLLM(ball.LLMName)
+	ball.Charge = randomCharge()
+
 	// Create the text label for the AI LLM name - bright and visible against star field
 	ball.Text = &canvas.Text{
 		Text:      ball.LLMName,
@@ -599,12 +907,23 @@ func NewCustomBall(x, y, vx, vy, radius float32, fillColor, strokeColor color.RG
 
 	ball.UpdatePosition()
 
-	// Initialize particle trail
+	// Initialize ribbon trail
 	ball.initializeTrail()
 
+	// Draw it in the current theme
+	ball.ApplyTheme()
+
 	return ball
 }
 
+// Stun freezes the ball in place for the given number of frames
+func (b *Ball) Stun(frames int) {
+	b.StunTimer = frames
+	b.Circle.StrokeColor = color.RGBA{R: 255, G: 255, B: 0, A: 255} // Electric yellow border while stunned
+	b.Circle.Refresh()
+	b.triggerJiggle(0.4)
+}
+
 // triggerJiggle starts a jiggle effect (called when ball bounces)
 func (b *Ball) triggerJiggle(intensity float32) {
 	b.JiggleAmplitude = intensity * b.OriginalRadius * 0.8 // Reduced to 0.8 for subtle, natural jiggle
@@ -620,25 +939,12 @@ func (b *Ball) triggerExplosion() {
 	b.IsExploding = true
 	b.ExplosionTimer = 30 // 30 frames explosion duration (~0.5 seconds at 60 FPS)
 
-	// Create explosion particles
-	b.ExplosionParticles = make([]*canvas.Circle, 8) // 8 explosion particles per ball
-	explosionColors := []color.RGBA{
-		{R: 255, G: 255, B: 0, A: 255},   // Yellow
-		{R: 255, G: 165, B: 0, A: 255},   // Orange
-		{R: 255, G: 0, B: 0, A: 255},     // Red
-		{R: 255, G: 255, B: 255, A: 255}, // White
-	}
+	// Throw sparks out in every direction
+	b.Particles.Emit(ballExplosion, b.X, b.Y)
+	b.explosionPending = true
 
-	for i := 0; i < 8; i++ {
-		particle := &canvas.Circle{
-			FillColor:   explosionColors[i%len(explosionColors)],
-			StrokeColor: color.RGBA{R: 255, G: 255, B: 255, A: 255},
-			StrokeWidth: 1.0,
-		}
-		particle.Resize(fyne.NewSize(6, 6)) // Small particles
-		particle.Move(fyne.NewPos(b.X-3, b.Y-3))
-		b.ExplosionParticles[i] = particle
-	}
+	// Shove any human standing close by
+	b.addBlast(b.X, b.Y, explosionBlastStrength, b.Radius*explosionBlastScale)
 }
 
 // UpdateExplosion updates the explosion animation
@@ -649,96 +955,142 @@ func (b *Ball) UpdateExplosion() {
 
 	b.ExplosionTimer--
 
-	// Animate explosion particles
-	if b.ExplosionTimer > 0 {
-		explosionFrame := 30 - b.ExplosionTimer // 0 to 29
-		for i, particle := range b.ExplosionParticles {
-			if particle != nil {
-				// Calculate particle movement in different directions
-				angle := float64(i) * 2 * math.Pi / float64(len(b.ExplosionParticles))
-				radius := float32(explosionFrame) * 1.5 // Smaller explosion radius than human
-
-				newX := b.X + float32(math.Cos(angle))*radius - 3
-				newY := b.Y + float32(math.Sin(angle))*radius - 3
-
-				particle.Move(fyne.NewPos(newX, newY))
-
-				// Fade particles
-				alphaInt := 255 - int(explosionFrame*8)
-				if alphaInt < 0 {
-					alphaInt = 0
-				}
-				alpha := uint8(alphaInt)
-				particle.FillColor = color.RGBA{
-					R: particle.FillColor.(color.RGBA).R,
-					G: particle.FillColor.(color.RGBA).G,
-					B: particle.FillColor.(color.RGBA).B,
-					A: alpha,
-				}
-				particle.Refresh()
-			}
-		}
-	} else {
-		// Explosion finished, clean up
-		for _, particle := range b.ExplosionParticles {
-			if particle != nil {
-				particle.Hide()
-			}
-		}
-		b.ExplosionParticles = nil
+	// The sparks animate themselves; the explosion lasts until the timer runs out
+	if b.ExplosionTimer <= 0 {
 		b.IsExploding = false
 	}
 }
 
 // shrinkBall reduces the eyeball size by the given factor
 func (b *Ball) shrinkBall(factor float32) {
-	// Calculate new size
-	newRadius := b.Radius * factor
-	newOriginalRadius := b.OriginalRadius * factor
-
-	// Apply minimum size constraint (35 pixels radius)
-	minRadius := float32(35.0)
-	if newRadius < minRadius {
+	// Calculate new size, stopping at the minimum ball size
+	newRadius := b.OriginalRadius * factor
+	if minRadius, _ := b.World.ballSizeRange(); newRadius < minRadius {
 		newRadius = minRadius
-		newOriginalRadius = minRadius
 	}
 
-	// Only shrink if the new size is different from current size
-	if newRadius != b.Radius {
-		// Hide old trail particles before resizing
-		for _, trail := range b.Trail {
-			if trail != nil {
-				trail.Hide()
-			}
-		}
+	// Only ever shrink (a ball already at or below the minimum keeps its size)
+	if newRadius < b.OriginalRadius {
+		b.setRadius(newRadius)
+	}
+}
+
+// regrow grows a shrunk ball back toward its full radius
+func (b *Ball) regrow() {
+	if b.GrowthRate <= 0 || b.OriginalRadius >= b.FullRadius {
+		return
+	}
+
+	newRadius := b.OriginalRadius + b.GrowthRate
+	if newRadius > b.FullRadius {
+		newRadius = b.FullRadius
+	}
+	if _, maxRadius := b.World.ballSizeRange(); newRadius > maxRadius {
+		newRadius = maxRadius
+	}
+	b.setRadius(newRadius)
+}
+
+// setRadius changes the eyeball size and resizes all of its components
+func (b *Ball) setRadius(radius float32) {
+	// Update radius
+	b.Radius = radius
+	b.OriginalRadius = radius
+
+	// Update visual size for all eyeball components
+	b.Circle.Resize(fyne.NewSize(b.Radius*2, b.Radius*2))
+
+	// Update iris size (60% of eyeball)
+	irisSize := b.Radius * 1.2
+	b.Iris.Resize(fyne.NewSize(irisSize, irisSize))
+
+	// Update pupil size (30% of eyeball)
+	pupilSize := b.Radius * 0.6
+	b.Pupil.Resize(fyne.NewSize(pupilSize, pupilSize))
 
-		// Update radius
-		b.Radius = newRadius
-		b.OriginalRadius = newOriginalRadius
+	// Adjust text size for new ball size
+	b.updateTextSize()
 
-		// Update visual size for all eyeball components
-		b.Circle.Resize(fyne.NewSize(b.Radius*2, b.Radius*2))
+	// Re-fit the trail ribbon width to the new size
+	b.updateTrail()
+}
+
+// GetVisualComponents returns all visual components for adding to container
+func (b *Ball) GetVisualComponents() []fyne.CanvasObject {
+	components := make([]fyne.CanvasObject, 0, len(b.BloodVeins)+4)
 
-		// Update iris size (60% of eyeball)
-		irisSize := b.Radius * 1.2
-		b.Iris.Resize(fyne.NewSize(irisSize, irisSize))
+	// Ghosts and trail first so they draw behind the eyeball
+	components = append(components, b.Ghosts.GetVisualComponents()...)
+	components = append(components, b.Trail.GetVisualComponents()...)
+
+	components = append(components, b.Circle) // White eyeball background
+	for _, vein := range b.BloodVeins {
+		if vein != nil {
+			components = append(components, vein) // Bloodshot veins
+		}
+	}
+	components = append(components, b.Iris)  // Colored iris
+	components = append(components, b.Pupil) // Black pupil
+	components = append(components, b.Text)  // AI LLM name label
+	if b.HPBar != nil {
+		components = append(components, b.HPBar.GetVisualComponents()...) // Boss health
+	}
 
-		// Update pupil size (30% of eyeball)
-		pupilSize := b.Radius * 0.6
-		b.Pupil.Resize(fyne.NewSize(pupilSize, pupilSize))
+	return components
+}
 
-		// Adjust text size for new ball size
-		b.updateTextSize()
+// TakePendingExplosion reports whether the ball has exploded since the last call, so the UI announces it once
+func (b *Ball) TakePendingExplosion() bool {
+	pending := b.explosionPending
+	b.explosionPending = false
+	return pending
+}
 
-		// Reinitialize trail with new size
-		b.initializeTrail()
+// TakeDamage reduces the ball's hit points and destroys it at zero.
+// It returns true if this damage destroyed the ball.
+func (b *Ball) TakeDamage(amount int) bool {
+	if b.IsDestroyed || b.IsAbsorbed {
+		return false
 	}
+
+	b.HP -= amount
+	if b.HP > 0 {
+		return false
+	}
+
+	b.HP = 0
+	b.IsDestroyed = true
+	b.IsAnimated = false // Other entities ignore destroyed balls
+
+	// Hide the eyeball and play the explosion in its place
+	b.hideEyeball()
+
+	// Restart the explosion even if a collision explosion was already playing
+	b.IsExploding = false
+	b.triggerExplosion()
+
+	return true
 }
 
-// GetExplosionParticles returns the explosion particles for UI management
-func (b *Ball) GetExplosionParticles() []*canvas.Circle {
-	if b.ExplosionParticles == nil {
-		return nil
+// hideEyeball hides every part of the eyeball (used when it is destroyed or leaves the arena)
+func (b *Ball) hideEyeball() {
+	b.Shadow.Hide()
+	b.Circle.Hide()
+	b.Iris.Hide()
+	b.Pupil.Hide()
+	b.Text.Hide()
+	b.Ghosts.Clear()
+	if b.HPBar != nil {
+		b.HPBar.Hide()
+	}
+	for _, vein := range b.BloodVeins {
+		vein.Hide()
 	}
-	return b.ExplosionParticles
+	b.Trail.Hide()
 }
+
+// DestructionComplete reports whether a destroyed ball has finished exploding and can be removed
+func (b *Ball) DestructionComplete() bool {
+	return b.IsDestroyed && !b.IsExploding
+}
+
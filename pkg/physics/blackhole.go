@@ -0,0 +1,214 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Black hole tuning
+const (
+	blackHoleChance      = float32(0.0002) // chance per frame a black hole starts drifting into view (a few minutes apart)
+	blackHoleParallax    = float32(0.6)    // drifts at this fraction of the travel speed
+	blackHoleHorizon     = float32(14)     // event horizon radius; bullets that cross it are swallowed
+	blackHoleDiskRadius  = float32(56)     // outer radius of the accretion disk
+	blackHoleGravity     = float32(2500)   // pull strength: a ball 100 pixels away speeds up 0.25 pixels per frame
+	blackHoleMaxPull     = float32(0.5)    // strongest pull per frame, so objects near the center don't fling off
+	blackHoleMaxSpeed    = float32(6.0)    // balls caught in the pull never go faster than this
+	blackHoleHumanDrift  = float32(1.2)    // fastest the human is dragged, in pixels per frame
+	blackHoleSpinSpeed   = float32(0.05)   // radians per frame the accretion disk turns
+	blackHoleRingCount   = 4               // rings in the accretion disk
+	blackHoleSparkCount  = 10              // clumps of hot gas orbiting in the disk
+	blackHoleSparkRadius = float32(2.5)    // size of a clump of hot gas
+)
+
+// Black hole colors
+var (
+	blackHoleCoreColor  = color.RGBA{R: 0, G: 0, B: 0, A: 255}       // The event horizon lets no light out
+	blackHoleInnerColor = color.RGBA{R: 255, G: 230, B: 160, A: 220} // White-hot inner disk
+	blackHoleOuterColor = color.RGBA{R: 200, G: 70, B: 20, A: 90}    // Cooler, dimmer outer disk
+	blackHoleSparkColor = color.RGBA{R: 255, G: 200, B: 120, A: 230} // Orbiting hot gas
+)
+
+// BlackHole is a rare background object with real gravity: while it is on screen it pulls the balls,
+// bullets and humans toward it, and swallows any bullet that crosses its event horizon
+type BlackHole struct {
+	X, Y      float32   // center
+	IsActive  bool      // whether a black hole is drifting through
+	IsVisible bool      // whether it is on screen (and pulling)
+	Events    *EventBus // receives EventBlackHole when one comes into view (may be nil)
+	// Visual components
+	Core   *canvas.Circle   // Black event horizon
+	Rings  []*canvas.Circle // Accretion disk, hot inside and cooler outside
+	Sparks []*canvas.Circle // Clumps of hot gas orbiting in the disk
+	phase  float32          // disk rotation
+}
+
+// NewBlackHole creates an inactive, hidden black hole
+func NewBlackHole() *BlackHole {
+	bh := &BlackHole{
+		Core:   &canvas.Circle{FillColor: blackHoleCoreColor},
+		Rings:  make([]*canvas.Circle, blackHoleRingCount),
+		Sparks: make([]*canvas.Circle, blackHoleSparkCount),
+	}
+	for i := range bh.Rings {
+		bh.Rings[i] = &canvas.Circle{StrokeColor: blackHoleRingColor(i), StrokeWidth: 3}
+	}
+	for i := range bh.Sparks {
+		bh.Sparks[i] = &canvas.Circle{FillColor: blackHoleSparkColor}
+	}
+	bh.hide()
+	return bh
+}
+
+// blackHoleRingColor blends from the inner to the outer disk color
+func blackHoleRingColor(ring int) color.RGBA {
+	t := float32(ring) / float32(blackHoleRingCount-1)
+	mix := func(a, b uint8) uint8 {
+		return uint8(float32(a) + (float32(b)-float32(a))*t)
+	}
+	return color.RGBA{
+		R: mix(blackHoleInnerColor.R, blackHoleOuterColor.R),
+		G: mix(blackHoleInnerColor.G, blackHoleOuterColor.G),
+		B: mix(blackHoleInnerColor.B, blackHoleOuterColor.B),
+		A: mix(blackHoleInnerColor.A, blackHoleOuterColor.A),
+	}
+}
+
+// Update now and then starts a black hole at the edge the ship is heading toward (dirX, dirY), drifts it
+// with the stars' streaming velocity (vx, vy) and lets it go once it has passed off the trailing edge
+func (bh *BlackHole) Update(vx, vy, dirX, dirY float32, bounds fyne.Size) {
+	if !bh.IsActive {
+		if rand.Float32() >= blackHoleChance {
+			return
+		}
+		bh.IsActive = true
+		bh.X, bh.Y = leadingEdgePosition(bounds, dirX, dirY, blackHoleDiskRadius)
+		for _, component := range bh.GetVisualComponents() {
+			component.Show()
+		}
+	}
+
+	bh.X += vx * blackHoleParallax
+	bh.Y += vy * blackHoleParallax
+	if isOffscreen(bh.X, bh.Y, blackHoleDiskRadius*1.5, bounds) {
+		bh.IsActive = false
+		bh.hide()
+		return
+	}
+
+	// Announce it the moment its center comes into view
+	onScreen := !isOffscreen(bh.X, bh.Y, 0, bounds)
+	if onScreen && !bh.IsVisible {
+		bh.Events.Publish(Event{Type: EventBlackHole, X: bh.X, Y: bh.Y})
+	}
+	bh.IsVisible = onScreen
+
+	bh.phase += blackHoleSpinSpeed
+	bh.draw()
+}
+
+// ApplyGravity pulls the balls, the humans and their bullets toward a black hole on screen, and swallows
+// any bullet that crosses the event horizon
+func (bh *BlackHole) ApplyGravity(balls []*Ball, humans []*Human) {
+	if !bh.IsVisible {
+		return
+	}
+
+	for _, ball := range balls {
+		if !ball.IsAnimated || ball.IsDestroyed || ball.IsAbsorbed || ball.CarriedBy != nil {
+			continue
+		}
+		ax, ay := bh.pull(ball.X, ball.Y)
+		ball.VX += ax
+		ball.VY += ay
+		ball.limitSpeed(blackHoleMaxSpeed)
+	}
+
+	for _, human := range humans {
+		for _, bullet := range human.Bullets {
+			if !bullet.IsActive {
+				continue
+			}
+			if dx, dy := bullet.X-bh.X, bullet.Y-bh.Y; dx*dx+dy*dy < blackHoleHorizon*blackHoleHorizon {
+				bullet.remove()
+				continue
+			}
+			ax, ay := bh.pull(bullet.X, bullet.Y)
+			bullet.VX += ax
+			bullet.VY += ay
+		}
+
+		// The human isn't moved by velocity, so it is dragged a little each frame instead
+		if !human.IsActive || human.IsExploding || human.Mount != nil {
+			continue
+		}
+		ax, ay := bh.pull(human.X, human.Y)
+		scale := blackHoleHumanDrift / blackHoleMaxPull
+		human.X = clampCoordinate(human.X+ax*scale, human.Size/2, human.Bounds.Width-human.Size/2)
+		human.Y = clampCoordinate(human.Y+ay*scale, human.Size/2, human.Bounds.Height-human.Size/2)
+	}
+}
+
+// pull returns the inverse-square acceleration toward the black hole at (x, y), capped at blackHoleMaxPull
+func (bh *BlackHole) pull(x, y float32) (float32, float32) {
+	dx := bh.X - x
+	dy := bh.Y - y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance == 0 {
+		return 0, 0
+	}
+	strength := blackHoleGravity / (distance * distance)
+	if strength > blackHoleMaxPull {
+		strength = blackHoleMaxPull
+	}
+	return dx / distance * strength, dy / distance * strength
+}
+
+// draw lays out the event horizon, the accretion disk and the orbiting gas
+func (bh *BlackHole) draw() {
+	placeCircle := func(circle *canvas.Circle, x, y, r float32) {
+		circle.Resize(fyne.NewSize(r*2, r*2))
+		circle.Move(fyne.NewPos(x-r, y-r))
+	}
+
+	placeCircle(bh.Core, bh.X, bh.Y, blackHoleHorizon)
+	span := blackHoleDiskRadius - blackHoleHorizon
+	for i, ring := range bh.Rings {
+		// Rings shimmer slightly as the disk turns
+		flicker := 1.5 * float32(math.Sin(float64(bh.phase*3+float32(i))))
+		placeCircle(ring, bh.X, bh.Y, blackHoleHorizon+span*float32(i+1)/blackHoleRingCount+flicker)
+	}
+
+	// Gas closer in orbits faster
+	for i, spark := range bh.Sparks {
+		orbit := blackHoleHorizon + span*(0.2+0.8*float32(i)/blackHoleSparkCount)
+		speed := blackHoleDiskRadius / orbit
+		angle := float64(bh.phase*speed) + float64(i)*2.4
+		placeCircle(spark, bh.X+float32(math.Cos(angle))*orbit, bh.Y+float32(math.Sin(angle))*orbit, blackHoleSparkRadius)
+	}
+}
+
+// hide hides every visual component
+func (bh *BlackHole) hide() {
+	bh.IsVisible = false
+	for _, component := range bh.GetVisualComponents() {
+		component.Hide()
+	}
+}
+
+// GetVisualComponents returns the black hole's visual components for UI management
+func (bh *BlackHole) GetVisualComponents() []fyne.CanvasObject {
+	components := make([]fyne.CanvasObject, 0, 1+len(bh.Rings)+len(bh.Sparks))
+	for i := len(bh.Rings) - 1; i >= 0; i-- {
+		components = append(components, bh.Rings[i]) // Outer rings first, behind the inner ones
+	}
+	components = append(components, bh.Core)
+	for _, spark := range bh.Sparks {
+		components = append(components, spark)
+	}
+	return components
+}
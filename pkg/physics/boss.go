@@ -0,0 +1,142 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Boss tuning
+const (
+	BossRadius     = float32(55)   // starting radius of a boss ball
+	bossHP         = 40            // bullet hits needed to destroy a boss
+	bossScoreScale = 10            // a boss is worth this many regular balls
+	bossSpeed      = float32(2.0)  // homing speed
+	bossTurnRate   = float32(0.03) // maximum heading change per frame in radians
+	hpBarHeight    = float32(6)
+	hpBarGap       = float32(8) // space between the top of the ball and the HP bar
+)
+
+// HomeInOnHuman steers the ball straight at the human, turning at most TurnRate radians per frame
+type HomeInOnHuman struct {
+	Speed    float32 // cruising speed
+	TurnRate float32 // maximum heading change per frame in radians
+}
+
+// Steer turns the ball's heading toward the nearest human and holds it at cruising speed
+func (h HomeInOnHuman) Steer(b *Ball, ctx *SteeringContext) (float32, float32) {
+	heading := math.Atan2(float64(b.VY), float64(b.VX))
+
+	// Wander straight ahead while every human is dead
+	if human := NearestHuman(ctx.Humans, b.X, b.Y); human != nil {
+		target := math.Atan2(float64(human.Y-b.Y), float64(human.X-b.X))
+		if b.VX == 0 && b.VY == 0 {
+			heading = target // Standing still: set off straight at the human
+		}
+		turn := math.Remainder(target-heading, 2*math.Pi) // shortest way round
+		maxTurn := float64(h.TurnRate)
+		if turn > maxTurn {
+			turn = maxTurn
+		} else if turn < -maxTurn {
+			turn = -maxTurn
+		}
+		heading += turn
+	}
+
+	newVX := float32(math.Cos(heading)) * h.Speed
+	newVY := float32(math.Sin(heading)) * h.Speed
+	return newVX - b.VX, newVY - b.VY
+}
+
+// HPBar is a health bar floating above a ball
+type HPBar struct {
+	Background *canvas.Rectangle // Dark empty bar
+	Fill       *canvas.Rectangle // Remaining health
+}
+
+// NewHPBar creates a health bar
+func NewHPBar() *HPBar {
+	return &HPBar{
+		Background: &canvas.Rectangle{
+			FillColor:    color.RGBA{R: 40, G: 0, B: 0, A: 200},
+			StrokeColor:  color.RGBA{R: 0, G: 0, B: 0, A: 255},
+			StrokeWidth:  1,
+			CornerRadius: hpBarHeight / 2,
+		},
+		Fill: &canvas.Rectangle{
+			FillColor:    color.RGBA{R: 80, G: 220, B: 80, A: 255},
+			CornerRadius: hpBarHeight / 2,
+		},
+	}
+}
+
+// Update places the bar centered above (x, top) and sizes the fill to the health fraction
+func (h *HPBar) Update(x, top, width float32, hp, maxHP int) {
+	fraction := float32(0)
+	if maxHP > 0 {
+		fraction = float32(hp) / float32(maxHP)
+	}
+
+	left := x - width/2
+	y := top - hpBarGap - hpBarHeight
+	h.Background.Resize(fyne.NewSize(width, hpBarHeight))
+	h.Background.Move(fyne.NewPos(left, y))
+
+	h.Fill.Resize(fyne.NewSize(width*fraction, hpBarHeight))
+	h.Fill.Move(fyne.NewPos(left, y))
+
+	// Green when healthy, through yellow, to red when nearly dead
+	red := uint8(255 * math.Min(1, float64(2*(1-fraction))))
+	green := uint8(220 * math.Min(1, float64(2*fraction)))
+	h.Fill.FillColor = color.RGBA{R: red, G: green, B: 40, A: 255}
+
+	h.Background.Refresh()
+	h.Fill.Refresh()
+}
+
+// Show shows the bar
+func (h *HPBar) Show() {
+	h.Background.Show()
+	h.Fill.Show()
+}
+
+// Hide hides the bar
+func (h *HPBar) Hide() {
+	h.Background.Hide()
+	h.Fill.Hide()
+}
+
+// GetVisualComponents returns the bar's visual components
+func (h *HPBar) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{h.Background, h.Fill}
+}
+
+// makeBoss turns the ball into a boss: big, tough, homing in on the human, with an HP bar
+func (b *Ball) makeBoss() {
+	b.HP = bossHP
+	b.MaxHP = bossHP
+	b.Steering = HomeInOnHuman{Speed: bossSpeed, TurnRate: bossTurnRate}
+	if b.HPBar == nil {
+		b.HPBar = NewHPBar()
+	}
+	b.setRadius(b.World.clampRadius(BossRadius))
+	b.FullRadius = b.OriginalRadius
+}
+
+// updateHPBar keeps the HP bar (if any) floating above the ball
+func (b *Ball) updateHPBar(radius float32) {
+	if b.HPBar == nil {
+		return
+	}
+	b.HPBar.Update(b.X, b.Y-radius, radius*2, b.HP, b.MaxHP)
+}
+
+// ScoreValue returns the score granted for destroying the ball
+func (b *Ball) ScoreValue() int {
+	if b.Kind == KindBoss {
+		return BallScoreValue * bossScoreScale
+	}
+	return BallScoreValue
+}
@@ -0,0 +1,232 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// Boss fight tuning
+const (
+	bossFightRadius    = float32(90)  // the giant eyeball's radius (before the ball size range clamps it)
+	bossFightHP        = 150          // bullet hits needed to win the fight
+	bossRingOrbs       = 12           // orbs in each ring spread (more in later phases)
+	bossRingInterval   = 150          // frames between ring spreads in the first phase
+	bossDashInterval   = 300          // frames between charge dashes from the second phase
+	bossDashWindup     = 45           // frames the boss shudders in place before a dash
+	bossDashFrames     = 40           // frames a dash lasts
+	bossDashSpeed      = float32(9.0) // dash speed
+	bossSummonInterval = 420          // frames between minion spawns in the last phase
+	bossSummonCount    = 3            // minions per summon
+	bossOrbSpeedScale  = float32(1.2) // ring orbs fly a little faster than alien plasma
+)
+
+// BossPhase is a stage of the boss fight, reached as the boss loses HP
+type BossPhase int
+
+// Boss fight phases
+const (
+	BossPhaseRings  BossPhase = iota // ring spreads only
+	BossPhaseCharge                  // from two thirds HP: faster rings and charge dashes
+	BossPhaseSwarm                   // from one third HP: all of the above and minion spawns
+)
+
+// String returns the phase's display name
+func (p BossPhase) String() string {
+	switch p {
+	case BossPhaseCharge:
+		return "Charge"
+	case BossPhaseSwarm:
+		return "Swarm"
+	default:
+		return "Rings"
+	}
+}
+
+// BossFight is a scripted encounter with one giant boss eyeball that attacks in phases: ring spreads of
+// orbs, charge dashes at the nearest human and minion spawns
+type BossFight struct {
+	Boss   *Ball     // the giant eyeball
+	Orbs   []*Bullet // ring spread orbs in flight
+	Bounds fyne.Size // arena the orbs fly in
+
+	ringTimer   int     // frames until the next ring spread
+	dashTimer   int     // frames until the next dash windup
+	windup      int     // frames left shuddering before a dash (0 = not winding up)
+	dashing     int     // frames left in the current dash
+	summonTimer int     // frames until the next minion spawn
+	summons     int     // minions asked for and not yet taken by the game
+	ringTurn    float64 // rotation of the next ring, so successive rings leave different gaps
+}
+
+// NewBossFight turns the ball into the fight's giant boss eyeball
+func NewBossFight(boss *Ball, bounds fyne.Size) *BossFight {
+	boss.SetKind(KindBoss)
+	boss.HP = bossFightHP
+	boss.MaxHP = bossFightHP
+	boss.setRadius(boss.World.clampRadius(bossFightRadius))
+	boss.FullRadius = boss.OriginalRadius
+	if boss.HPBar != nil {
+		boss.HPBar.Hide() // The fight shows its own bar across the top
+	}
+	return &BossFight{
+		Boss:        boss,
+		Bounds:      bounds,
+		ringTimer:   bossRingInterval,
+		dashTimer:   bossDashInterval,
+		summonTimer: bossSummonInterval,
+	}
+}
+
+// Phase returns the fight's current phase, from the boss's remaining HP
+func (f *BossFight) Phase() BossPhase {
+	switch {
+	case f.Boss.HP*3 <= f.Boss.MaxHP:
+		return BossPhaseSwarm
+	case f.Boss.HP*3 <= f.Boss.MaxHP*2:
+		return BossPhaseCharge
+	default:
+		return BossPhaseRings
+	}
+}
+
+// Defeated reports whether the boss has been destroyed and has finished exploding
+func (f *BossFight) Defeated() bool {
+	return f.Boss.DestructionComplete()
+}
+
+// Update moves the orbs and runs the boss's attacks for one frame
+func (f *BossFight) Update(humans []*Human) {
+	for i := len(f.Orbs) - 1; i >= 0; i-- {
+		if !f.Orbs[i].IsActive || !f.Orbs[i].advance(f.Bounds) {
+			f.Orbs = append(f.Orbs[:i], f.Orbs[i+1:]...)
+		}
+	}
+	if f.Boss.IsDestroyed {
+		return
+	}
+	phase := f.Phase()
+
+	// Ring spreads, denser and more frequent each phase
+	f.ringTimer--
+	if f.ringTimer <= 0 && f.dashing == 0 {
+		f.fireRing(bossRingOrbs + 4*int(phase))
+		f.ringTimer = bossRingInterval - 30*int(phase)
+	}
+
+	if phase >= BossPhaseCharge {
+		f.updateDash(humans)
+	}
+
+	if phase >= BossPhaseSwarm {
+		f.summonTimer--
+		if f.summonTimer <= 0 {
+			f.summons += bossSummonCount
+			f.summonTimer = bossSummonInterval
+		}
+	}
+}
+
+// fireRing fires orbs out of the boss in every direction
+func (f *BossFight) fireRing(count int) {
+	b := f.Boss
+	for i := 0; i < count; i++ {
+		angle := f.ringTurn + 2*math.Pi*float64(i)/float64(count)
+		dx, dy := float32(math.Cos(angle)), float32(math.Sin(angle))
+		startX, startY := b.X+dx*b.Radius, b.Y+dy*b.Radius
+		orb := NewPlasmaOrb(startX, startY, startX+dx, startY+dy)
+		orb.VX *= bossOrbSpeedScale
+		orb.VY *= bossOrbSpeedScale
+		orb.Eyeball.FillColor = color.RGBA{R: 255, G: 40, B: 80, A: 90}    // Faint red halo
+		orb.Eyeball.StrokeColor = color.RGBA{R: 255, G: 60, B: 90, A: 200} // Bloodshot rim
+		orb.Iris.FillColor = color.RGBA{R: 255, G: 120, B: 140, A: 220}
+		f.Orbs = append(f.Orbs, orb)
+	}
+	f.ringTurn += math.Pi / float64(count) // Offset the next ring by half a gap
+}
+
+// updateDash winds up and then charges straight at the nearest human, homing in again afterwards
+func (f *BossFight) updateDash(humans []*Human) {
+	b := f.Boss
+	switch {
+	case f.dashing > 0:
+		f.dashing--
+		if f.dashing == 0 {
+			b.Steering = HomeInOnHuman{Speed: bossSpeed, TurnRate: bossTurnRate}
+		}
+	case f.windup > 0:
+		f.windup--
+		b.triggerJiggle(0.3) // Shudder as a warning
+		if f.windup > 0 {
+			return
+		}
+		b.Steering = HomeInOnHuman{Speed: bossSpeed, TurnRate: bossTurnRate}
+		target := NearestHuman(humans, b.X, b.Y)
+		if target == nil {
+			return
+		}
+		dx, dy := target.X-b.X, target.Y-b.Y
+		distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+		if distance == 0 {
+			return
+		}
+		b.Steering = nil // A straight line, not a homing curve
+		b.VX, b.VY = dx/distance*bossDashSpeed, dy/distance*bossDashSpeed
+		f.dashing = bossDashFrames
+	default:
+		f.dashTimer--
+		if f.dashTimer <= 0 {
+			f.windup = bossDashWindup
+			b.Steering = nil // Stand still while winding up
+			b.VX, b.VY = 0, 0
+			f.dashTimer = bossDashInterval
+		}
+	}
+}
+
+// TakeSummons returns how many minions the boss has summoned since the last call
+func (f *BossFight) TakeSummons() int {
+	summons := f.summons
+	f.summons = 0
+	return summons
+}
+
+// OrbHits reports whether one of the boss's orbs hit the human, using up that orb
+func (f *BossFight) OrbHits(human *Human) bool {
+	if !human.IsVulnerable() {
+		return false
+	}
+	for _, orb := range f.Orbs {
+		if !orb.IsActive {
+			continue
+		}
+		dx := human.X - orb.X
+		dy := human.Y - orb.Y
+		reach := human.Size*0.6 + orb.Size/2
+		if dx*dx+dy*dy < reach*reach {
+			orb.remove()
+			return true
+		}
+	}
+	return false
+}
+
+// ClearOrbs removes every orb in flight
+func (f *BossFight) ClearOrbs() {
+	for _, orb := range f.Orbs {
+		orb.remove()
+	}
+	f.Orbs = nil
+}
+
+// GetOrbVisuals returns all orb visual objects for UI management
+func (f *BossFight) GetOrbVisuals() []fyne.CanvasObject {
+	visuals := make([]fyne.CanvasObject, 0, len(f.Orbs)*3)
+	for _, orb := range f.Orbs {
+		if orb.IsActive {
+			visuals = append(visuals, orb.Eyeball, orb.Iris, orb.Pupil)
+		}
+	}
+	return visuals
+}
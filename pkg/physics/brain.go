@@ -0,0 +1,225 @@
+package physics
+
+import "math"
+
+// HumanBrain is an AI strategy that decides how the human moves when the player isn't driving
+type HumanBrain interface {
+	// Name returns the strategy's display name
+	Name() string
+	// Avoid returns the force away from threatening balls and the danger at the human's position
+	// (0 = safe, 2 = about to be hit). It is expensive, so it runs on AI ticks and is cached in between.
+	Avoid(h *Human, balls []*Ball) (float32, float32, float32)
+	// Steer combines the cached avoidance force with the strategy's positioning into this frame's movement
+	Steer(h *Human, avoidX, avoidY float32) (float32, float32)
+}
+
+// Brain tuning
+const (
+	camperIgnoreForce = float32(0.25) // avoidance (fraction of speed) a camper shrugs off without moving
+	kiteStrafe        = float32(0.4)  // sideways strafe (fraction of speed) while kiting
+	fieldRange        = float32(200)  // clearance at which a ball starts pushing in the potential field
+	fieldWallRange    = float32(80)   // distance at which a wall starts pushing in the potential field
+	fieldLookahead    = float32(10)   // frames ahead the potential field predicts ball positions
+)
+
+// ClassicBrain dodges along the danger map while drifting back toward the center (the original AI)
+type ClassicBrain struct{}
+
+// Name returns the strategy's display name
+func (ClassicBrain) Name() string { return "Classic Dodger" }
+
+// Avoid heads down the predicted danger map toward the safest nearby spot
+func (ClassicBrain) Avoid(h *Human, balls []*Ball) (float32, float32, float32) {
+	return h.calculateAvoidance(balls)
+}
+
+// Steer favors avoidance (80%) over centering (20%)
+func (ClassicBrain) Steer(h *Human, avoidX, avoidY float32) (float32, float32) {
+	centerX, centerY := h.calculateCentering()
+	return avoidX*0.8 + centerX*0.2, avoidY*0.8 + centerY*0.2
+}
+
+// CautiousCamper holds its spot in the center and only steps aside for real threats. It reads the danger map
+// the same way as ClassicBrain (whose Avoid it reuses) but steers differently.
+type CautiousCamper struct{ ClassicBrain }
+
+// Name returns the strategy's display name
+func (CautiousCamper) Name() string { return "Cautious Camper" }
+
+// Steer ignores weak avoidance and heads straight back to the center once the threat passes
+func (CautiousCamper) Steer(h *Human, avoidX, avoidY float32) (float32, float32) {
+	centerX, centerY := h.calculateCentering()
+	avoidLength := float32(math.Sqrt(float64(avoidX*avoidX + avoidY*avoidY)))
+	if avoidLength < h.Speed*camperIgnoreForce {
+		return centerX, centerY
+	}
+	return avoidX, avoidY
+}
+
+// AggressiveKiter keeps the closest ball at shooting range, circling it while it fires
+type AggressiveKiter struct {
+	Range float32 // preferred distance from the closest ball
+}
+
+// Name returns the strategy's display name
+func (AggressiveKiter) Name() string { return "Aggressive Kiter" }
+
+// Avoid heads toward safety on the danger map while closing in on (or backing off from) the closest ball and strafing
+func (k AggressiveKiter) Avoid(h *Human, balls []*Ball) (float32, float32, float32) {
+	avoidX, avoidY, danger := h.calculateAvoidance(balls)
+
+	target := h.findClosestBall(balls)
+	if target == nil {
+		return avoidX, avoidY, danger
+	}
+	dx := target.X - h.X
+	dy := target.Y - h.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance == 0 {
+		return avoidX, avoidY, danger
+	}
+
+	// Positive when too far (close in), negative when too close (back off)
+	radial := (distance - target.Radius - k.Range) / k.Range
+	if radial > 1 {
+		radial = 1
+	} else if radial < -1 {
+		radial = -1
+	}
+	towardX := dx / distance
+	towardY := dy / distance
+
+	kiteX := (towardX*radial - towardY*kiteStrafe) * h.Speed
+	kiteY := (towardY*radial + towardX*kiteStrafe) * h.Speed
+	return avoidX + kiteX, avoidY + kiteY, danger
+}
+
+// Steer follows the avoidance and kiting force as is
+func (AggressiveKiter) Steer(_ *Human, avoidX, avoidY float32) (float32, float32) {
+	return avoidX, avoidY
+}
+
+// WallHugger sticks to the nearest wall and slides along it away from threats
+type WallHugger struct {
+	Margin float32 // distance kept from the wall
+}
+
+// Name returns the strategy's display name
+func (WallHugger) Name() string { return "Wall Hugger" }
+
+// Avoid heads down the predicted danger map toward the safest nearby spot
+func (WallHugger) Avoid(h *Human, balls []*Ball) (float32, float32, float32) {
+	return h.calculateAvoidance(balls)
+}
+
+// Steer combines avoidance with a pull toward the nearest wall
+func (w WallHugger) Steer(h *Human, avoidX, avoidY float32) (float32, float32) {
+	// Head for the spot Margin away from the nearest wall (starting with the left one)
+	nearest := h.X
+	targetX, targetY := w.Margin, h.Y
+	if right := h.Bounds.Width - h.X; right < nearest {
+		nearest = right
+		targetX = h.Bounds.Width - w.Margin
+	}
+	if h.Y < nearest {
+		nearest = h.Y
+		targetX, targetY = h.X, w.Margin
+	}
+	if bottom := h.Bounds.Height - h.Y; bottom < nearest {
+		targetX, targetY = h.X, h.Bounds.Height-w.Margin
+	}
+
+	hugX, hugY := towards(h.X, h.Y, targetX, targetY, h.Speed*0.4)
+	return avoidX*0.8 + hugX, avoidY*0.8 + hugY
+}
+
+// PotentialField treats every ball and wall as a repelling charge and slides down the combined field
+type PotentialField struct{}
+
+// Name returns the strategy's display name
+func (PotentialField) Name() string { return "Potential Field" }
+
+// Avoid sums the repulsion from each ball's predicted position and from nearby walls
+func (PotentialField) Avoid(h *Human, balls []*Ball) (float32, float32, float32) {
+	var forceX, forceY, danger float32
+
+	for _, ball := range balls {
+		if !ball.IsAnimated {
+			continue
+		}
+
+		// Push away from where the ball is about to be
+		dx := h.X - (ball.X + ball.VX*fieldLookahead)
+		dy := h.Y - (ball.Y + ball.VY*fieldLookahead)
+		distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+		clearance := distance - ball.Radius - h.Size*0.5
+		if clearance >= fieldRange || distance == 0 {
+			continue
+		}
+
+		strength := (fieldRange - clearance) / fieldRange
+		strength = strength * strength * 2.0 // Quadratic increase, same scale as the classic avoidance
+		forceX += dx / distance * strength
+		forceY += dy / distance * strength
+		if strength > danger {
+			danger = strength
+		}
+	}
+
+	// Walls repel too, so the field never pins the human in a corner
+	forceX += wallRepulsion(h.X) - wallRepulsion(h.Bounds.Width-h.X)
+	forceY += wallRepulsion(h.Y) - wallRepulsion(h.Bounds.Height-h.Y)
+
+	return forceX * h.Speed, forceY * h.Speed, danger
+}
+
+// Steer follows the field with a gentle pull toward the center
+func (PotentialField) Steer(h *Human, avoidX, avoidY float32) (float32, float32) {
+	centerX, centerY := h.calculateCentering()
+	return avoidX + centerX*0.2, avoidY + centerY*0.2
+}
+
+// wallRepulsion returns the potential field push away from a wall the given distance away
+func wallRepulsion(distance float32) float32 {
+	if distance >= fieldWallRange {
+		return 0
+	}
+	strength := (fieldWallRange - distance) / fieldWallRange
+	return strength * strength
+}
+
+// humanBrains lists the built-in strategies in menu order (the first is the default)
+var humanBrains = []HumanBrain{
+	ClassicBrain{},
+	CautiousCamper{},
+	AggressiveKiter{Range: 220},
+	WallHugger{Margin: 60},
+	PotentialField{},
+}
+
+// HumanBrainNames returns the names of the built-in strategies in menu order
+func HumanBrainNames() []string {
+	names := make([]string, len(humanBrains))
+	for i, brain := range humanBrains {
+		names[i] = brain.Name()
+	}
+	return names
+}
+
+// HumanBrainByName returns the built-in strategy with the given name, or nil
+func HumanBrainByName(name string) HumanBrain {
+	for _, brain := range humanBrains {
+		if brain.Name() == name {
+			return brain
+		}
+	}
+	return nil
+}
+
+// brain returns the human's AI strategy, falling back to the classic dodger
+func (h *Human) brain() HumanBrain {
+	if h.Brain == nil {
+		return ClassicBrain{}
+	}
+	return h.Brain
+}
@@ -0,0 +1,118 @@
+package physics
+
+import "fyne.io/fyne/v2"
+
+// BulletConfig controls how long the human's bullets live and whether they ricochet
+type BulletConfig struct {
+	MaxBounces int // wall bounces before a bullet despawns (0 = leaves the screen at the first wall)
+	TTL        int // frames before a bullet despawns (0 = until it leaves the screen)
+}
+
+// DefaultBulletConfig returns the bullet config from the tuning registry
+func DefaultBulletConfig() BulletConfig {
+	return BulletConfig{
+		MaxBounces: Tuning().BulletMaxBounces,
+		TTL:        Tuning().BulletTTL,
+	}
+}
+
+// apply gives a newly fired bullet the configured ricochets and lifetime.
+// Bullets that already have a lifetime (e.g. homing missiles) keep their own.
+func (c BulletConfig) apply(bullet *Bullet) {
+	bullet.MaxBounces = c.MaxBounces
+	if bullet.TTL == 0 {
+		bullet.TTL = c.TTL
+	}
+}
+
+// advance moves the bullet one frame, bouncing it off the walls while ricochets remain and counting down
+// its lifetime. It returns false (and hides the bullet) once it has expired or left the arena.
+func (b *Bullet) advance(bounds fyne.Size) bool {
+	b.X += b.VX
+	b.Y += b.VY
+
+	// Bounce off the walls while ricochets remain
+	inArena := b.ricochet(bounds)
+	b.place()
+	b.updateTrail()
+
+	// Bullets fizzle out when their lifetime runs out
+	expired := false
+	if b.TTL > 0 {
+		b.TTL--
+		expired = b.TTL == 0
+	}
+
+	if expired || !inArena {
+		b.remove()
+		return false
+	}
+	return true
+}
+
+// bounceOffObstacles ricochets the bullet off any of the level's blocks it has run into, spending the same
+// bounces as the walls. It returns false, removing the bullet, if it hits one with no bounces left.
+func (b *Bullet) bounceOffObstacles(obstacles []*Obstacle) bool {
+	for _, obstacle := range obstacles {
+		normalX, normalY, overlap, hit := obstacle.push(b.X, b.Y, b.Size/2)
+		if !hit {
+			continue
+		}
+		if b.bounces >= b.MaxBounces {
+			b.remove()
+			return false
+		}
+		b.bounces++
+
+		b.X += normalX * overlap
+		b.Y += normalY * overlap
+		if approach := b.VX*normalX + b.VY*normalY; approach < 0 {
+			b.VX -= 2 * approach * normalX
+			b.VY -= 2 * approach * normalY
+		}
+		b.place()
+	}
+	return true
+}
+
+// place centers the bullet's eyeball, iris and pupil on its position
+func (b *Bullet) place() {
+	irisSize := b.Size * 0.7
+	pupilSize := b.Size * 0.35
+	b.Eyeball.Move(fyne.NewPos(b.X-b.Size/2, b.Y-b.Size/2))
+	b.Iris.Move(fyne.NewPos(b.X-irisSize/2, b.Y-irisSize/2))
+	b.Pupil.Move(fyne.NewPos(b.X-pupilSize/2, b.Y-pupilSize/2))
+}
+
+// remove deactivates and hides the bullet
+func (b *Bullet) remove() {
+	b.IsActive = false
+	b.Eyeball.Hide()
+	b.Iris.Hide()
+	b.Pupil.Hide()
+	b.Trail.Hide()
+}
+
+// ricochet bounces the bullet back into the arena if it has crossed a wall and has bounces left.
+// It returns false if the bullet left the arena for good.
+func (b *Bullet) ricochet(bounds fyne.Size) bool {
+	hitX := b.X < 0 || b.X > bounds.Width
+	hitY := b.Y < 0 || b.Y > bounds.Height
+	if !hitX && !hitY {
+		return true
+	}
+	if b.bounces >= b.MaxBounces {
+		return false
+	}
+	b.bounces++
+
+	if hitX {
+		b.VX = -b.VX
+		b.X = clampCoordinate(b.X, 0, bounds.Width)
+	}
+	if hitY {
+		b.VY = -b.VY
+		b.Y = clampCoordinate(b.Y, 0, bounds.Height)
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+package physics
+
+import "math"
+
+// Catch-and-throw tuning
+const (
+	carryMaxRadius    = float32(18)   // biggest ball the dragon can grab (balls it has shrunk fit)
+	carryMinFrames    = 20            // frames a grabbed ball is held before it can be thrown
+	carryMaxFrames    = 120           // longest the dragon carries a ball before throwing it anyway (2 seconds)
+	dragonThrowSpeed  = float32(9)    // speed of a thrown ball
+	throwRegrabFrames = 30            // frames after a throw before the dragon can grab or deflect again
+	clawDrop          = float32(0.45) // how far below the dragon's center the claws hold a ball, relative to its size
+)
+
+// canGrab reports whether the dragon can catch the ball in its claws instead of deflecting it
+func (d *Dragon) canGrab(ball *Ball) bool {
+	if d.Carried != nil || d.Rider != nil || d.Rival || ball.CarriedBy != nil {
+		return false
+	}
+	// Ghosts slip through claws and bosses are far too big
+	return ball.Radius <= carryMaxRadius && ball.Kind != KindGhost && ball.Kind != KindBoss
+}
+
+// grabBall takes the ball out of the world and holds it in the dragon's claws
+func (d *Dragon) grabBall(ball *Ball) {
+	d.Carried = ball
+	d.carryTimer = 0
+	ball.CarriedBy = d
+	ball.IsAnimated = false // Other entities ignore a carried ball
+	ball.triggerJiggle(0.5)
+	d.SetState(DragonCarry)
+	d.carryBall()
+}
+
+// releaseBall lets go of the carried ball, putting it back in the world moving at (vx, vy)
+func (d *Dragon) releaseBall(vx, vy float32) {
+	ball := d.Carried
+	if ball == nil {
+		return
+	}
+	d.Carried = nil
+	ball.CarriedBy = nil
+	ball.IsAnimated = !ball.Frozen // A ball frozen in the claws stays where it is dropped
+	ball.VX, ball.VY = vx, vy
+	d.deflectTimer = throwRegrabFrames
+}
+
+// DropBall lets go of the carried ball where it is, moving with the dragon
+func (d *Dragon) DropBall() {
+	d.releaseBall(d.VX, d.VY)
+}
+
+// throwBall hurls the carried ball so it meets the target, leading it by its velocity
+func (d *Dragon) throwBall(target *Ball) {
+	ball := d.Carried
+	aimX, aimY := target.interceptFrom(ball.X, ball.Y, dragonThrowSpeed)
+	dx := aimX - ball.X
+	dy := aimY - ball.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance == 0 {
+		d.DropBall()
+		return
+	}
+	d.releaseBall(dx/distance*dragonThrowSpeed, dy/distance*dragonThrowSpeed)
+	ball.triggerJiggle(0.8)
+}
+
+// updateCarrying keeps escorting the human with the ball in its claws, then hurls it at the most distant
+// threatening ball; with no threat in time it throws at the farthest ball instead
+func (d *Dragon) updateCarrying(balls []*Ball, human *Human) {
+	d.levelOut(0.1)
+	d.carryTimer++
+
+	// The ball may have burned up in the dragon's claws
+	if ball := d.Carried; ball.IsDestroyed || ball.IsAbsorbed {
+		ball.CarriedBy = nil
+		d.Carried = nil
+		return
+	}
+
+	if human != nil && human.IsActive {
+		d.followHuman(human)
+	} else {
+		d.VX *= 0.9
+		d.VY *= 0.9
+	}
+
+	if d.carryTimer < carryMinFrames {
+		return
+	}
+	if target := d.farthestBall(d.FindThreateningBalls(balls, human)); target != nil {
+		d.throwBall(target)
+	} else if d.carryTimer >= carryMaxFrames {
+		if target := d.farthestBall(balls); target != nil {
+			d.throwBall(target)
+		} else {
+			d.DropBall()
+		}
+	}
+}
+
+// carryBall holds the carried ball in the dragon's claws
+func (d *Dragon) carryBall() {
+	ball := d.Carried
+	if ball == nil {
+		return
+	}
+	ball.X = d.X
+	ball.Y = d.Y + d.Size*clawDrop + ball.Radius*0.5
+	ball.VX, ball.VY = d.VX, d.VY
+	ball.UpdatePosition()
+}
+
+// farthestBall returns the live ball farthest from the dragon, or nil
+func (d *Dragon) farthestBall(balls []*Ball) *Ball {
+	var farthest *Ball
+	farthestDistance := float32(-1)
+	for _, ball := range balls {
+		if !ball.IsAnimated || ball.IsDestroyed || ball.IsAbsorbed {
+			continue
+		}
+		dx := ball.X - d.X
+		dy := ball.Y - d.Y
+		if distance := dx*dx + dy*dy; distance > farthestDistance {
+			farthestDistance = distance
+			farthest = ball
+		}
+	}
+	return farthest
+}
@@ -0,0 +1,163 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
+)
+
+// Celebration tuning
+const (
+	confettiCount        = 80
+	fireworkInterval     = 40  // frames between bursts
+	celebrationDuration  = 360 // 6 seconds at 60 FPS
+	confettiGravity      = float32(0.01)
+	confettiPieceWidth   = float32(6)
+	confettiPieceHeight  = float32(10)
+	confettiMaxDriftX    = float32(1.5)
+	confettiMinFallSpeed = float32(1.0)
+)
+
+// celebrationColors are the bright colors used by confetti and fireworks
+var celebrationColors = []color.RGBA{
+	{R: 255, G: 80, B: 80, A: 255},   // Red
+	{R: 255, G: 200, B: 50, A: 255},  // Gold
+	{R: 80, G: 255, B: 120, A: 255},  // Green
+	{R: 80, G: 180, B: 255, A: 255},  // Blue
+	{R: 220, G: 100, B: 255, A: 255}, // Purple
+	{R: 255, G: 255, B: 255, A: 255}, // White
+}
+
+// confettiPiece is one falling piece of confetti
+type confettiPiece struct {
+	rect   *canvas.Rectangle
+	x, y   float32
+	vx, vy float32
+	phase  float32 // flutter phase
+}
+
+// fireworkBurst is a ring of sparks that slows as it spreads and sags as it fades. Each burst is emitted
+// in a single color picked from celebrationColors.
+var fireworkBurst = effects.Emitter{
+	Count:       16,
+	Speed:       3,
+	SpeedJitter: 0.3,
+	Spread:      math.Pi,
+	Even:        true,
+	Life:        50,
+	Size:        5,
+	Gravity:     0.016,
+	Drag:        0.02,
+}
+
+// Celebration is the victory effect: confetti raining down and fireworks bursting across the arena
+type Celebration struct {
+	Bounds    fyne.Size               // arena bounds
+	IsActive  bool                    // whether the celebration is playing
+	Timer     int                     // frames left in the celebration
+	Particles *effects.ParticleSystem // where the firework sparks are emitted (set by the UI; nil = no fireworks)
+	confetti  []*confettiPiece
+	nextBurst int // frames until the next firework
+}
+
+// NewCelebration creates a hidden celebration with pooled confetti
+func NewCelebration(bounds fyne.Size) *Celebration {
+	c := &Celebration{Bounds: bounds}
+
+	c.confetti = make([]*confettiPiece, confettiCount)
+	for i := range c.confetti {
+		rect := &canvas.Rectangle{FillColor: celebrationColors[i%len(celebrationColors)]}
+		rect.Resize(fyne.NewSize(confettiPieceWidth, confettiPieceHeight))
+		rect.Hide()
+		c.confetti[i] = &confettiPiece{rect: rect}
+	}
+
+	return c
+}
+
+// Start begins the celebration
+func (c *Celebration) Start() {
+	c.IsActive = true
+	c.Timer = celebrationDuration
+	c.nextBurst = 0
+
+	// Scatter confetti above the top edge so it rains in over time
+	for _, piece := range c.confetti {
+		piece.x = rand.Float32() * c.Bounds.Width
+		piece.y = -rand.Float32() * c.Bounds.Height
+		piece.vx = (rand.Float32()*2 - 1) * confettiMaxDriftX
+		piece.vy = confettiMinFallSpeed + rand.Float32()
+		piece.phase = rand.Float32() * 2 * math.Pi
+		piece.rect.Show()
+	}
+}
+
+// Update animates confetti and fireworks
+func (c *Celebration) Update() {
+	if !c.IsActive {
+		return
+	}
+
+	c.Timer--
+	if c.Timer <= 0 {
+		c.Stop()
+		return
+	}
+
+	// Confetti flutters down with a little gravity
+	for _, piece := range c.confetti {
+		piece.vy += confettiGravity
+		piece.phase += 0.15
+		piece.x += piece.vx + float32(math.Sin(float64(piece.phase)))*0.8
+		piece.y += piece.vy
+
+		// Recycle pieces that fell out while the celebration is still going
+		if piece.y > c.Bounds.Height && c.Timer > 120 {
+			piece.y = -confettiPieceHeight
+			piece.vy = confettiMinFallSpeed + rand.Float32()
+		}
+
+		// Flip between wide and narrow to look like spinning paper
+		width := confettiPieceWidth * float32(math.Abs(math.Cos(float64(piece.phase))))
+		piece.rect.Resize(fyne.NewSize(width+1, confettiPieceHeight))
+		piece.rect.Move(fyne.NewPos(piece.x, piece.y))
+	}
+
+	// Launch a new firework every so often
+	c.nextBurst--
+	if c.nextBurst <= 0 && c.Timer > fireworkBurst.Life {
+		c.launchFirework()
+		c.nextBurst = fireworkInterval
+	}
+}
+
+// launchFirework bursts a firework at a random spot in the upper part of the arena
+func (c *Celebration) launchFirework() {
+	burst := fireworkBurst
+	burst.Colors = []color.RGBA{celebrationColors[rand.Intn(len(celebrationColors))]}
+	x := c.Bounds.Width * (0.15 + rand.Float32()*0.7)
+	y := c.Bounds.Height * (0.15 + rand.Float32()*0.4)
+	c.Particles.Emit(&burst, x, y)
+}
+
+// Stop ends the celebration and hides the confetti. Fireworks already bursting fade out on their own.
+func (c *Celebration) Stop() {
+	c.IsActive = false
+	c.Timer = 0
+	for _, piece := range c.confetti {
+		piece.rect.Hide()
+	}
+}
+
+// GetVisualComponents returns all confetti components for adding to container
+func (c *Celebration) GetVisualComponents() []fyne.CanvasObject {
+	var components []fyne.CanvasObject
+	for _, piece := range c.confetti {
+		components = append(components, piece.rect)
+	}
+	return components
+}
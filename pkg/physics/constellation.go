@@ -0,0 +1,216 @@
+package physics
+
+import (
+	"image/color"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+)
+
+// Constellation tuning
+const (
+	maxConstellations        = 4            // constellations shown at once
+	constellationMinStars    = 3            // fewest stars in a constellation
+	constellationMaxStars    = 6            // most stars in a constellation
+	constellationBrightness  = 190          // dimmest star bright enough to join a constellation
+	constellationLink        = float32(140) // farthest apart two linked stars start out
+	constellationStretch     = float32(1.6) // a link stretched past this multiple of constellationLink breaks it up
+	constellationSpawnFrames = 90           // frames between attempts to form a new constellation
+	constellationLineRadius  = float32(0.5) // half the width of a constellation line
+	constellationLabelSize   = float32(11)  // name text size
+	constellationLabelOffset = float32(14)  // how far below the constellation's center the name sits
+)
+
+// Constellation colors
+var (
+	constellationLineColor  = color.RGBA{R: 160, G: 190, B: 255, A: 60}  // Faint blue lines
+	constellationLabelColor = color.RGBA{R: 170, G: 190, B: 230, A: 110} // Faint blue names
+)
+
+// Constellation name parts
+var (
+	constellationAdjectives = []string{"Great", "Lesser", "Northern", "Southern", "Crimson", "Silent", "Wandering", "Blind", "Burning", "Drowsy"}
+	constellationNouns      = []string{"Eyeball", "Dragon", "Serpent", "Lantern", "Harp", "Crown", "Archer", "Comet", "Whale", "Wanderer"}
+)
+
+// Constellation is a named chain of bright stars, each linked to the one before it
+type Constellation struct {
+	Name  string       // generated name
+	Stars []*Star      // stars in linking order
+	Label *canvas.Text // name shown near the constellation's center
+}
+
+// ConstellationLayer draws faint constellation lines and names between the bright stars, forming new
+// constellations as old ones scroll off screen
+type ConstellationLayer struct {
+	Constellations []*Constellation // constellations on screen
+	IsVisible      bool             // whether the overlay is shown
+	Container      *fyne.Container  // holds the names; the lines are drawn into the star layer
+	spawnTimer     int              // frames until the next attempt to form a constellation
+}
+
+// NewConstellationLayer creates a hidden constellation overlay
+func NewConstellationLayer(bounds fyne.Size) *ConstellationLayer {
+	layer := &ConstellationLayer{Container: container.NewWithoutLayout()}
+	layer.Container.Resize(bounds)
+	layer.SetVisible(false)
+	return layer
+}
+
+// SetVisible shows or hides the constellation overlay. Hiding it forgets the constellations, so new
+// ones form when it is shown again.
+func (l *ConstellationLayer) SetVisible(visible bool) {
+	l.IsVisible = visible
+	setVisible(l.Container, visible)
+	if !visible {
+		l.Clear()
+	}
+}
+
+// Clear removes every constellation
+func (l *ConstellationLayer) Clear() {
+	for _, constellation := range l.Constellations {
+		l.Container.Remove(constellation.Label)
+	}
+	l.Constellations = nil
+	l.spawnTimer = 0
+}
+
+// Update breaks up constellations that have scrolled off screen or been pulled apart by parallax,
+// forms new ones from the stars and moves the names along
+func (l *ConstellationLayer) Update(stars []*Star, bounds fyne.Size) {
+	if !l.IsVisible {
+		return
+	}
+
+	for i := len(l.Constellations) - 1; i >= 0; i-- {
+		constellation := l.Constellations[i]
+		if constellation.isBroken(bounds) {
+			l.Container.Remove(constellation.Label)
+			l.Constellations = append(l.Constellations[:i], l.Constellations[i+1:]...)
+			continue
+		}
+		constellation.placeLabel()
+	}
+
+	l.spawnTimer--
+	if l.spawnTimer > 0 || len(l.Constellations) >= maxConstellations {
+		return
+	}
+	l.spawnTimer = constellationSpawnFrames
+	if constellation := l.form(stars, bounds); constellation != nil {
+		constellation.placeLabel()
+		l.Constellations = append(l.Constellations, constellation)
+		l.Container.Add(constellation.Label)
+	}
+}
+
+// form chains together bright on-screen stars that aren't in a constellation yet, starting from a random
+// one and linking the nearest unused star each time. Returns nil if there aren't enough nearby.
+func (l *ConstellationLayer) form(stars []*Star, bounds fyne.Size) *Constellation {
+	used := make(map[*Star]bool)
+	for _, constellation := range l.Constellations {
+		for _, star := range constellation.Stars {
+			used[star] = true
+		}
+	}
+
+	var candidates []*Star
+	for _, star := range stars {
+		if star != nil && !used[star] && star.Brightness >= constellationBrightness && !isOffscreen(star.X, star.Y, 0, bounds) {
+			candidates = append(candidates, star)
+		}
+	}
+	if len(candidates) < constellationMinStars {
+		return nil
+	}
+
+	start := candidates[rand.Intn(len(candidates))]
+	chain := []*Star{start}
+	used[start] = true
+	size := constellationMinStars + rand.Intn(constellationMaxStars-constellationMinStars+1)
+	for len(chain) < size {
+		last := chain[len(chain)-1]
+		var next *Star
+		closest := constellationLink * constellationLink
+		for _, star := range candidates {
+			if used[star] {
+				continue
+			}
+			dx := star.X - last.X
+			dy := star.Y - last.Y
+			if distance := dx*dx + dy*dy; distance <= closest {
+				closest = distance
+				next = star
+			}
+		}
+		if next == nil {
+			break
+		}
+		chain = append(chain, next)
+		used[next] = true
+	}
+	if len(chain) < constellationMinStars {
+		return nil
+	}
+
+	name := "The " + constellationAdjectives[rand.Intn(len(constellationAdjectives))] + " " +
+		constellationNouns[rand.Intn(len(constellationNouns))]
+	label := canvas.NewText(name, constellationLabelColor)
+	label.TextSize = constellationLabelSize
+	label.TextStyle = fyne.TextStyle{Italic: true}
+	return &Constellation{Name: name, Stars: chain, Label: label}
+}
+
+// isBroken reports whether a star has left the screen or a link has stretched too far
+func (c *Constellation) isBroken(bounds fyne.Size) bool {
+	maxLink := constellationLink * constellationStretch
+	for i, star := range c.Stars {
+		if isOffscreen(star.X, star.Y, 0, bounds) {
+			return true
+		}
+		if i > 0 {
+			dx := star.X - c.Stars[i-1].X
+			dy := star.Y - c.Stars[i-1].Y
+			if dx*dx+dy*dy > maxLink*maxLink {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// placeLabel centers the name just below the middle of the constellation
+func (c *Constellation) placeLabel() {
+	var x, y float32
+	for _, star := range c.Stars {
+		x += star.X
+		y += star.Y
+	}
+	x /= float32(len(c.Stars))
+	y /= float32(len(c.Stars))
+
+	size := c.Label.MinSize()
+	c.Label.Move(fyne.NewPos(x-size.Width/2, y+constellationLabelOffset))
+}
+
+// sprites returns the constellation lines for the star layer to draw
+func (l *ConstellationLayer) sprites() []starSprite {
+	if !l.IsVisible {
+		return nil
+	}
+	var lines []starSprite
+	for _, constellation := range l.Constellations {
+		for i := 1; i < len(constellation.Stars); i++ {
+			from, to := constellation.Stars[i-1], constellation.Stars[i]
+			lines = append(lines, starSprite{
+				X: from.X, Y: from.Y, TailX: to.X, TailY: to.Y,
+				Radius: constellationLineRadius,
+				Color:  constellationLineColor,
+			})
+		}
+	}
+	return lines
+}
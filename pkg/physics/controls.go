@@ -0,0 +1,44 @@
+package physics
+
+import "math"
+
+// ControlMode selects who drives the human
+type ControlMode int
+
+// Control modes
+const (
+	ControlAI     ControlMode = iota // the human dodges balls on its own
+	ControlManual                    // the player steers (keyboard, mouse or gamepad)
+)
+
+// String returns the mode's display name
+func (m ControlMode) String() string {
+	if m == ControlManual {
+		return "Manual"
+	}
+	return "AI"
+}
+
+// manualForce returns the movement for this frame from the player's input.
+// A full stick (or any direction keys) moves at full speed; diagonals are not faster.
+func (h *Human) manualForce() (float32, float32) {
+	dx, dy := h.MoveX, h.MoveY
+	length := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if length == 0 {
+		return 0, 0
+	}
+	if length < 1 {
+		length = 1 // Partial stick tilt moves slower
+	}
+	return dx / length * h.Speed, dy / length * h.Speed
+}
+
+// ReleaseControls clears the manual input state (e.g. when switching back to AI control)
+func (h *Human) ReleaseControls() {
+	h.MoveX = 0
+	h.MoveY = 0
+	h.ManualAim = false
+	h.FireHeld = false
+	h.SprintHeld = false
+	h.MeleeHeld = false
+}
@@ -0,0 +1,227 @@
+package physics
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// DangerMap is a coarse grid of how dangerous each part of the arena will be over the next few seconds,
+// built from the balls' predicted trajectories
+type DangerMap struct {
+	CellSize   float32   // cell width and height in pixels
+	Cols, Rows int       // grid dimensions
+	Cells      []float32 // danger from predicted ball positions per cell, row-major
+	bounds     fyne.Size // arena size the grid covers
+}
+
+// Danger map tuning
+const (
+	dangerCellSize = float32(40) // pixels per grid cell
+	dangerStep     = 10          // frames between predicted samples
+	dangerMax      = float32(2)  // danger reported for the human's own cell is capped here
+	dangerBlocked  = float32(10) // cost of a cell a level's block covers, so the human routes around it
+)
+
+// NewDangerMap creates an empty danger map covering the arena
+func NewDangerMap(bounds fyne.Size, cellSize float32) *DangerMap {
+	cols := int(math.Ceil(float64(bounds.Width / cellSize)))
+	rows := int(math.Ceil(float64(bounds.Height / cellSize)))
+	if cols < 1 {
+		cols = 1
+	}
+	if rows < 1 {
+		rows = 1
+	}
+
+	return &DangerMap{
+		CellSize: cellSize,
+		Cols:     cols,
+		Rows:     rows,
+		Cells:    make([]float32, cols*rows),
+		bounds:   bounds,
+	}
+}
+
+// Build recomputes the grid from where each ball will be over the prediction horizon.
+// Predictions follow the current wall mode; nearer-future positions count for more.
+// Cells the human can't stand in because an obstacle covers them are marked as blocked.
+func (m *DangerMap) Build(balls []*Ball, obstacles []*Obstacle, humanSize float32) {
+	for i := range m.Cells {
+		m.Cells[i] = 0
+	}
+
+	values := Tuning()
+	for _, ball := range balls {
+		if !ball.IsAnimated || ball.IsDestroyed || ball.IsAbsorbed {
+			continue
+		}
+
+		reach := ball.Radius + humanSize*0.5 + values.HumanDangerMargin
+		x, y, vx, vy := ball.X, ball.Y, ball.VX, ball.VY
+		for t := 0; t <= values.DangerHorizon; t += dangerStep {
+			weight := 1 - float32(t)/float32(values.DangerHorizon+dangerStep)
+			m.splat(x, y, reach, weight*weight)
+
+			var inArena bool
+			x, y, vx, vy, inArena = m.predict(x, y, vx, vy, ball.Radius, dangerStep, ball.World.wallMode())
+			if !inArena {
+				break // Absorbed by the wall
+			}
+		}
+	}
+
+	if len(obstacles) == 0 {
+		return
+	}
+	for row := 0; row < m.Rows; row++ {
+		for col := 0; col < m.Cols; col++ {
+			if centerX, centerY := m.cellCenter(col, row); Blocked(obstacles, centerX, centerY, humanSize*0.5) {
+				m.Cells[row*m.Cols+col] += dangerBlocked
+			}
+		}
+	}
+}
+
+// predict moves a ball position the given number of frames ahead, following the ball's wall mode.
+// It returns false once an absorbed ball has left the arena.
+func (m *DangerMap) predict(x, y, vx, vy, radius float32, frames int, mode WallMode) (float32, float32, float32, float32, bool) {
+	x += vx * float32(frames)
+	y += vy * float32(frames)
+	width, height := m.bounds.Width, m.bounds.Height
+
+	switch mode {
+	case WallWrap:
+		x = float32(math.Mod(float64(x+width), float64(width)))
+		y = float32(math.Mod(float64(y+height), float64(height)))
+	case WallAbsorb:
+		if x < -radius || x > width+radius || y < -radius || y > height+radius {
+			return x, y, vx, vy, false
+		}
+	default:
+		// Reflect off the walls like a bounce
+		if x < radius {
+			x, vx = 2*radius-x, -vx
+		} else if x > width-radius {
+			x, vx = 2*(width-radius)-x, -vx
+		}
+		if y < radius {
+			y, vy = 2*radius-y, -vy
+		} else if y > height-radius {
+			y, vy = 2*(height-radius)-y, -vy
+		}
+	}
+	return x, y, vx, vy, true
+}
+
+// splat adds danger around a predicted ball position, fading out to nothing at reach
+func (m *DangerMap) splat(x, y, reach, weight float32) {
+	minCol, minRow := m.cellOf(x-reach, y-reach)
+	maxCol, maxRow := m.cellOf(x+reach, y+reach)
+
+	for row := minRow; row <= maxRow; row++ {
+		for col := minCol; col <= maxCol; col++ {
+			centerX, centerY := m.cellCenter(col, row)
+			dx := centerX - x
+			dy := centerY - y
+			distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+			if distance >= reach {
+				continue
+			}
+			falloff := 1 - distance/reach
+			m.Cells[row*m.Cols+col] += weight * falloff * falloff
+		}
+	}
+}
+
+// At returns the predicted danger at a position
+func (m *DangerMap) At(x, y float32) float32 {
+	col, row := m.cellOf(x, y)
+	return m.Cells[row*m.Cols+col]
+}
+
+// SafestStep returns the center of the neighboring cell to move into from (x, y) on the way
+// to the safest reachable cell, and how much safer that cell is than the current one (0 = stay put).
+// Cells along the walls cost extra, so the human doesn't back itself into a corner.
+func (m *DangerMap) SafestStep(x, y float32) (float32, float32, float32) {
+	col, row := m.cellOf(x, y)
+	here := m.cost(col, row)
+
+	// Find the safest cell, preferring nearby ones
+	targetCol, targetRow := col, row
+	best := here
+	for r := 0; r < m.Rows; r++ {
+		for c := 0; c < m.Cols; c++ {
+			cost := m.cost(c, r) + Tuning().DangerTravelCost*cellDistance(c, r, col, row)
+			if cost < best {
+				best = cost
+				targetCol, targetRow = c, r
+			}
+		}
+	}
+	if targetCol == col && targetRow == row {
+		return x, y, 0
+	}
+
+	// Descend: step into the neighbor that best trades its own danger against the distance left to the target,
+	// which routes around dangerous cells instead of straight through them
+	stepCol, stepRow := col, row
+	stepCost := float32(math.Inf(1))
+	for dr := -1; dr <= 1; dr++ {
+		for dc := -1; dc <= 1; dc++ {
+			c, r := col+dc, row+dr
+			if (dc == 0 && dr == 0) || c < 0 || c >= m.Cols || r < 0 || r >= m.Rows {
+				continue
+			}
+			cost := m.cost(c, r) + Tuning().DangerTravelCost*cellDistance(c, r, targetCol, targetRow)
+			if cost < stepCost {
+				stepCost = cost
+				stepCol, stepRow = c, r
+			}
+		}
+	}
+
+	stepX, stepY := m.cellCenter(stepCol, stepRow)
+	return stepX, stepY, here - m.cost(targetCol, targetRow)
+}
+
+// cost returns a cell's predicted danger plus the wall penalty
+func (m *DangerMap) cost(col, row int) float32 {
+	cost := m.Cells[row*m.Cols+col]
+	if col == 0 || col == m.Cols-1 {
+		cost += Tuning().DangerWallPenalty
+	}
+	if row == 0 || row == m.Rows-1 {
+		cost += Tuning().DangerWallPenalty
+	}
+	return cost
+}
+
+// cellOf returns the cell containing a position, clamped to the grid
+func (m *DangerMap) cellOf(x, y float32) (int, int) {
+	col := int(x / m.CellSize)
+	row := int(y / m.CellSize)
+	if col < 0 {
+		col = 0
+	} else if col >= m.Cols {
+		col = m.Cols - 1
+	}
+	if row < 0 {
+		row = 0
+	} else if row >= m.Rows {
+		row = m.Rows - 1
+	}
+	return col, row
+}
+
+// cellCenter returns the center of a cell in arena coordinates
+func (m *DangerMap) cellCenter(col, row int) (float32, float32) {
+	return (float32(col) + 0.5) * m.CellSize, (float32(row) + 0.5) * m.CellSize
+}
+
+// cellDistance returns the distance between two cells in cells
+func cellDistance(col1, row1, col2, row2 int) float32 {
+	dc := float64(col1 - col2)
+	dr := float64(row1 - row2)
+	return float32(math.Sqrt(dc*dc + dr*dr))
+}
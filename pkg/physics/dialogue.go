@@ -0,0 +1,330 @@
+package physics
+
+import (
+	"image/color"
+	"math/rand"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// SpeechBubble is a small text bubble that floats above an entity
+type SpeechBubble struct {
+	Background *canvas.Rectangle // Rounded bubble background
+	Text       *canvas.Text      // Bubble text
+	Timer      int               // frames remaining while visible
+	IsVisible  bool              // whether the bubble is currently shown
+}
+
+// NewSpeechBubble creates a hidden speech bubble
+func NewSpeechBubble() *SpeechBubble {
+	bubble := &SpeechBubble{}
+
+	bubble.Background = &canvas.Rectangle{
+		FillColor:    color.RGBA{R: 255, G: 255, B: 255, A: 220}, // Mostly opaque white
+		StrokeColor:  color.RGBA{R: 60, G: 60, B: 60, A: 255},    // Dark gray outline
+		StrokeWidth:  1.5,
+		CornerRadius: 6,
+	}
+
+	bubble.Text = &canvas.Text{
+		Color:     color.RGBA{R: 20, G: 20, B: 20, A: 255}, // Near-black text
+		Alignment: fyne.TextAlignCenter,
+		TextSize:  11,
+	}
+
+	bubble.Hide()
+
+	return bubble
+}
+
+// Say shows the bubble with the given message for a number of frames
+func (s *SpeechBubble) Say(message string, frames int) {
+	s.Text.Text = message
+	s.Text.Refresh()
+	s.Timer = frames
+	s.IsVisible = true
+	s.Background.Show()
+	s.Text.Show()
+}
+
+// Update counts down the bubble's display time and hides it when done
+func (s *SpeechBubble) Update() {
+	if !s.IsVisible {
+		return
+	}
+	s.Timer--
+	if s.Timer <= 0 {
+		s.Hide()
+	}
+}
+
+// MoveAbove positions the bubble centered above the point (x, y)
+func (s *SpeechBubble) MoveAbove(x, y float32) {
+	padding := float32(6)
+	textSize := s.Text.MinSize()
+	width := textSize.Width + padding*2
+	height := textSize.Height + padding
+
+	left := x - width/2
+	top := y - height - 8 // Small gap above the anchor point
+
+	s.Background.Resize(fyne.NewSize(width, height))
+	s.Background.Move(fyne.NewPos(left, top))
+	s.Text.Resize(fyne.NewSize(width, height))
+	s.Text.Move(fyne.NewPos(left, top))
+}
+
+// Hide hides the bubble
+func (s *SpeechBubble) Hide() {
+	s.IsVisible = false
+	s.Timer = 0
+	s.Background.Hide()
+	s.Text.Hide()
+}
+
+// GetVisualComponents returns the bubble's visual components for UI management
+func (s *SpeechBubble) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{s.Background, s.Text}
+}
+
+// ballDialogues holds short templated exchanges between two balls.
+// {self} is replaced with the speaker's name and {other} with the listener's.
+var ballDialogues = [][]string{
+	{"Hey {other}, seen the human?", "Only with my entire iris, {other}."},
+	{"{other}! Stop bumping me.", "That was physics, not me.", "Sure it was."},
+	{"Do you ever blink, {other}?", "Blinking is for lesser eyes."},
+	{"I'm {self}, nice to meet you.", "I know. Your name is written under you."},
+	{"That dragon is purple, right?", "I see it in 16 million colors.", "Show-off."},
+	{"Is it me or is space moving?", "We're flying, {other}. Keep up."},
+	{"What's your context window, {other}?", "Bigger than yours.", "Rude."},
+	{"Those bullets look like us.", "Flattering, honestly."},
+}
+
+// ballConversation tracks an exchange in progress between two balls
+type ballConversation struct {
+	speakers [2]*Ball
+	lines    []string
+	line     int           // index of the line currently shown
+	bubble   *SpeechBubble // bubble reused for every line
+}
+
+// BallConversations triggers short speech-bubble exchanges between balls that linger near each other
+type BallConversations struct {
+	LingerDistance float32 // extra distance beyond touching that counts as "near"
+	LingerFrames   int     // frames two balls must stay near before talking
+	LineFrames     int     // frames each line stays on screen
+	PairCooldown   int     // frames before the same pair can talk again
+	GlobalCooldown int     // frames between any two conversations
+	globalTimer    int
+	lingerCounters map[[2]int]int // pair key -> consecutive frames near each other
+	pairCooldowns  map[[2]int]int // pair key -> frames until the pair may talk again
+	active         []*ballConversation
+	bubbles        []*SpeechBubble // fixed pool so the UI can register them once
+}
+
+// NewBallConversations creates a conversation manager with a fixed pool of speech bubbles
+func NewBallConversations(maxConcurrent int) *BallConversations {
+	bc := &BallConversations{
+		LingerDistance: 60,
+		LingerFrames:   90,   // 1.5 seconds near each other
+		LineFrames:     120,  // 2 seconds per line
+		PairCooldown:   3600, // Same pair waits a minute
+		GlobalCooldown: 900,  // 15 seconds between conversations keeps it rare
+		lingerCounters: make(map[[2]int]int),
+		pairCooldowns:  make(map[[2]int]int),
+	}
+
+	bc.bubbles = make([]*SpeechBubble, maxConcurrent)
+	for i := range bc.bubbles {
+		bc.bubbles[i] = NewSpeechBubble()
+	}
+
+	return bc
+}
+
+// pairKey returns an order-independent key for two balls
+func pairKey(a, b *Ball) [2]int {
+	if a.ID < b.ID {
+		return [2]int{a.ID, b.ID}
+	}
+	return [2]int{b.ID, a.ID}
+}
+
+// Update advances conversations in progress and starts new ones between lingering balls
+func (bc *BallConversations) Update(balls []*Ball) {
+	if bc.globalTimer > 0 {
+		bc.globalTimer--
+	}
+	for key, frames := range bc.pairCooldowns {
+		if frames <= 1 {
+			delete(bc.pairCooldowns, key)
+		} else {
+			bc.pairCooldowns[key] = frames - 1
+		}
+	}
+
+	bc.updateActive(balls)
+	bc.trackLingering(balls)
+}
+
+// updateActive advances or ends conversations in progress
+func (bc *BallConversations) updateActive(balls []*Ball) {
+	for i := len(bc.active) - 1; i >= 0; i-- {
+		conv := bc.active[i]
+
+		// End the conversation if either ball left the game
+		if !containsBall(balls, conv.speakers[0]) || !containsBall(balls, conv.speakers[1]) {
+			conv.bubble.Hide()
+			bc.active = append(bc.active[:i], bc.active[i+1:]...)
+			continue
+		}
+
+		conv.bubble.Update()
+		if !conv.bubble.IsVisible {
+			conv.line++
+			if conv.line >= len(conv.lines) {
+				bc.active = append(bc.active[:i], bc.active[i+1:]...)
+				continue
+			}
+			bc.sayLine(conv)
+		}
+
+		speaker := conv.speakers[conv.line%2]
+		conv.bubble.MoveAbove(speaker.X, speaker.Y-speaker.Radius)
+	}
+}
+
+// trackLingering counts how long pairs of balls stay close and starts a conversation when ready
+func (bc *BallConversations) trackLingering(balls []*Ball) {
+	near := make(map[[2]int]bool)
+
+	for i := 0; i < len(balls); i++ {
+		for j := i + 1; j < len(balls); j++ {
+			a, b := balls[i], balls[j]
+			if a.LLMName == "" || b.LLMName == "" {
+				continue
+			}
+
+			dx := a.X - b.X
+			dy := a.Y - b.Y
+			reach := a.Radius + b.Radius + bc.LingerDistance
+			if dx*dx+dy*dy > reach*reach {
+				continue
+			}
+
+			key := pairKey(a, b)
+			near[key] = true
+			bc.lingerCounters[key]++
+
+			if bc.lingerCounters[key] >= bc.LingerFrames && bc.canStart(key, a, b) {
+				bc.start(a, b)
+				bc.lingerCounters[key] = 0
+			}
+		}
+	}
+
+	// Forget pairs that drifted apart
+	for key := range bc.lingerCounters {
+		if !near[key] {
+			delete(bc.lingerCounters, key)
+		}
+	}
+}
+
+// canStart reports whether a new conversation between a and b is allowed
+func (bc *BallConversations) canStart(key [2]int, a, b *Ball) bool {
+	if bc.globalTimer > 0 || bc.pairCooldowns[key] > 0 {
+		return false
+	}
+	if len(bc.active) >= len(bc.bubbles) {
+		return false
+	}
+	for _, conv := range bc.active {
+		for _, speaker := range conv.speakers {
+			if speaker == a || speaker == b {
+				return false // One conversation per ball
+			}
+		}
+	}
+	return true
+}
+
+// start begins a conversation between two balls using a random dialogue template
+func (bc *BallConversations) start(a, b *Ball) {
+	template := ballDialogues[rand.Intn(len(ballDialogues))]
+	conv := &ballConversation{
+		speakers: [2]*Ball{a, b},
+		lines:    template,
+		bubble:   bc.freeBubble(),
+	}
+	if conv.bubble == nil {
+		return
+	}
+
+	bc.active = append(bc.active, conv)
+	bc.pairCooldowns[pairKey(a, b)] = bc.PairCooldown
+	bc.globalTimer = bc.GlobalCooldown
+
+	bc.sayLine(conv)
+	conv.bubble.MoveAbove(a.X, a.Y-a.Radius)
+}
+
+// sayLine shows the current line of a conversation, filling in the names
+func (bc *BallConversations) sayLine(conv *ballConversation) {
+	speaker := conv.speakers[conv.line%2]
+	listener := conv.speakers[(conv.line+1)%2]
+
+	message := strings.ReplaceAll(conv.lines[conv.line], "{self}", speaker.LLMName)
+	message = strings.ReplaceAll(message, "{other}", listener.LLMName)
+
+	conv.bubble.Say(message, bc.LineFrames)
+}
+
+// freeBubble returns a bubble from the pool that no conversation is using
+func (bc *BallConversations) freeBubble() *SpeechBubble {
+	for _, bubble := range bc.bubbles {
+		inUse := false
+		for _, conv := range bc.active {
+			if conv.bubble == bubble {
+				inUse = true
+				break
+			}
+		}
+		if !inUse {
+			return bubble
+		}
+	}
+	return nil
+}
+
+// Reset ends all conversations and clears cooldowns
+func (bc *BallConversations) Reset() {
+	for _, conv := range bc.active {
+		conv.bubble.Hide()
+	}
+	bc.active = nil
+	bc.globalTimer = 0
+	bc.lingerCounters = make(map[[2]int]int)
+	bc.pairCooldowns = make(map[[2]int]int)
+}
+
+// GetVisualComponents returns all bubble components for adding to container
+func (bc *BallConversations) GetVisualComponents() []fyne.CanvasObject {
+	var components []fyne.CanvasObject
+	for _, bubble := range bc.bubbles {
+		components = append(components, bubble.GetVisualComponents()...)
+	}
+	return components
+}
+
+// containsBall reports whether target is still in the balls slice
+func containsBall(balls []*Ball, target *Ball) bool {
+	for _, ball := range balls {
+		if ball == target {
+			return true
+		}
+	}
+	return false
+}
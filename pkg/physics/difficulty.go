@@ -0,0 +1,88 @@
+package physics
+
+import "strings"
+
+// Difficulty is a preset for how hard the game plays
+type Difficulty int
+
+// Difficulties, easiest first
+const (
+	DifficultyEasy Difficulty = iota
+	DifficultyNormal
+	DifficultyHard
+	DifficultyNightmare
+)
+
+// Difficulties lists every difficulty in the order they are offered
+var Difficulties = []Difficulty{DifficultyEasy, DifficultyNormal, DifficultyHard, DifficultyNightmare}
+
+// CurrentDifficulty is the difficulty the game is played at; everything it affects reads its Config
+var CurrentDifficulty = DifficultyNormal
+
+// DifficultyConfig is what a difficulty changes, each multiplier relative to Normal
+type DifficultyConfig struct {
+	BallSpeed     float32 // multiplies how far every ball travels each frame
+	SpawnRate     float32 // multiplies how often wave balls spawn
+	HumanHP       int     // hits a player can take before exploding, before upgrades
+	DragonPower   float32 // multiplies the dragon's protect radius and how long its flames burn
+	ShootCooldown float32 // multiplies the frames between shots
+}
+
+// String returns the difficulty's display name
+func (d Difficulty) String() string {
+	switch d {
+	case DifficultyEasy:
+		return "Easy"
+	case DifficultyHard:
+		return "Hard"
+	case DifficultyNightmare:
+		return "Nightmare"
+	default:
+		return "Normal"
+	}
+}
+
+// Next returns the difficulty after this one, cycling back to DifficultyEasy
+func (d Difficulty) Next() Difficulty {
+	return (d + 1) % (DifficultyNightmare + 1)
+}
+
+// DifficultyByName returns the difficulty with the given display name (any case), or DifficultyNormal for unknown names
+func DifficultyByName(name string) Difficulty {
+	for _, difficulty := range Difficulties {
+		if strings.EqualFold(difficulty.String(), name) {
+			return difficulty
+		}
+	}
+	return DifficultyNormal
+}
+
+// Config returns what the difficulty changes
+func (d Difficulty) Config() DifficultyConfig {
+	switch d {
+	case DifficultyEasy:
+		return DifficultyConfig{BallSpeed: 0.75, SpawnRate: 0.75, HumanHP: 3, DragonPower: 1.3, ShootCooldown: 0.8}
+	case DifficultyHard:
+		return DifficultyConfig{BallSpeed: 1.25, SpawnRate: 1.3, HumanHP: 1, DragonPower: 0.8, ShootCooldown: 1.15}
+	case DifficultyNightmare:
+		return DifficultyConfig{BallSpeed: 1.5, SpawnRate: 1.6, HumanHP: 1, DragonPower: 0.6, ShootCooldown: 1.3}
+	default:
+		return DifficultyConfig{BallSpeed: 1, SpawnRate: 1, HumanHP: 1, DragonPower: 1, ShootCooldown: 1}
+	}
+}
+
+// ApplyDifficulty resizes the human's health for the current difficulty, keeping any extra HP upgrades.
+// A living human is healed by any health gained; health above a lower maximum is lost.
+func (h *Human) ApplyDifficulty() {
+	maxHP := CurrentDifficulty.Config().HumanHP + h.Progress.Upgrades[UpgradeExtraHP]
+	if h.IsActive && maxHP > h.MaxHP {
+		h.HP += maxHP - h.MaxHP
+	}
+	h.MaxHP = maxHP
+	h.HP = min(h.HP, h.MaxHP)
+}
+
+// ApplyDifficulty rescales the dragon's protect radius for the current difficulty
+func (d *Dragon) ApplyDifficulty() {
+	d.SetProtectRadius(d.baseProtectRadius)
+}
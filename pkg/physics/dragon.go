@@ -6,6 +6,7 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
 )
 
 // Dragon represents a dragon that protects the human by following them and deflecting balls
@@ -17,27 +18,44 @@ type Dragon struct {
 	Speed         float32   // movement speed
 	FollowDistance float32  // preferred distance to maintain from human
 	ProtectRadius  float32  // radius within which dragon will intercept balls
+	Stance         DragonStance // how far past the protect radius it chases balls (see stance.go)
+	BurnFrames     int      // frames a ball burns after touching the dragon's flames
 	Bounds        fyne.Size // movement bounds
+	Obstacles      []*Obstacle  // the level's blocks it flies around (set by the game)
 	IsActive      bool      // whether the dragon is active
 	// Human movement tracking for strategic deflection
 	LastHumanX    float32 // previous human X position
 	LastHumanY    float32 // previous human Y position
 	HumanVX       float32 // calculated human velocity X
 	HumanVY       float32 // calculated human velocity Y
+	// Behavior state machine (see dragonstate.go)
+	State       DragonState // current behavior
+	StateFrames int         // frames spent in the current state
+	patrolAngle float32     // position on the patrol circle around the human's last position
 	// Collision and drift state
-	IsDrifting    bool // whether dragon is currently drifting after collision
-	DriftTimer    int  // frames remaining in drift mode
-	DriftDuration int  // total frames to drift
+	DriftTimer    int // frames remaining in drift mode
+	DriftDuration int // total frames to drift
+	deflectTimer  int // frames until the dragon can deflect another ball
 	// Spinning animation state
-	IsSpinning bool    // whether dragon is spinning while resuming follow
 	SpinAngle  float32 // current spin angle
 	SpinCount  int     // number of spins completed
 	SpinTarget int     // target number of spins (4)
 	// Intercept rotation state
-	IsIntercepting     bool    // whether dragon is currently intercepting a ball
-	InterceptAngle     float32 // current rotation angle during intercept
+	InterceptAngle     float32 // direction the dragon is drawn facing (its heading, or toward a target)
 	TargetAngle        float32 // target angle to rotate toward
-	ReturnToHorizontal bool    // flag to return to horizontal after collision
+	ReturnToHorizontal bool    // flag to ease back to the heading after facing a target
+	Heading            float32 // direction of travel the dragon faces (radians, 0 = right)
+	// Energy (see energy.go)
+	Energy    float32 // energy left for intercepting, deflecting and spinning
+	MaxEnergy float32 // energy when fully rested
+	exhausted bool    // ran out of energy and retreating until it recovers
+	// Rider (see rider.go)
+	Rider        *Human  // human riding the dragon (nil = free to protect)
+	HP           int     // chip damage left before the dragon bucks its rider off
+	MaxHP        int     // HP when fully rested
+	rideX, rideY float32 // rider's steering, as a fraction of the rider's walking speed
+	chipTimer    int     // frames until the dragon can be chipped again
+	regenTimer   int     // frames toward the next HP regained
 	// Visual components
 	Head      *canvas.Circle
 	Body      *canvas.Rectangle
@@ -48,8 +66,32 @@ type Dragon struct {
 	RightEye  *canvas.Circle
 	// Animation state
 	WingFlap       float32 // wing flapping animation
-	FlameParticles []*canvas.Circle
+	Particles      *effects.ParticleSystem // where the exhaust flames are emitted (set by the UI; nil = no flames)
+	flames         []*effects.Particle     // exhaust flames still alight, which set balls on fire
 	FlameTimer     int
+	Shadow         *Shadow // Ground shadow (drawn on the shadow layer)
+	Smoke          *Ribbon // faint smoke trail left while intercepting (see trail.go)
+	smokeFade      float32 // how visible the smoke trail is, 0 to 1
+	// AI scheduling (threat scanning runs on AI ticks and is cached in between)
+	AI           *AIScheduler // shared AI tick scheduler (nil = every frame)
+	cachedThreat *Ball        // closest threat found on the last AI tick
+	// Growth (see dragontier.go)
+	Progression       *DragonProgression // deflections counted toward the next tier
+	baseSize          float32            // hatchling size; each tier scales it up
+	baseProtectRadius float32            // hatchling protect radius; each tier scales it up
+	Horns             [2]*canvas.Line    // shown from adult up
+	Spikes            []*canvas.Line     // shown on an elder
+	Events            *EventBus          // receives dragon growth events (may be nil)
+	// Duel (see duel.go)
+	Rival        bool    // an enemy dragon that herds balls toward the human
+	Foe          *Dragon // the dragon this one is dueling (nil = no duel)
+	RetreatTimer int     // frames left retreating after losing the dominance meter
+	herdTarget   *Ball   // ball a rival is lining up to shove at the human
+	// Catch and throw (see carry.go)
+	Carried    *Ball // small ball held in the dragon's claws (nil = claws empty)
+	carryTimer int   // frames the carried ball has been held
+	// Hostile aliens (see plasma.go)
+	Orbs []*Bullet // plasma orbs in flight, set each frame by the game
 }
 
 // NewDragon creates a new dragon figure that protects the human
@@ -61,9 +103,10 @@ func NewDragon(x, y, size float32) *Dragon {
 		VY:            0,
 		Size:          size,
 		Mass:          70.0,  // Will be updated to twice the largest ball mass
-		Speed:         2.0,   // Slower, more controlled movement
-		FollowDistance: 80.0, // Preferred distance from human
-		ProtectRadius:  150.0, // Will intercept balls within this radius of human
+		Speed:         Tuning().DragonSpeed,
+		FollowDistance: Tuning().DragonFollowDistance, // Preferred distance from human
+		ProtectRadius:  Tuning().DragonProtectRadius,  // Will intercept balls within this radius of human
+		BurnFrames:     Tuning().DragonBurnFrames,
 		Bounds:        fyne.NewSize(800, 600),
 		IsActive:      true,
 		// Initialize human tracking
@@ -73,13 +116,21 @@ func NewDragon(x, y, size float32) *Dragon {
 		HumanVY:       0,
 		DriftDuration: 60, // Shorter drift duration for more responsive protection
 		SpinTarget:    2,  // Fewer spins for faster recovery
+		HP:            dragonMaxHP,
+		Energy:        dragonMaxEnergy,
+		MaxEnergy:     dragonMaxEnergy,
+		MaxHP:         dragonMaxHP,
+		Heading:       math.Pi, // Start facing left
+		InterceptAngle: math.Pi,
+		Progression:   NewDragonProgression(),
+		baseSize:      size,
+		baseProtectRadius: Tuning().DragonProtectRadius,
 	}
 
 	// Dragon colors
 	dragonColor := color.RGBA{R: 150, G: 50, B: 200, A: 255} // Purple dragon
 	wingColor := color.RGBA{R: 100, G: 30, B: 150, A: 255}   // Darker purple wings
 	eyeColor := color.RGBA{R: 255, G: 255, B: 0, A: 255}     // Yellow eyes
-	flameColor := color.RGBA{R: 255, G: 100, B: 50, A: 255}  // Orange flames
 
 	// Head (circle)
 	dragon.Head = &canvas.Circle{
@@ -87,7 +138,6 @@ func NewDragon(x, y, size float32) *Dragon {
 		StrokeColor: color.RGBA{R: 0, G: 0, B: 0, A: 255},
 		StrokeWidth: 2.0,
 	}
-	dragon.Head.Resize(fyne.NewSize(size*0.5, size*0.5))
 
 	// Body (ellipse)
 	dragon.Body = &canvas.Rectangle{
@@ -95,7 +145,6 @@ func NewDragon(x, y, size float32) *Dragon {
 		StrokeColor: color.RGBA{R: 0, G: 0, B: 0, A: 255},
 		StrokeWidth: 2.0,
 	}
-	dragon.Body.Resize(fyne.NewSize(size*0.8, size*0.4))
 
 	// Tail (rectangle)
 	dragon.Tail = &canvas.Rectangle{
@@ -103,7 +152,6 @@ func NewDragon(x, y, size float32) *Dragon {
 		StrokeColor: color.RGBA{R: 0, G: 0, B: 0, A: 255},
 		StrokeWidth: 1.0,
 	}
-	dragon.Tail.Resize(fyne.NewSize(size*0.6, size*0.2))
 
 	// Wings (rectangles)
 	dragon.LeftWing = &canvas.Rectangle{
@@ -111,14 +159,12 @@ func NewDragon(x, y, size float32) *Dragon {
 		StrokeColor: color.RGBA{R: 0, G: 0, B: 0, A: 255},
 		StrokeWidth: 1.0,
 	}
-	dragon.LeftWing.Resize(fyne.NewSize(size*0.4, size*0.6))
 
 	dragon.RightWing = &canvas.Rectangle{
 		FillColor:   wingColor,
 		StrokeColor: color.RGBA{R: 0, G: 0, B: 0, A: 255},
 		StrokeWidth: 1.0,
 	}
-	dragon.RightWing.Resize(fyne.NewSize(size*0.4, size*0.6))
 
 	// Eyes (small circles)
 	dragon.LeftEye = &canvas.Circle{
@@ -126,27 +172,25 @@ func NewDragon(x, y, size float32) *Dragon {
 		StrokeColor: color.RGBA{R: 0, G: 0, B: 0, A: 255},
 		StrokeWidth: 1.0,
 	}
-	dragon.LeftEye.Resize(fyne.NewSize(size*0.1, size*0.1))
 
 	dragon.RightEye = &canvas.Circle{
 		FillColor:   eyeColor,
 		StrokeColor: color.RGBA{R: 0, G: 0, B: 0, A: 255},
 		StrokeWidth: 1.0,
 	}
-	dragon.RightEye.Resize(fyne.NewSize(size*0.1, size*0.1))
 
-	// Initialize flame particles
-	dragon.FlameParticles = make([]*canvas.Circle, 8)
-	for i := range dragon.FlameParticles {
-		flame := &canvas.Circle{
-			FillColor:   flameColor,
-			StrokeColor: color.RGBA{R: 255, G: 50, B: 0, A: 255},
-			StrokeWidth: 1.0,
-		}
-		flameSize := size * 0.15 * (1.0 - float32(i)*0.1)
-		flame.Resize(fyne.NewSize(flameSize, flameSize))
-		dragon.FlameParticles[i] = flame
-	}
+	// Draw it in the current theme
+	dragon.ApplyTheme()
+
+	// Horns and spikes appear as the dragon grows
+	dragon.createTierDetails()
+
+	// Size every part for the hatchling
+	dragon.resizeParts()
+
+	// Create the ground shadow and smoke trail
+	dragon.Shadow = NewShadow()
+	dragon.Smoke = NewRibbon(smokeTrailSegments, smokeSampleFrames)
 
 	// Set initial position
 	dragon.UpdatePosition()
@@ -173,13 +217,13 @@ func (d *Dragon) FindLargestBall(balls []*Ball) *Ball {
 	return largestBall
 }
 
-// UpdateMass updates dragon mass to be twice the largest ball's mass
+// UpdateMass updates dragon mass to be a multiple of the largest ball's mass (twice as a hatchling)
 func (d *Dragon) UpdateMass(balls []*Ball) {
 	largestBall := d.FindLargestBall(balls)
 	if largestBall != nil {
 		// Calculate ball mass based on area (π * r²) and assume unit density
 		ballMass := math.Pi * float64(largestBall.Radius) * float64(largestBall.Radius)
-		d.Mass = float32(ballMass) * 2.0 // Dragon has twice the mass of largest ball
+		d.Mass = float32(ballMass) * d.Progression.Tier.massScale() // Twice the largest ball's mass, more as it grows
 
 		// Ensure minimum mass for dragon effectiveness
 		minMass := float32(1000.0)
@@ -206,7 +250,7 @@ func (d *Dragon) updateHumanVelocity(human *Human) {
 	d.LastHumanY = human.Y
 }
 
-// FindThreateningBalls finds balls that are moving toward the human and within protect radius
+// FindThreateningBalls finds balls that are moving toward the human and within chasing range
 func (d *Dragon) FindThreateningBalls(balls []*Ball, human *Human) []*Ball {
 	if human == nil || !human.IsActive {
 		return nil
@@ -224,8 +268,8 @@ func (d *Dragon) FindThreateningBalls(balls []*Ball, human *Human) []*Ball {
 		dy := human.Y - ball.Y
 		distanceToHuman := float32(math.Sqrt(float64(dx*dx + dy*dy)))
 
-		// Only consider balls within protect radius
-		if distanceToHuman > d.ProtectRadius {
+		// Only consider balls within chasing range (the protect radius, further when aggressive)
+		if distanceToHuman > d.chaseRadius() {
 			continue
 		}
 
@@ -363,16 +407,23 @@ func (d *Dragon) HandleBallCollision(ball *Ball, human *Human) {
 		d.VX -= normalizedDx * dragonBounce
 		d.VY -= normalizedDy * dragonBounce
 
-		// Enter brief drift mode
-		d.IsDrifting = true
-		d.DriftTimer = d.DriftDuration / 2 // Shorter drift for responsiveness
-		d.IsSpinning = false
-		d.SpinAngle = 0
-		d.SpinCount = 0
+		// Enter brief drift mode (a ridden dragon keeps flying where its rider steers), and don't hit the
+		// same ball again straight away
+		d.deflectTimer = d.DriftDuration / 2
+		if d.Rider == nil {
+			d.SetState(DragonDrift)
+		}
+
+		// Every deflection tires the dragon out
+		d.spendEnergy(dragonDeflectCost)
 
-		// Set flag to return to horizontal after collision
-		d.IsIntercepting = false
-		d.ReturnToHorizontal = true
+		// ...but every deflection also counts toward growing up
+		d.countDeflection()
+
+		// Dragon impacts hit hard; a destroyed ball counts toward the human's score
+		if ball.TakeDamage(2) {
+			human.Score += ball.ScoreValue()
+		}
 	}
 }
 
@@ -382,39 +433,86 @@ func (d *Dragon) Update(balls []*Ball, human *Human) {
 		return
 	}
 
+	// A ridden dragon only looks after its rider
+	if d.Rider != nil {
+		human = d.Rider
+	}
+	d.updateHealth()
+
 	// Update human movement tracking for strategic deflection
 	d.updateHumanVelocity(human)
 
 	// Update mass based on current largest ball
 	d.UpdateMass(balls)
 
-	// Check for collisions with balls (only when not already drifting)
-	if !d.IsDrifting {
+	// Check for collisions with balls (only when not just deflected one, and not when too tired to deflect)
+	if d.deflectTimer > 0 {
+		d.deflectTimer--
+	} else if !d.exhausted && !d.Rival {
 		if collidedBall := d.CheckCollisionWithBalls(balls); collidedBall != nil {
-			d.HandleBallCollision(collidedBall, human)
+			// Small balls get caught to throw at other threats; the rest are deflected
+			if d.canGrab(collidedBall) {
+				d.grabBall(collidedBall)
+			} else {
+				d.HandleBallCollision(collidedBall, human)
+			}
 		}
 	}
 
-	// Handle different behavior states
-	if d.IsDrifting {
-		d.updateDrifting()
-	} else if d.IsSpinning {
-		d.updateSpinning()
-	} else {
-		d.updateProtecting(balls, human)
+	// Plasma orbs fizzle out against the dragon's body
+	if !d.Rival {
+		d.absorbOrbs()
 	}
 
+	// Pick this frame's behavior, then run it
+	d.SetState(d.nextState(balls, human))
+	d.updateState(balls, human)
+
+	// Fly around the level's blocks instead of pushing into them
+	d.VX, d.VY = SteerAround(d.Obstacles, d.X, d.Y, d.VX, d.VY, d.Size*0.5, dragonAvoidLookahead)
+
 	// Apply movement
 	d.X += d.VX
 	d.Y += d.VY
 
-	// Keep dragon within bounds
+	// Keep dragon within bounds and out of the blocks
 	d.keepWithinBounds()
+	d.keepOutOfObstacles()
+	d.carryRider()
+	d.carryBall()
+
+	// Set alight any balls caught in the flames (a rival's flames are just for show)
+	if !d.Rival {
+		d.breatheFire(balls)
+	}
 
 	// Update animations
 	d.updateAnimations()
 }
 
+// breatheFire sets every ball touching one of the dragon's flame particles on fire
+func (d *Dragon) breatheFire(balls []*Ball) {
+	for _, ball := range balls {
+		if ball.IsDestroyed || ball.IsAbsorbed || ball.HasStatus(StatusBurning) {
+			continue
+		}
+
+		for _, flame := range d.flames {
+			if !flame.Alive() {
+				continue
+			}
+
+			dx := ball.X - flame.X
+			dy := ball.Y - flame.Y
+			touchDistance := ball.Radius + flame.Size/2
+			if dx*dx+dy*dy < touchDistance*touchDistance {
+				ball.ApplyStatus(StatusBurning, int(float32(d.BurnFrames)*CurrentDifficulty.Config().DragonPower))
+				break
+			}
+		}
+	}
+}
+
 // updateDrifting handles drifting behavior after collision
 func (d *Dragon) updateDrifting() {
 	d.DriftTimer--
@@ -424,39 +522,9 @@ func (d *Dragon) updateDrifting() {
 	d.VY *= 0.95
 
 	// Smoothly return to horizontal during drift
-	if d.ReturnToHorizontal {
-		targetHorizontal := float32(0)
-		angleDiff := targetHorizontal - d.InterceptAngle
+	d.levelOut(0.08) // Slower return during drift
 
-		// Normalize angle difference to [-pi, pi] range
-		for angleDiff > math.Pi {
-			angleDiff -= 2 * math.Pi
-		}
-		for angleDiff < -math.Pi {
-			angleDiff += 2 * math.Pi
-		}
-
-		// Return to horizontal with smooth rotation
-		returnSpeed := float32(0.08) // Slower return during drift
-		d.InterceptAngle += angleDiff * returnSpeed
-
-		// Stop returning when close enough to horizontal
-		if math.Abs(float64(angleDiff)) < 0.1 {
-			d.InterceptAngle = 0
-			d.ReturnToHorizontal = false
-		}
-	}
-
-	// Check if drift time is over
-	if d.DriftTimer <= 0 {
-		d.IsDrifting = false
-		d.VX = 0
-		d.VY = 0
-		// Start brief spinning animation before resuming protection
-		d.IsSpinning = true
-		d.SpinAngle = 0
-		d.SpinCount = 0
-	}
+	// Once the drift is over, the state machine starts a brief spin before resuming protection
 }
 
 // updateSpinning handles spinning animation when resuming protection
@@ -464,112 +532,93 @@ func (d *Dragon) updateSpinning() {
 	// Faster spin speed for quicker recovery
 	spinSpeed := float32(2 * math.Pi / 10)
 	d.SpinAngle += spinSpeed
+	d.spendEnergy(dragonSpinCost)
 
 	// Check if completed a full rotation
 	if d.SpinAngle >= 2*math.Pi {
 		d.SpinAngle -= 2 * math.Pi
 		d.SpinCount++
 
-		// Check if completed target number of spins
-		if d.SpinCount >= d.SpinTarget {
-			d.IsSpinning = false
-			d.SpinAngle = 0
-			d.SpinCount = 0
-		}
-	}
-}
-
-// updateProtecting handles protective behavior - following human and intercepting threats
-func (d *Dragon) updateProtecting(balls []*Ball, human *Human) {
-	if human == nil || !human.IsActive {
-		d.VX = 0
-		d.VY = 0
-		return
-	}
-
-	// Find threatening balls
-	threateningBalls := d.FindThreateningBalls(balls, human)
-	closestThreat := d.FindClosestThreat(threateningBalls)
-
-	if closestThreat != nil {
-		// Priority: Intercept the closest threat
-		d.interceptBall(closestThreat, human)
-	} else {
-		// No immediate threats: Follow the human at preferred distance
-		d.followHuman(human)
+		// After the target number of spins the state machine goes back to protecting
 	}
 }
 
 // interceptBall moves dragon to intercept a threatening ball
 func (d *Dragon) interceptBall(ball *Ball, human *Human) {
-	// Calculate interception point
-	// Predict where the ball will be when dragon reaches it
-	dx := ball.X - d.X
-	dy := ball.Y - d.Y
+	// Fly to where the ball will be when the dragon gets there, cutting it off instead of chasing its tail
+	pointX, pointY := d.InterceptPoint(ball)
+	dx := pointX - d.X
+	dy := pointY - d.Y
 	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
 
 	if distance > 0 {
-		// Calculate angle to the ball for rotation alignment
-		d.TargetAngle = float32(math.Atan2(float64(dy), float64(dx)))
-		d.IsIntercepting = true
-		d.ReturnToHorizontal = false
+		d.turnToward(dx, dy)
 
-		// Smoothly rotate toward target angle
-		angleDiff := d.TargetAngle - d.InterceptAngle
-
-		// Normalize angle difference to [-pi, pi] range
-		for angleDiff > math.Pi {
-			angleDiff -= 2 * math.Pi
-		}
-		for angleDiff < -math.Pi {
-			angleDiff += 2 * math.Pi
-		}
-
-		// Smooth rotation toward target
-		rotationSpeed := float32(0.15) // Adjust for faster/slower rotation
-		d.InterceptAngle += angleDiff * rotationSpeed
-
-		// Simple interception: move toward ball's current position aggressively
 		normalizedDx := dx / distance
 		normalizedDy := dy / distance
 
-		// Move at controlled speed toward threat
-		d.VX = normalizedDx * d.Speed * 1.2 // 1.2x speed when intercepting (was 1.5x)
-		d.VY = normalizedDy * d.Speed * 1.2
+		// Move at intercept speed toward the meeting point
+		d.VX = normalizedDx * d.interceptSpeed()
+		d.VY = normalizedDy * d.interceptSpeed()
+		d.spendEnergy(dragonInterceptCost)
 	}
 }
 
-// followHuman makes dragon follow the human at preferred distance
-func (d *Dragon) followHuman(human *Human) {
-	// When following (not intercepting), prepare to return to horizontal
-	if d.IsIntercepting {
-		d.IsIntercepting = false
-		d.ReturnToHorizontal = true
+// turnToward smoothly tilts the dragon to face along (dx, dy)
+func (d *Dragon) turnToward(dx, dy float32) {
+	// Calculate angle to the target for rotation alignment
+	d.TargetAngle = float32(math.Atan2(float64(dy), float64(dx)))
+
+	// Smoothly rotate toward target angle
+	angleDiff := d.TargetAngle - d.InterceptAngle
+
+	// Normalize angle difference to [-pi, pi] range
+	for angleDiff > math.Pi {
+		angleDiff -= 2 * math.Pi
+	}
+	for angleDiff < -math.Pi {
+		angleDiff += 2 * math.Pi
 	}
 
-	// Smoothly return to horizontal orientation (0 radians)
-	if d.ReturnToHorizontal {
-		targetHorizontal := float32(0)
-		angleDiff := targetHorizontal - d.InterceptAngle
+	// Smooth rotation toward target
+	rotationSpeed := float32(0.15) // Adjust for faster/slower rotation
+	d.InterceptAngle += angleDiff * rotationSpeed
+}
 
-		// Normalize angle difference to [-pi, pi] range
-		for angleDiff > math.Pi {
-			angleDiff -= 2 * math.Pi
-		}
-		for angleDiff < -math.Pi {
-			angleDiff += 2 * math.Pi
-		}
+// flyToward heads for (x, y) at speed, stopping on the spot instead of overshooting it
+func (d *Dragon) flyToward(x, y, speed float32) {
+	dx := x - d.X
+	dy := y - d.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance > speed {
+		d.VX = dx / distance * speed
+		d.VY = dy / distance * speed
+	} else {
+		d.VX, d.VY = dx, dy
+	}
+}
 
-		// Return to horizontal with smooth rotation
-		returnSpeed := float32(0.1) // Slower return to horizontal
-		d.InterceptAngle += angleDiff * returnSpeed
+// interceptSpeed returns how fast the dragon flies when intercepting
+func (d *Dragon) interceptSpeed() float32 {
+	return d.Speed * Tuning().DragonInterceptScale
+}
 
-		// Stop returning when close enough to horizontal
-		if math.Abs(float64(angleDiff)) < 0.1 {
-			d.InterceptAngle = 0
-			d.ReturnToHorizontal = false
-		}
+// InterceptPoint returns where the dragon meets the ball flying at intercept speed, given the ball's
+// current velocity. Predictions further ahead than dragonMaxLead frames (the ball will likely have
+// bounced by then) and balls that can't be caught fall back to the ball's current position.
+func (d *Dragon) InterceptPoint(ball *Ball) (float32, float32) {
+	vx, vy := ball.effectiveVelocity()
+	t, ok := interceptTime(ball.X-d.X, ball.Y-d.Y, vx, vy, d.interceptSpeed())
+	if !ok || t > Tuning().DragonMaxLead {
+		return ball.X, ball.Y
 	}
+	return ball.X + vx*t, ball.Y + vy*t
+}
+
+// followHuman makes dragon follow the human at preferred distance
+func (d *Dragon) followHuman(human *Human) {
+	// Smoothly return to horizontal orientation (0 radians) after intercepting
+	d.levelOut(0.1) // Slower return to horizontal
 
 	// Calculate distance to human
 	dx := human.X - d.X
@@ -593,9 +642,10 @@ func (d *Dragon) followHuman(human *Human) {
 			d.VX = -normalizedDx * d.Speed * 0.3
 			d.VY = -normalizedDy * d.Speed * 0.3
 		} else {
-			// Good distance: Maintain position with slight drift
+			// Good distance: Maintain position with slight drift, catching its breath
 			d.VX *= 0.9
 			d.VY *= 0.9
+			d.rest()
 		}
 	}
 }
@@ -627,11 +677,27 @@ func (d *Dragon) keepWithinBounds() {
 	}
 }
 
+// dragonAvoidLookahead is how many frames ahead the dragon looks for blocks in its way
+const dragonAvoidLookahead = float32(30)
+
+// keepOutOfObstacles pushes the dragon out of any block it clipped, stopping its motion into the block
+func (d *Dragon) keepOutOfObstacles() {
+	var normalX, normalY float32
+	d.X, d.Y, normalX, normalY = PushOut(d.Obstacles, d.X, d.Y, d.Size*0.5)
+	if approach := d.VX*normalX + d.VY*normalY; approach < 0 {
+		d.VX -= approach * normalX
+		d.VY -= approach * normalY
+	}
+}
+
 // updateAnimations handles wing flapping and flame effects
 func (d *Dragon) updateAnimations() {
+	// Face the direction of travel
+	d.updateHeading()
+
 	// Update wing flap animation (faster when spinning)
 	flapSpeed := float32(0.3)
-	if d.IsSpinning {
+	if d.State == DragonRecover {
 		flapSpeed = 0.8 // Faster wing flapping during spin
 	}
 	d.WingFlap += flapSpeed
@@ -644,6 +710,57 @@ func (d *Dragon) updateAnimations() {
 	if d.FlameTimer > 60 {
 		d.FlameTimer = 0
 	}
+	d.emitExhaust()
+}
+
+// Exhaust flame colors: orange, hotter while spinning or intercepting, cooling to red as they fade
+var (
+	exhaustColors    = []color.RGBA{{R: 255, G: 100, B: 50, A: 200}, {R: 255, G: 130, B: 50, A: 200}}
+	exhaustHotColors = []color.RGBA{{R: 255, G: 150, B: 50, A: 200}, {R: 255, G: 180, B: 50, A: 200}}
+	exhaustEndColor  = color.RGBA{R: 255, G: 40, B: 20, A: 0}
+)
+
+// emitExhaust streams a flame back from the tail and forgets the flames that have burned out
+func (d *Dragon) emitExhaust() {
+	alight := d.flames[:0]
+	for _, flame := range d.flames {
+		if flame.Alive() {
+			alight = append(alight, flame)
+		}
+	}
+	d.flames = alight
+
+	// The tail trails behind whichever way the dragon faces, and swings round with a spin
+	facing := newOrientation(d.InterceptAngle)
+	spin := newRotation(d.SpinAngle)
+	spinning := d.State == DragonRecover
+	turn := func(offsetX, offsetY float32) (float32, float32) {
+		offsetX, offsetY = facing.apply(offsetX, offsetY)
+		if spinning {
+			offsetX, offsetY = spin.apply(offsetX, offsetY)
+		}
+		return offsetX, offsetY
+	}
+	wobble := float32(math.Sin(float64(d.FlameTimer)*0.1)) * 3
+	tailX, tailY := turn(-d.Size*0.85, wobble)
+	backX, backY := turn(-1, 0)
+
+	colors := exhaustColors
+	if spinning || d.State == DragonIntercept {
+		colors = exhaustHotColors // More intense flames during action
+	}
+	exhaust := effects.Emitter{
+		Count:       1,
+		Speed:       3,
+		SpeedJitter: 1,
+		Direction:   float32(math.Atan2(float64(backY), float64(backX))),
+		Spread:      0.15,
+		Life:        16,
+		Size:        d.Size * 0.15,
+		Colors:      colors,
+		EndColor:    exhaustEndColor,
+	}
+	d.flames = append(d.flames, d.Particles.Emit(&exhaust, d.X+tailX, d.Y+tailY)...)
 }
 
 // UpdatePosition updates the visual position of all dragon components
@@ -652,112 +769,84 @@ func (d *Dragon) UpdatePosition() {
 		return
 	}
 
+	// Smoke trails behind the dragon while it intercepts
+	d.updateSmoke()
+
 	// Wing flap effect
 	wingOffset := float32(math.Sin(float64(d.WingFlap))) * 5
 
-	// Spinning effect - rotate all components around dragon center
-	var spinCos, spinSin float32 = 1, 0
-	if d.IsSpinning {
-		spinCos = float32(math.Cos(float64(d.SpinAngle)))
-		spinSin = float32(math.Sin(float64(d.SpinAngle)))
-	}
+	// Face the way the dragon is heading (or turned toward its target): head leads, tail and flames trail
+	facing := newOrientation(d.InterceptAngle)
 
-	// Intercept rotation effect - rotate to align with target ball
-	var interceptCos, interceptSin float32 = 1, 0
-	if d.IsIntercepting || d.ReturnToHorizontal {
-		interceptCos = float32(math.Cos(float64(d.InterceptAngle)))
-		interceptSin = float32(math.Sin(float64(d.InterceptAngle)))
-	}
+	// Spinning effect - rotate all components around dragon center
+	spinning := d.State == DragonRecover
+	spin := newRotation(d.SpinAngle)
 
-	// Helper function to apply both spin and intercept rotation to a position offset
+	// Turn a layout offset (drawn facing right) into a screen offset
 	applyRotations := func(offsetX, offsetY float32) (float32, float32) {
-		// First apply intercept rotation
-		if d.IsIntercepting || d.ReturnToHorizontal {
-			rotatedX := offsetX*interceptCos - offsetY*interceptSin
-			rotatedY := offsetX*interceptSin + offsetY*interceptCos
-			offsetX, offsetY = rotatedX, rotatedY
-		}
-
-		// Then apply spin rotation (if spinning)
-		if d.IsSpinning {
-			rotatedX := offsetX*spinCos - offsetY*spinSin
-			rotatedY := offsetX*spinSin + offsetY*spinCos
-			return rotatedX, rotatedY
+		offsetX, offsetY = facing.apply(offsetX, offsetY)
+		if spinning {
+			offsetX, offsetY = spin.apply(offsetX, offsetY)
 		}
-
 		return offsetX, offsetY
 	}
 
-	// Head position (front center)
-	headOffsetX, headOffsetY := applyRotations(-d.Size*0.25, -d.Size*0.25)
-	d.Head.Move(fyne.NewPos(d.X+headOffsetX, d.Y+headOffsetY))
-
-	// Body position (center)
-	bodyOffsetX, bodyOffsetY := applyRotations(-d.Size*0.4, -d.Size*0.2)
-	d.Body.Move(fyne.NewPos(d.X+bodyOffsetX, d.Y+bodyOffsetY))
-
-	// Tail position (behind body)
-	tailOffsetX, tailOffsetY := applyRotations(-d.Size*0.8, -d.Size*0.1)
-	d.Tail.Move(fyne.NewPos(d.X+tailOffsetX, d.Y+tailOffsetY))
-
-	// Wings positions (animated flapping + rotations)
-	leftWingOffsetX, leftWingOffsetY := applyRotations(-d.Size*0.6, -d.Size*0.5+wingOffset)
-	d.LeftWing.Move(fyne.NewPos(d.X+leftWingOffsetX, d.Y+leftWingOffsetY))
-
-	rightWingOffsetX, rightWingOffsetY := applyRotations(d.Size*0.2, -d.Size*0.5-wingOffset)
-	d.RightWing.Move(fyne.NewPos(d.X+rightWingOffsetX, d.Y+rightWingOffsetY))
+	// Place a component by its center in the layout
+	placeCentered := func(object fyne.CanvasObject, centerX, centerY float32) {
+		x, y := applyRotations(centerX, centerY)
+		size := object.Size()
+		object.Move(fyne.NewPos(d.X+x-size.Width/2, d.Y+y-size.Height/2))
+	}
 
-	// Eyes positions (on head)
-	leftEyeOffsetX, leftEyeOffsetY := applyRotations(-d.Size*0.15, -d.Size*0.3)
-	d.LeftEye.Move(fyne.NewPos(d.X+leftEyeOffsetX, d.Y+leftEyeOffsetY))
+	// Body in the middle, head leading and tail trailing
+	placeCentered(d.Body, 0, 0)
+	placeCentered(d.Head, d.Size*0.4, -d.Size*0.1)
+	placeCentered(d.Tail, -d.Size*0.55, d.Size*0.02)
 
-	rightEyeOffsetX, rightEyeOffsetY := applyRotations(-d.Size*0.05, -d.Size*0.3)
-	d.RightEye.Move(fyne.NewPos(d.X+rightEyeOffsetX, d.Y+rightEyeOffsetY))
+	// Wings above the body (animated flapping)
+	placeCentered(d.LeftWing, -d.Size*0.15, -d.Size*0.3+wingOffset)
+	placeCentered(d.RightWing, d.Size*0.15, -d.Size*0.3-wingOffset)
 
-	// Update flame particles (breath effect + rotations)
-	for i, flame := range d.FlameParticles {
-		if flame != nil {
-			// Base flame position
-			baseFlameX := -d.Size*0.5 - float32(i)*8
-			baseFlameY := float32(math.Sin(float64(d.FlameTimer)*0.1+float64(i)*0.5)) * 3
+	// Eyes toward the front of the head
+	placeCentered(d.LeftEye, d.Size*0.48, -d.Size*0.2)
+	placeCentered(d.RightEye, d.Size*0.6, -d.Size*0.17)
 
-			// Apply rotations to flame position
-			flameOffsetX, flameOffsetY := applyRotations(baseFlameX, baseFlameY)
+	// The dragon is flying, so its shadow falls well below it and bobs with the wing beats
+	flyingHeight := 30 + wingOffset
+	d.Shadow.Update(d.X, d.Y+d.Size*0.5, d.Size*1.6, flyingHeight)
 
-			flameSize := d.Size * 0.15 * (1.0 - float32(i)*0.1)
-			flame.Move(fyne.NewPos(d.X+flameOffsetX-flameSize/2, d.Y+flameOffsetY-flameSize/2))
+	// Horns and spikes turn with the rest of the dragon
+	d.positionTierDetails(applyRotations)
+}
 
-			// Animate flame color (more intense during spinning or intercepting)
-			alpha := uint8(200 - i*20)
-			red := uint8(255)
-			green := uint8(100 + i*10)
-			if d.IsSpinning || d.IsIntercepting {
-				green = uint8(150 + i*10) // More intense flames during action
-			}
-			flame.FillColor = color.RGBA{R: red, G: green, B: 50, A: alpha}
-			flame.Refresh()
-		}
-	}
+// resizeParts sizes every body part for the dragon's current size
+func (d *Dragon) resizeParts() {
+	d.Head.Resize(fyne.NewSize(d.Size*0.5, d.Size*0.5))
+	d.Body.Resize(fyne.NewSize(d.Size*0.8, d.Size*0.4))
+	d.Tail.Resize(fyne.NewSize(d.Size*0.6, d.Size*0.2))
+	d.LeftWing.Resize(fyne.NewSize(d.Size*0.4, d.Size*0.6))
+	d.RightWing.Resize(fyne.NewSize(d.Size*0.4, d.Size*0.6))
+	d.LeftEye.Resize(fyne.NewSize(d.Size*0.1, d.Size*0.1))
+	d.RightEye.Resize(fyne.NewSize(d.Size*0.1, d.Size*0.1))
 }
 
 // GetVisualComponents returns all visual components for adding to container
 func (d *Dragon) GetVisualComponents() []fyne.CanvasObject {
-	components := []fyne.CanvasObject{
-		d.Tail,     // Draw tail first (behind)
+	components := d.Smoke.GetVisualComponents() // Smoke trails behind everything
+	components = append(components, d.Tail)      // Then the tail (behind the body)
+	for _, spike := range d.Spikes {
+		components = append(components, spike) // Spikes stick out from behind the tail and body
+	}
+	components = append(components,
 		d.LeftWing, // Wings behind body
 		d.RightWing,
-		d.Body,    // Body in middle
+		d.Body,     // Body in middle
+		d.Horns[0], // Horns behind the head
+		d.Horns[1],
 		d.Head,    // Head on top
 		d.LeftEye, // Eyes on top of head
 		d.RightEye,
-	}
-
-	// Add flame particles
-	for _, flame := range d.FlameParticles {
-		if flame != nil {
-			components = append(components, flame)
-		}
-	}
+	)
 
 	return components
 }
@@ -771,10 +860,13 @@ func (d *Dragon) Hide() {
 	d.RightWing.Hide()
 	d.LeftEye.Hide()
 	d.RightEye.Hide()
-	for _, flame := range d.FlameParticles {
-		if flame != nil {
-			flame.Hide()
-		}
+	d.Shadow.Hide()
+	d.Smoke.Clear()
+	for _, horn := range d.Horns {
+		horn.Hide()
+	}
+	for _, spike := range d.Spikes {
+		spike.Hide()
 	}
 }
 
@@ -787,9 +879,6 @@ func (d *Dragon) Show() {
 	d.RightWing.Show()
 	d.LeftEye.Show()
 	d.RightEye.Show()
-	for _, flame := range d.FlameParticles {
-		if flame != nil {
-			flame.Show()
-		}
-	}
+	d.Shadow.Show()
+	d.showTierDetails()
 }
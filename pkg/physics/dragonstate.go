@@ -0,0 +1,245 @@
+package physics
+
+import "math"
+
+// DragonState is one behavior of the dragon's state machine
+type DragonState int
+
+// Dragon states
+const (
+	DragonFollow    DragonState = iota // escorts the human at its follow distance
+	DragonIntercept                    // flies out to cut off a ball threatening the human
+	DragonDrift                        // coasts after deflecting a ball
+	DragonRecover                      // spins back into shape before protecting again
+	DragonPatrol                       // circles where the human was while the human is dead
+	DragonRest                         // too tired to deflect; retreats behind the human to recover
+	DragonRidden                       // flies wherever its rider steers it
+	DragonFight                        // rams a rival dragon that came too close to the human
+	DragonHerd                         // (rival only) shoves balls toward the human
+	DragonRetreat                      // flees after losing a duel, until it has regrouped
+	DragonCarry                        // holds a small ball in its claws, waiting to throw it at a threat
+	DragonBlock                        // flies into the path of an alien plasma orb headed for the human
+)
+
+// Dragon patrol and heading tuning
+const (
+	dragonPatrolSpeed     = float32(0.5)  // fraction of its speed the dragon patrols at
+	dragonPatrolTurn      = float32(0.02) // radians per frame the patrol circles
+	dragonHeadingMinSpeed = float32(0.3)  // slowest the dragon can move and still turn to face its travel
+	dragonHeadingTurn     = float32(0.1)  // fraction of the way the heading turns toward the travel each frame
+)
+
+// String returns the state's display name
+func (s DragonState) String() string {
+	switch s {
+	case DragonIntercept:
+		return "Intercept"
+	case DragonDrift:
+		return "Drift"
+	case DragonRecover:
+		return "Recover"
+	case DragonPatrol:
+		return "Patrol"
+	case DragonRest:
+		return "Rest"
+	case DragonRidden:
+		return "Ridden"
+	case DragonFight:
+		return "Fight"
+	case DragonHerd:
+		return "Herd"
+	case DragonRetreat:
+		return "Retreat"
+	case DragonCarry:
+		return "Carry"
+	case DragonBlock:
+		return "Block"
+	default:
+		return "Follow"
+	}
+}
+
+// SetState switches the dragon to another state, running the old state's exit hook and the new state's enter hook.
+// Switching to the current state does nothing.
+func (d *Dragon) SetState(state DragonState) {
+	if state == d.State {
+		return
+	}
+	d.exitState(d.State)
+	d.State = state
+	d.StateFrames = 0
+	d.enterState(state)
+}
+
+// enterState sets up a state the dragon is switching into
+func (d *Dragon) enterState(state DragonState) {
+	switch state {
+	case DragonIntercept, DragonFight, DragonHerd, DragonBlock:
+		d.ReturnToHorizontal = false
+	case DragonDrift:
+		d.DriftTimer = d.DriftDuration / 2 // Shorter drift for responsiveness
+		d.ReturnToHorizontal = true
+	case DragonRecover:
+		d.SpinAngle = 0
+		d.SpinCount = 0
+	case DragonPatrol:
+		// Pick up the circle from wherever the dragon is around the human's last position
+		d.patrolAngle = float32(math.Atan2(float64(d.Y-d.LastHumanY), float64(d.X-d.LastHumanX)))
+	case DragonRidden:
+		d.ReturnToHorizontal = false
+		d.rideX, d.rideY = 0, 0
+	}
+}
+
+// exitState cleans up a state the dragon is switching out of
+func (d *Dragon) exitState(state DragonState) {
+	switch state {
+	case DragonIntercept, DragonRidden, DragonFight, DragonHerd, DragonBlock:
+		d.ReturnToHorizontal = true // Level out again
+	case DragonDrift:
+		d.VX = 0
+		d.VY = 0
+	case DragonRecover:
+		d.SpinAngle = 0
+		d.SpinCount = 0
+	case DragonCarry:
+		d.DropBall() // A rider climbing on makes the dragon let go
+	}
+}
+
+// nextState picks the state for this frame. Drifting and recovering play out in full;
+// otherwise riders, carried balls, lost duels, tiredness, the human's death, rival dragons, alien plasma orbs
+// and threats decide.
+// A rival dragon herds balls instead of protecting the human.
+func (d *Dragon) nextState(balls []*Ball, human *Human) DragonState {
+	switch {
+	case d.Rider != nil:
+		return DragonRidden
+	case d.Carried != nil:
+		return DragonCarry
+	case d.RetreatTimer > 0:
+		return DragonRetreat
+	case d.State == DragonDrift && d.DriftTimer > 0:
+		return DragonDrift
+	case d.State == DragonDrift:
+		return DragonRecover
+	case d.State == DragonRecover && d.SpinCount < d.SpinTarget:
+		return DragonRecover
+	case d.exhausted:
+		return DragonRest
+	case human == nil || !human.IsActive:
+		return DragonPatrol
+	case d.Rival:
+		return DragonHerd
+	case d.foeInRange(human):
+		return DragonFight
+	case d.orbThreat(human) != nil:
+		return DragonBlock
+	}
+
+	if d.scanThreats(balls, human) != nil {
+		return DragonIntercept
+	}
+	return DragonFollow
+}
+
+// updateState runs the current state's behavior for one frame
+func (d *Dragon) updateState(balls []*Ball, human *Human) {
+	d.StateFrames++
+
+	switch d.State {
+	case DragonIntercept:
+		d.interceptBall(d.cachedThreat, human)
+	case DragonDrift:
+		d.updateDrifting()
+	case DragonRecover:
+		d.updateSpinning()
+	case DragonPatrol:
+		d.updatePatrolling()
+	case DragonRest:
+		d.updateRetreating(balls, human)
+	case DragonRidden:
+		d.updateRidden()
+	case DragonFight:
+		d.updateFighting()
+	case DragonHerd:
+		d.updateHerding(balls, human)
+	case DragonRetreat:
+		d.updateRetreat()
+	case DragonCarry:
+		d.updateCarrying(balls, human)
+	case DragonBlock:
+		d.updateBlocking(human)
+	default:
+		d.followHuman(human)
+	}
+}
+
+// scanThreats finds the closest ball threatening the human (rescanned on AI ticks, cached in between)
+func (d *Dragon) scanThreats(balls []*Ball, human *Human) *Ball {
+	if d.AI.Due(AITaskDragonThreats) {
+		threateningBalls := d.FindThreateningBalls(balls, human)
+		d.cachedThreat = d.FindClosestThreat(threateningBalls)
+	} else if d.cachedThreat != nil && (!d.cachedThreat.IsAnimated || d.cachedThreat.IsDestroyed || d.cachedThreat.IsAbsorbed) {
+		d.cachedThreat = nil // Cached threat is gone
+	}
+	return d.cachedThreat
+}
+
+// updatePatrolling circles slowly around the spot where the human was last seen
+func (d *Dragon) updatePatrolling() {
+	d.levelOut(0.1)
+
+	d.patrolAngle += dragonPatrolTurn
+	targetX := d.LastHumanX + float32(math.Cos(float64(d.patrolAngle)))*d.FollowDistance
+	targetY := d.LastHumanY + float32(math.Sin(float64(d.patrolAngle)))*d.FollowDistance
+
+	dx := targetX - d.X
+	dy := targetY - d.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	speed := d.Speed * dragonPatrolSpeed
+	if distance > speed {
+		d.VX = dx / distance * speed
+		d.VY = dy / distance * speed
+	} else {
+		d.VX, d.VY = dx, dy
+	}
+}
+
+// levelOut eases the dragon's tilt back to its heading once it no longer faces a target
+func (d *Dragon) levelOut(returnSpeed float32) {
+	if !d.ReturnToHorizontal {
+		return
+	}
+
+	// Shortest way round to the heading
+	angleDiff := float32(math.Remainder(float64(d.Heading-d.InterceptAngle), 2*math.Pi))
+	d.InterceptAngle += angleDiff * returnSpeed
+
+	// Stop returning when close enough to the heading
+	if math.Abs(float64(angleDiff)) < 0.1 {
+		d.InterceptAngle = d.Heading
+		d.ReturnToHorizontal = false
+	}
+}
+
+// updateHeading turns the dragon to face its direction of travel. Unless it is turned toward a target,
+// the dragon is drawn facing its heading.
+func (d *Dragon) updateHeading() {
+	if d.VX*d.VX+d.VY*d.VY > dragonHeadingMinSpeed*dragonHeadingMinSpeed {
+		travel := float32(math.Atan2(float64(d.VY), float64(d.VX)))
+		d.Heading += float32(math.Remainder(float64(travel-d.Heading), 2*math.Pi)) * dragonHeadingTurn
+	}
+	if !d.isTilted() {
+		d.InterceptAngle = d.Heading
+	}
+}
+
+// isTilted reports whether the dragon is turned toward a target or still easing back to its heading
+func (d *Dragon) isTilted() bool {
+	switch d.State {
+	case DragonIntercept, DragonRidden, DragonFight, DragonHerd, DragonBlock:
+		return true
+	}
+	return d.ReturnToHorizontal
+}
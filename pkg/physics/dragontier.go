@@ -0,0 +1,191 @@
+package physics
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// DragonTier is how far the dragon has grown
+type DragonTier int
+
+// Dragon tiers
+const (
+	DragonHatchling DragonTier = iota // newly hatched: the starting size
+	DragonAdult                       // bigger, heavier and guards a wider area; grows horns
+	DragonElder                       // biggest of all; grows spikes down its back
+)
+
+// Dragon growth tuning
+const (
+	adultDeflections = 10 // deflections a hatchling needs to grow into an adult
+	elderDeflections = 30 // total deflections needed to grow into an elder
+	dragonSpikeCount = 4  // spikes down an elder's back
+)
+
+// dragonHornColor is the bone color of horns and spikes
+var dragonHornColor = color.RGBA{R: 235, G: 225, B: 190, A: 255}
+
+// String returns the tier's display name
+func (t DragonTier) String() string {
+	switch t {
+	case DragonAdult:
+		return "Adult"
+	case DragonElder:
+		return "Elder"
+	default:
+		return "Hatchling"
+	}
+}
+
+// sizeScale returns the dragon's size as a multiple of its hatchling size
+func (t DragonTier) sizeScale() float32 {
+	switch t {
+	case DragonAdult:
+		return 1.25
+	case DragonElder:
+		return 1.5
+	default:
+		return 1
+	}
+}
+
+// massScale returns the dragon's mass as a multiple of the largest ball's mass
+func (t DragonTier) massScale() float32 {
+	switch t {
+	case DragonAdult:
+		return 2.5
+	case DragonElder:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// protectScale returns the dragon's protect radius as a multiple of the tuned radius
+func (t DragonTier) protectScale() float32 {
+	switch t {
+	case DragonAdult:
+		return 1.2
+	case DragonElder:
+		return 1.4
+	default:
+		return 1
+	}
+}
+
+// DragonProgression tracks the dragon's deflections and the tier they have earned it
+type DragonProgression struct {
+	Deflections int        // balls deflected since the last reset
+	Tier        DragonTier // current tier, starting as a hatchling
+}
+
+// NewDragonProgression creates a freshly hatched dragon's progression
+func NewDragonProgression() *DragonProgression {
+	return &DragonProgression{Tier: DragonHatchling}
+}
+
+// DeflectionsForNextTier returns the total deflections needed for the next tier, or -1 for an elder
+func (p *DragonProgression) DeflectionsForNextTier() int {
+	switch p.Tier {
+	case DragonHatchling:
+		return adultDeflections
+	case DragonAdult:
+		return elderDeflections
+	default:
+		return -1
+	}
+}
+
+// AddDeflection counts a deflection, growing a tier when it earns one.
+// It returns true if the dragon grew.
+func (p *DragonProgression) AddDeflection() bool {
+	p.Deflections++
+
+	next := p.DeflectionsForNextTier()
+	if next < 0 || p.Deflections < next {
+		return false
+	}
+	p.Tier++
+	return true
+}
+
+// countDeflection counts a deflection toward the next tier and grows the dragon when it earns one
+func (d *Dragon) countDeflection() {
+	if !d.Progression.AddDeflection() {
+		return
+	}
+	d.applyTier()
+	d.Events.Publish(Event{Type: EventDragonGrew, X: d.X, Y: d.Y, Value: int(d.Progression.Tier)})
+}
+
+// ResetProgression shrinks the dragon back to a hatchling
+func (d *Dragon) ResetProgression() {
+	d.Progression = NewDragonProgression()
+	d.applyTier()
+}
+
+// applyTier sizes the dragon, its protect radius and its horns and spikes for its tier
+func (d *Dragon) applyTier() {
+	tier := d.Progression.Tier
+	d.Size = d.baseSize * tier.sizeScale()
+	d.ProtectRadius = d.baseProtectRadius * tier.protectScale() * CurrentDifficulty.Config().DragonPower
+	d.resizeParts()
+	d.showTierDetails()
+	d.UpdatePosition()
+}
+
+// createTierDetails builds the horns and spikes (hidden until the dragon grows into them)
+func (d *Dragon) createTierDetails() {
+	for i := range d.Horns {
+		d.Horns[i] = &canvas.Line{StrokeColor: dragonHornColor, StrokeWidth: 3}
+		d.Horns[i].Hide()
+	}
+	d.Spikes = make([]*canvas.Line, dragonSpikeCount)
+	for i := range d.Spikes {
+		d.Spikes[i] = &canvas.Line{StrokeColor: dragonHornColor, StrokeWidth: 2}
+		d.Spikes[i].Hide()
+	}
+}
+
+// showTierDetails shows the horns from adult up and the spikes on an elder
+func (d *Dragon) showTierDetails() {
+	tier := d.Progression.Tier
+	for _, horn := range d.Horns {
+		setVisible(horn, d.IsActive && tier >= DragonAdult)
+	}
+	for _, spike := range d.Spikes {
+		setVisible(spike, d.IsActive && tier >= DragonElder)
+	}
+}
+
+// positionTierDetails moves the horns and spikes with the dragon, applying the same rotations as its body
+func (d *Dragon) positionTierDetails(rotate func(offsetX, offsetY float32) (float32, float32)) {
+	placeLine := func(line *canvas.Line, x1, y1, x2, y2 float32) {
+		x1, y1 = rotate(x1, y1)
+		x2, y2 = rotate(x2, y2)
+		line.Position1 = fyne.NewPos(d.X+x1, d.Y+y1)
+		line.Position2 = fyne.NewPos(d.X+x2, d.Y+y2)
+		line.Refresh()
+	}
+
+	// Horns sweep up and back from the top of the head
+	placeLine(d.Horns[0], d.Size*0.3, -d.Size*0.3, d.Size*0.18, -d.Size*0.5)
+	placeLine(d.Horns[1], d.Size*0.45, -d.Size*0.33, d.Size*0.36, -d.Size*0.55)
+
+	// Spikes run down the tail and back
+	for i, spike := range d.Spikes {
+		x := -d.Size*0.75 + float32(i)*d.Size*0.13
+		placeLine(spike, x, -d.Size*0.1, x-d.Size*0.05, -d.Size*0.22)
+	}
+}
+
+// setVisible shows or hides a canvas object
+func setVisible(object fyne.CanvasObject, visible bool) {
+	if visible {
+		object.Show()
+	} else {
+		object.Hide()
+	}
+}
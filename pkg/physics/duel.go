@@ -0,0 +1,262 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+)
+
+// Duel tuning
+const (
+	duelClashShift      = float32(0.25)  // most dominance one clash can swing toward the harder hitter
+	duelClashCooldown   = 20             // frames after a clash before the dragons can clash again
+	duelDominanceDecay  = float32(0.001) // dominance drifts back toward even by this much per frame
+	duelRetreatFrames   = 300            // frames a beaten dragon spends retreating (5 seconds)
+	duelRetreatSpeed    = float32(1.2)   // fraction of its speed a beaten dragon flees at
+	herdPushSpeed       = float32(4)     // speed the rival shoves a ball toward the human at
+	herdPushCooldown    = 30             // frames after a shove before the rival can shove again
+	herdApproachSpacing = float32(0.5)   // gap kept behind a ball before shoving it, relative to the rival's size
+	herdLinedUp         = float32(0.8)   // how squarely behind a ball (cosine of the angle) the rival must be to charge it
+)
+
+// Rival dragon colors
+var (
+	rivalBodyColor = color.RGBA{R: 170, G: 20, B: 30, A: 255} // Crimson
+	rivalWingColor = color.RGBA{R: 60, G: 10, B: 15, A: 255}  // Near-black red wings
+	rivalEyeColor  = color.RGBA{R: 80, G: 255, B: 80, A: 255} // Poison green eyes
+)
+
+// NewRivalDragon creates an enemy dragon that herds balls toward the human instead of deflecting them
+func NewRivalDragon(x, y, size float32) *Dragon {
+	d := NewDragon(x, y, size)
+	d.Rival = true
+
+	d.Head.FillColor = rivalBodyColor
+	d.Body.FillColor = rivalBodyColor
+	d.Tail.FillColor = rivalBodyColor
+	d.LeftWing.FillColor = rivalWingColor
+	d.RightWing.FillColor = rivalWingColor
+	d.LeftEye.FillColor = rivalEyeColor
+	d.RightEye.FillColor = rivalEyeColor
+	return d
+}
+
+// DragonDuel pits the friendly dragon against a rival. The two clash with mass-based collisions, and each
+// clash swings the dominance meter toward whichever hit harder; a dragon pushed to the end of the meter
+// retreats for a while.
+type DragonDuel struct {
+	Friend     *Dragon   // the human's protector
+	Rival      *Dragon   // the enemy herding balls toward the human
+	Dominance  float32   // -1 (rival dominates) to +1 (friend dominates)
+	Events     *EventBus // receives retreat events (may be nil)
+	clashTimer int       // frames until the dragons can clash again
+}
+
+// NewDragonDuel sets the two dragons against each other
+func NewDragonDuel(friend, rival *Dragon, events *EventBus) *DragonDuel {
+	friend.Foe = rival
+	rival.Foe = friend
+	return &DragonDuel{Friend: friend, Rival: rival, Events: events}
+}
+
+// End stops the duel, leaving both dragons without a foe
+func (duel *DragonDuel) End() {
+	duel.Friend.Foe = nil
+	duel.Rival.Foe = nil
+	duel.Friend.RetreatTimer = 0
+	duel.Rival.RetreatTimer = 0
+}
+
+// Reset evens out the dominance meter and calls off any retreat
+func (duel *DragonDuel) Reset() {
+	duel.Dominance = 0
+	duel.clashTimer = 0
+	duel.Friend.RetreatTimer = 0
+	duel.Rival.RetreatTimer = 0
+}
+
+// Update resolves clashes between the dragons and sends a dragon that has lost the meter into retreat
+func (duel *DragonDuel) Update() {
+	if duel.clashTimer > 0 {
+		duel.clashTimer--
+	} else if duel.clash() {
+		duel.clashTimer = duelClashCooldown
+	}
+
+	// With no clashes, the meter slowly evens out
+	switch {
+	case duel.Dominance > duelDominanceDecay:
+		duel.Dominance -= duelDominanceDecay
+	case duel.Dominance < -duelDominanceDecay:
+		duel.Dominance += duelDominanceDecay
+	default:
+		duel.Dominance = 0
+	}
+
+	switch {
+	case duel.Dominance >= 1:
+		duel.sendIntoRetreat(duel.Rival, EventRivalRetreated)
+	case duel.Dominance <= -1:
+		duel.sendIntoRetreat(duel.Friend, EventDragonRetreated)
+	}
+}
+
+// sendIntoRetreat sends the beaten dragon off to recover and evens out the meter for the next round
+func (duel *DragonDuel) sendIntoRetreat(loser *Dragon, event EventType) {
+	loser.RetreatTimer = duelRetreatFrames
+	duel.Dominance = 0
+	duel.Events.Publish(Event{Type: event, X: loser.X, Y: loser.Y})
+}
+
+// clash bounces the dragons off each other if they touch, using their masses, and swings the meter
+// toward the one that hit harder. It returns true if they clashed.
+func (duel *DragonDuel) clash() bool {
+	friend, rival := duel.Friend, duel.Rival
+	if !friend.IsActive || !rival.IsActive || friend.RetreatTimer > 0 || rival.RetreatTimer > 0 {
+		return false
+	}
+
+	dx := rival.X - friend.X
+	dy := rival.Y - friend.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	reach := (friend.Size + rival.Size) * 0.4
+	if distance >= reach || distance == 0 {
+		return false
+	}
+	nx := dx / distance
+	ny := dy / distance
+
+	// Only clash while closing in on each other
+	closing := (friend.VX-rival.VX)*nx + (friend.VY-rival.VY)*ny
+	if closing <= 0 {
+		return false
+	}
+
+	// How hard each dragon hit: its momentum toward the other
+	friendHit := friend.Mass * float32(math.Max(0, float64(friend.VX*nx+friend.VY*ny)))
+	rivalHit := rival.Mass * float32(math.Max(0, float64(-(rival.VX*nx+rival.VY*ny))))
+	if total := friendHit + rivalHit; total > 0 {
+		duel.Dominance += (friendHit - rivalHit) / total * duelClashShift
+	}
+
+	// Elastic collision: the heavier dragon is knocked back less
+	impulse := 2 * closing / (1/friend.Mass + 1/rival.Mass)
+	friend.VX -= impulse / friend.Mass * nx
+	friend.VY -= impulse / friend.Mass * ny
+	rival.VX += impulse / rival.Mass * nx
+	rival.VY += impulse / rival.Mass * ny
+
+	// Push them apart so they don't stay overlapped
+	overlap := (reach - distance) / 2
+	friend.X -= nx * overlap
+	friend.Y -= ny * overlap
+	rival.X += nx * overlap
+	rival.Y += ny * overlap
+	return true
+}
+
+// foeInRange reports whether the friendly dragon should fight its foe: the foe is in the duel and
+// close enough to the human to be worth chasing
+func (d *Dragon) foeInRange(human *Human) bool {
+	foe := d.Foe
+	if d.Rival || foe == nil || !foe.IsActive || foe.RetreatTimer > 0 {
+		return false
+	}
+	dx := foe.X - human.X
+	dy := foe.Y - human.Y
+	radius := d.chaseRadius()
+	return dx*dx+dy*dy <= radius*radius
+}
+
+// updateFighting rams the foe at intercept speed
+func (d *Dragon) updateFighting() {
+	dx := d.Foe.X - d.X
+	dy := d.Foe.Y - d.Y
+	d.turnToward(dx, dy)
+	d.flyToward(d.Foe.X, d.Foe.Y, d.interceptSpeed())
+	d.spendEnergy(dragonInterceptCost)
+}
+
+// updateHerding gets behind the nearest ball (on the far side from the human) and shoves it at the human
+func (d *Dragon) updateHerding(balls []*Ball, human *Human) {
+	if d.AI.Due(AITaskRivalHerding) || d.herdTarget == nil || !d.herdTarget.IsAnimated ||
+		d.herdTarget.IsDestroyed || d.herdTarget.IsAbsorbed {
+		d.herdTarget = d.nearestBall(balls)
+	}
+	ball := d.herdTarget
+	if ball == nil {
+		d.followHuman(human)
+		return
+	}
+
+	// Line up behind the ball on the far side from the human
+	awayX := ball.X - human.X
+	awayY := ball.Y - human.Y
+	awayDistance := float32(math.Sqrt(float64(awayX*awayX + awayY*awayY)))
+	if awayDistance == 0 {
+		return
+	}
+	awayX /= awayDistance
+	awayY /= awayDistance
+	d.turnToward(ball.X-d.X, ball.Y-d.Y)
+	if d.isBehind(ball, awayX, awayY) {
+		d.flyToward(ball.X, ball.Y, d.interceptSpeed()) // Lined up: charge into it
+	} else {
+		gap := ball.Radius + d.Size*herdApproachSpacing
+		d.flyToward(ball.X+awayX*gap, ball.Y+awayY*gap, d.interceptSpeed())
+	}
+
+	// Shove the ball at the human when touching it
+	if d.deflectTimer > 0 {
+		return
+	}
+	dx := ball.X - d.X
+	dy := ball.Y - d.Y
+	touch := d.Size*0.4 + ball.Radius
+	if dx*dx+dy*dy < touch*touch {
+		ball.VX = -awayX * herdPushSpeed
+		ball.VY = -awayY * herdPushSpeed
+		ball.triggerJiggle(0.5)
+		d.deflectTimer = herdPushCooldown
+	}
+}
+
+// isBehind reports whether the dragon is on the far side of the ball along (awayX, awayY), lined up to shove it back the other way
+func (d *Dragon) isBehind(ball *Ball, awayX, awayY float32) bool {
+	dx := d.X - ball.X
+	dy := d.Y - ball.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	return distance > 0 && (dx*awayX+dy*awayY)/distance >= herdLinedUp
+}
+
+// nearestBall returns the closest live ball to the dragon, or nil
+func (d *Dragon) nearestBall(balls []*Ball) *Ball {
+	var nearest *Ball
+	nearestDistance := float32(math.MaxFloat32)
+	for _, ball := range balls {
+		if !ball.IsAnimated || ball.IsDestroyed || ball.IsAbsorbed {
+			continue
+		}
+		dx := ball.X - d.X
+		dy := ball.Y - d.Y
+		if distance := dx*dx + dy*dy; distance < nearestDistance {
+			nearestDistance = distance
+			nearest = ball
+		}
+	}
+	return nearest
+}
+
+// updateRetreat flees to the corner farthest from the foe until the retreat is over
+func (d *Dragon) updateRetreat() {
+	d.levelOut(0.1)
+	d.RetreatTimer--
+
+	targetX, targetY := float32(0), float32(0)
+	if d.Foe != nil && d.Foe.X < d.Bounds.Width/2 {
+		targetX = d.Bounds.Width
+	}
+	if d.Foe != nil && d.Foe.Y < d.Bounds.Height/2 {
+		targetY = d.Bounds.Height
+	}
+	d.flyToward(targetX, targetY, d.Speed*duelRetreatSpeed)
+}
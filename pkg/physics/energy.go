@@ -0,0 +1,102 @@
+package physics
+
+import "math"
+
+// Dragon energy tuning
+const (
+	dragonMaxEnergy     = float32(100)
+	dragonInterceptCost = float32(0.25) // energy per frame spent chasing down a threat
+	dragonDeflectCost   = float32(12)   // energy per ball deflected
+	dragonSpinCost      = float32(0.3)  // energy per frame spent spinning back into position
+	dragonRestRegen     = float32(0.5)  // energy regained per frame while idling near the human
+	dragonRecoverEnergy = float32(40)   // energy an exhausted dragon needs before protecting again
+	dragonRestRange     = float32(40)   // how far past its follow distance the dragon still counts as near the human
+	dragonRetreatSpeed  = float32(0.6)  // fraction of its speed an exhausted dragon retreats at
+)
+
+// EnergyFraction returns the dragon's energy from 0 (exhausted) to 1 (fully rested)
+func (d *Dragon) EnergyFraction() float32 {
+	if d.MaxEnergy <= 0 {
+		return 0
+	}
+	return d.Energy / d.MaxEnergy
+}
+
+// IsExhausted reports whether the dragon has run out of energy and is waiting to recover
+func (d *Dragon) IsExhausted() bool {
+	return d.exhausted
+}
+
+// RestoreEnergy fills the dragon's energy back up
+func (d *Dragon) RestoreEnergy() {
+	d.Energy = d.MaxEnergy
+	d.exhausted = false
+}
+
+// spendEnergy uses energy, leaving the dragon exhausted once it runs out
+func (d *Dragon) spendEnergy(amount float32) {
+	d.Energy -= amount
+	if d.Energy <= 0 {
+		d.Energy = 0
+		d.exhausted = true
+	}
+}
+
+// rest regains energy; an exhausted dragon gets back to work once it has recovered a little
+func (d *Dragon) rest() {
+	d.Energy += dragonRestRegen
+	if d.Energy > d.MaxEnergy {
+		d.Energy = d.MaxEnergy
+	}
+	if d.exhausted && d.Energy >= dragonRecoverEnergy {
+		d.exhausted = false
+	}
+}
+
+// updateRetreating pulls an exhausted dragon back behind the human, on the side away from the closest ball,
+// where it rests without deflecting anything
+func (d *Dragon) updateRetreating(balls []*Ball, human *Human) {
+	d.levelOut(0.1)
+
+	if human == nil || !human.IsActive {
+		d.VX *= 0.9
+		d.VY *= 0.9
+		d.rest()
+		return
+	}
+
+	// Shelter on the far side of the human from the closest ball
+	targetX, targetY := human.X, human.Y
+	if threat := human.findClosestBall(balls); threat != nil {
+		awayX := human.X - threat.X
+		awayY := human.Y - threat.Y
+		if length := float32(math.Sqrt(float64(awayX*awayX + awayY*awayY))); length > 0 {
+			targetX += awayX / length * d.FollowDistance
+			targetY += awayY / length * d.FollowDistance
+		}
+	}
+
+	dx := targetX - d.X
+	dy := targetY - d.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	speed := d.Speed * dragonRetreatSpeed
+	if distance > speed {
+		d.VX = dx / distance * speed
+		d.VY = dy / distance * speed
+	} else {
+		d.VX, d.VY = 0, 0
+	}
+
+	// Catch its breath once it is back near the human
+	if d.isNearHuman(human) {
+		d.rest()
+	}
+}
+
+// isNearHuman reports whether the dragon is close enough to the human to rest
+func (d *Dragon) isNearHuman(human *Human) bool {
+	dx := human.X - d.X
+	dy := human.Y - d.Y
+	near := d.FollowDistance + dragonRestRange
+	return dx*dx+dy*dy <= near*near
+}
@@ -0,0 +1,51 @@
+package physics
+
+// EventType identifies a kind of game event
+type EventType int
+
+// Game events
+const (
+	EventWaveStarted     EventType = iota // a new wave began (Value = wave number)
+	EventWaveCleared                      // every ball in a wave was destroyed (Value = wave number)
+	EventDragonGrew                       // the dragon grew a tier (Value = its new DragonTier)
+	EventRivalRetreated                   // the friendly dragon won the dominance meter and the rival retreats
+	EventDragonRetreated                  // the rival won the dominance meter and the friendly dragon retreats
+	EventExplosion                        // a ball or a human exploded
+	EventLightning                        // the laser sweep's electric charge or a storm bolt struck a ball
+	EventBlackHole                        // a black hole drifted into view
+	EventSupernova                        // a background star exploded
+)
+
+// Event is a game event published on the event bus
+type Event struct {
+	Type  EventType // what happened
+	X, Y  float32   // where it happened (zero for world-wide events)
+	Value int       // event-specific number (e.g. the wave number)
+}
+
+// EventBus delivers game events to subscribers.
+// Events are delivered synchronously on the game loop, so handlers must be quick.
+type EventBus struct {
+	subscribers map[EventType][]func(Event)
+}
+
+// NewEventBus creates an event bus with no subscribers
+func NewEventBus() *EventBus {
+	return &EventBus{subscribers: make(map[EventType][]func(Event))}
+}
+
+// Subscribe registers a handler for every future event of the given type
+func (b *EventBus) Subscribe(eventType EventType, handler func(Event)) {
+	b.subscribers[eventType] = append(b.subscribers[eventType], handler)
+}
+
+// Publish delivers an event to its subscribers in the order they subscribed.
+// Publishing on a nil bus does nothing, so entities work without one.
+func (b *EventBus) Publish(event Event) {
+	if b == nil {
+		return
+	}
+	for _, handler := range b.subscribers[event.Type] {
+		handler(event)
+	}
+}
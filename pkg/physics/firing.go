@@ -0,0 +1,111 @@
+package physics
+
+import "math"
+
+// FiringPattern selects where on the firing circle bullets leave from and which way they fly
+type FiringPattern int
+
+// Firing patterns
+const (
+	FiringAtTarget    FiringPattern = iota // bullets leave from the circle edge facing the target
+	FiringOrbit                            // the firing point orbits the circle and bullets leave from wherever it is
+	FiringRadialBurst                      // every shot fires outward from evenly spaced points all around the circle
+)
+
+// String returns the pattern's display name
+func (p FiringPattern) String() string {
+	switch p {
+	case FiringOrbit:
+		return "Orbit"
+	case FiringRadialBurst:
+		return "Radial Burst"
+	default:
+		return "At Target"
+	}
+}
+
+// Next returns the pattern after this one, cycling back to FiringAtTarget
+func (p FiringPattern) Next() FiringPattern {
+	return (p + 1) % (FiringRadialBurst + 1)
+}
+
+// FiringConfig controls how the firing circle releases bullets
+type FiringConfig struct {
+	Pattern    FiringPattern // where bullets leave from and which way they fly
+	OrbitSpeed float32       // radians per frame the firing point orbits the circle (negative = clockwise on screen)
+	Tangential bool          // in the orbit pattern, fire along the orbit instead of at the target
+	BurstCount int           // bullet origins around the circle in the radial burst pattern
+}
+
+// DefaultFiringConfig returns the firing circle behavior from the tuning values, aiming at the target
+func DefaultFiringConfig() FiringConfig {
+	return FiringConfig{
+		Pattern:    FiringAtTarget,
+		OrbitSpeed: Tuning().FiringOrbitSpeed,
+		Tangential: Tuning().FiringTangential,
+		BurstCount: Tuning().FiringBurstCount,
+	}
+}
+
+// SetFiringPattern switches the firing circle's pattern
+func (h *Human) SetFiringPattern(pattern FiringPattern) {
+	h.Firing.Pattern = pattern
+}
+
+// advanceOrbit moves the firing point around the circle in the orbit pattern
+func (h *Human) advanceOrbit() {
+	if h.Firing.Pattern != FiringOrbit {
+		return
+	}
+	h.FiringAngle = float32(math.Mod(float64(h.FiringAngle+h.Firing.OrbitSpeed), 2*math.Pi))
+}
+
+// fireAt fires the current weapon from the point at angle on the firing circle, heading along direction.
+// A weapon that has run dry falls back to the single shot.
+func (h *Human) fireAt(angle, direction float32) []*Bullet {
+	x := h.X + float32(math.Cos(float64(angle)))*h.FiringRadius
+	y := h.Y + float32(math.Sin(float64(angle)))*h.FiringRadius
+
+	bullets := h.CurrentWeapon().Fire(x, y, direction)
+	if len(bullets) == 0 {
+		h.WeaponIndex = 0
+		bullets = h.CurrentWeapon().Fire(x, y, direction)
+	}
+	return bullets
+}
+
+// firePattern fires one volley of the current firing pattern toward the target
+func (h *Human) firePattern(targetX, targetY float32) []*Bullet {
+	switch h.Firing.Pattern {
+	case FiringOrbit:
+		// Leave from wherever the orbiting point is, either along the orbit or at the target
+		x, y := h.FiringPosition()
+		direction := float32(math.Atan2(float64(targetY-y), float64(targetX-x)))
+		if h.Firing.Tangential {
+			direction = h.FiringAngle + math.Pi/2
+			if h.Firing.OrbitSpeed < 0 {
+				direction = h.FiringAngle - math.Pi/2
+			}
+		}
+		return h.fireAt(h.FiringAngle, direction)
+
+	case FiringRadialBurst:
+		// Fire outward all around the circle, starting from the point facing the target
+		h.FiringAngle = float32(math.Atan2(float64(targetY-h.Y), float64(targetX-h.X)))
+		count := h.Firing.BurstCount
+		if count < 1 {
+			count = 1
+		}
+		var bullets []*Bullet
+		for i := 0; i < count; i++ {
+			angle := h.FiringAngle + 2*math.Pi*float32(i)/float32(count)
+			bullets = append(bullets, h.fireAt(angle, angle)...)
+		}
+		return bullets
+
+	default:
+		// Leave from the circle edge facing the target
+		h.FiringAngle = float32(math.Atan2(float64(targetY-h.Y), float64(targetX-h.X)))
+		return h.fireAt(h.FiringAngle, h.FiringAngle)
+	}
+}
@@ -0,0 +1,76 @@
+package physics
+
+import "math"
+
+// Flocking tuning (boids: aliens drift toward each other, keep their distance and match headings)
+const (
+	flockRange       = float32(200)    // aliens further apart than this ignore each other
+	flockSeparation  = float32(80)     // aliens closer than this push apart
+	cohesionWeight   = float32(0.0006) // pull toward the center of nearby aliens, per pixel away
+	alignmentWeight  = float32(0.03)   // share of the neighbors' average drift matched per frame
+	separationWeight = float32(0.002)  // push away from crowding aliens, per pixel of crowding
+	maxFlockSpeed    = float32(0.8)    // drifting aliens never go faster than this
+)
+
+// ApplyFlocking steers drifting aliens with boids-style cohesion, alignment and separation,
+// so a fleet clusters into loose formations. Aliens busy with the human don't flock.
+func ApplyFlocking(aliens []*Alien) {
+	type steer struct{ vx, vy float32 }
+	steering := make([]steer, len(aliens))
+
+	for i, a := range aliens {
+		if !a.canFlock() {
+			continue
+		}
+
+		var centerX, centerY, driftX, driftY, pushX, pushY float32
+		neighbors := 0
+		for j, b := range aliens {
+			if i == j || !b.canFlock() {
+				continue
+			}
+			dx := b.X - a.X
+			dy := b.Y - a.Y
+			distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+			if distance > flockRange {
+				continue
+			}
+
+			neighbors++
+			centerX += b.X
+			centerY += b.Y
+			driftX += b.VX
+			driftY += b.VY
+			if distance > 0 && distance < flockSeparation {
+				crowding := flockSeparation - distance
+				pushX -= dx / distance * crowding
+				pushY -= dy / distance * crowding
+			}
+		}
+		if neighbors == 0 {
+			continue
+		}
+
+		n := float32(neighbors)
+		steering[i].vx = (centerX/n-a.X)*cohesionWeight + (driftX/n-a.VX)*alignmentWeight + pushX*separationWeight
+		steering[i].vy = (centerY/n-a.Y)*cohesionWeight + (driftY/n-a.VY)*alignmentWeight + pushY*separationWeight
+	}
+
+	// Apply every alien's steering at once so the order of the fleet doesn't matter
+	for i, a := range aliens {
+		if !a.canFlock() {
+			continue
+		}
+		a.VX += steering[i].vx
+		a.VY += steering[i].vy
+		if speed := float32(math.Sqrt(float64(a.VX*a.VX + a.VY*a.VY))); speed > maxFlockSpeed {
+			a.VX *= maxFlockSpeed / speed
+			a.VY *= maxFlockSpeed / speed
+		}
+	}
+}
+
+// canFlock reports whether the alien is drifting freely and takes part in flocking
+func (a *Alien) canFlock() bool {
+	return a.IsActive && !a.IsCurious && !a.IsObserving && !a.IsDeparting && !a.IsInvestigating
+}
@@ -0,0 +1,79 @@
+package physics
+
+import "strings"
+
+// GameMode selects the rules a game is played by
+type GameMode int
+
+// Game modes
+const (
+	GameClassic   GameMode = iota // clear every ball to win the round, then go again with more
+	GameSurvival                  // endless waves; the score is the time survived and one death ends the game
+	GameTimeTrial                 // destroy every ball as fast as possible
+	GamePacifist                  // no shooting or swiping: dodge until the time limit to win
+	GameBossFight                 // one giant boss eyeball with phased attacks; destroy it to win
+)
+
+// GameModes lists every mode in the order they are offered
+var GameModes = []GameMode{GameClassic, GameSurvival, GameTimeTrial, GamePacifist, GameBossFight}
+
+// pacifistSeconds is how long a pacifist has to survive to win
+const pacifistSeconds = 120
+
+// GameModeRules is how a game mode sets up the world and decides when the game is won or lost
+type GameModeRules struct {
+	Waves       bool // balls arrive in endless escalating waves instead of rounds
+	Shooting    bool // players can shoot and swipe
+	ScoreByTime bool // the score is the seconds survived instead of points for hits
+	EndOnDeath  bool // the game is lost as soon as every player is dead
+	EndOnClear  bool // the game is won by destroying every ball (instead of going on to the next round)
+	TimeLimit   int  // seconds to survive to win (0 = no limit)
+	Boss        bool // a boss fight instead of rounds: the game is won when the boss is destroyed
+}
+
+// String returns the mode's display name
+func (m GameMode) String() string {
+	switch m {
+	case GameSurvival:
+		return "Survival"
+	case GameTimeTrial:
+		return "Time Trial"
+	case GamePacifist:
+		return "Pacifist"
+	case GameBossFight:
+		return "Boss Fight"
+	default:
+		return "Classic"
+	}
+}
+
+// Next returns the mode after this one, cycling back to GameClassic
+func (m GameMode) Next() GameMode {
+	return (m + 1) % (GameBossFight + 1)
+}
+
+// GameModeByName returns the mode with the given display name (any case), or GameClassic for unknown names
+func GameModeByName(name string) GameMode {
+	for _, mode := range GameModes {
+		if strings.EqualFold(mode.String(), name) {
+			return mode
+		}
+	}
+	return GameClassic
+}
+
+// Rules returns how the mode plays
+func (m GameMode) Rules() GameModeRules {
+	switch m {
+	case GameSurvival:
+		return GameModeRules{Waves: true, Shooting: true, ScoreByTime: true, EndOnDeath: true}
+	case GameTimeTrial:
+		return GameModeRules{Shooting: true, EndOnClear: true}
+	case GamePacifist:
+		return GameModeRules{ScoreByTime: true, EndOnDeath: true, TimeLimit: pacifistSeconds}
+	case GameBossFight:
+		return GameModeRules{Shooting: true, EndOnDeath: true, Boss: true}
+	default:
+		return GameModeRules{Shooting: true}
+	}
+}
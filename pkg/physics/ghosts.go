@@ -0,0 +1,51 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
+)
+
+// Motion blur ghosts
+const (
+	motionGhostCount      = 3            // fading copies on screen at once
+	motionGhostAlpha      = 110          // opacity of a fresh ghost
+	ballGhostSpeed        = float32(5)   // ball speed in pixels per frame above which it leaves ghosts
+	humanGhostSpeedFactor = float32(1.5) // the human leaves ghosts above this multiple of its walking speed (sprinting)
+)
+
+// EnableMotionGhosts makes the ball leave fading copies of itself behind when it moves fast.
+// Call it before the ball's visuals are added to the canvas.
+func (b *Ball) EnableMotionGhosts() {
+	tint := color.RGBAModel.Convert(b.irisColor()).(color.RGBA)
+	tint.A = motionGhostAlpha
+	b.Ghosts = effects.NewMotionGhosts(motionGhostCount, ballGhostSpeed, tint)
+}
+
+// EnableMotionGhosts makes the human leave fading silhouettes behind while sprinting.
+// Call it before the human's visuals are added to the canvas.
+func (h *Human) EnableMotionGhosts() {
+	tint := color.RGBA{R: 200, G: 220, B: 255, A: motionGhostAlpha} // Pale blue
+	h.Ghosts = effects.NewMotionGhosts(motionGhostCount, h.Speed*humanGhostSpeedFactor, tint)
+}
+
+// updateGhosts drops a ghost of the ball's eyeball when it is moving fast
+func (b *Ball) updateGhosts() {
+	if b.Ghosts == nil {
+		return
+	}
+	speed := float32(math.Sqrt(float64(b.VX*b.VX + b.VY*b.VY)))
+	size := b.Radius * 2
+	b.Ghosts.Update(b.X, b.Y, size, size, speed)
+}
+
+// updateGhosts drops a silhouette of the human, head to feet, when it moved faster than a walk this frame
+func (h *Human) updateGhosts(dx, dy float32) {
+	if h.Ghosts == nil {
+		return
+	}
+	h.Ghosts.Threshold = h.Speed * humanGhostSpeedFactor // Keep up with speed upgrades
+	speed := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	h.Ghosts.Update(h.X, h.Y+h.Size*0.1, h.Size*0.8, h.Size*1.4, speed)
+}
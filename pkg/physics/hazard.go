@@ -0,0 +1,204 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// LaserSweep represents a laser line hazard that telegraphs its position and then sweeps across the arena
+type LaserSweep struct {
+	Horizontal     bool      // true = horizontal line sweeping vertically, false = vertical line sweeping horizontally
+	Position       float32   // current coordinate of the line along the sweep axis
+	Direction      float32   // +1 or -1 sweep direction
+	Speed          float32   // sweep speed in pixels per frame
+	TelegraphTimer int       // frames remaining in the telegraph (warning) phase
+	IsActive       bool      // whether the laser is sweeping and dangerous
+	IsFinished     bool      // whether the laser has left the arena
+	StunDuration   int       // frames a ball stays stunned when hit
+	Bounds         fyne.Size // arena bounds
+	Events         *EventBus // receives a lightning event for every ball the laser stuns (may be nil)
+	// Visual components
+	Glow *canvas.Line // Wide translucent glow behind the beam
+	Beam *canvas.Line // Bright core of the beam
+}
+
+// NewLaserSweep creates a laser sweep with a random orientation and direction
+func NewLaserSweep(bounds fyne.Size) *LaserSweep {
+	laser := &LaserSweep{
+		Horizontal:     rand.Intn(2) == 0,
+		Direction:      1,
+		Speed:          Tuning().LaserSweepSpeed,
+		TelegraphTimer: 90, // 1.5 seconds of warning at 60 FPS
+		StunDuration:   Tuning().LaserStunFrames,
+		Bounds:         bounds,
+	}
+
+	// Pick a starting edge; the laser sweeps toward the opposite edge
+	if rand.Intn(2) == 0 {
+		laser.Direction = -1
+	}
+	laser.Position = laser.startPosition()
+
+	laser.Glow = &canvas.Line{
+		StrokeColor: color.RGBA{R: 255, G: 0, B: 0, A: 0},
+		StrokeWidth: 8.0,
+	}
+	laser.Beam = &canvas.Line{
+		StrokeColor: color.RGBA{R: 255, G: 60, B: 60, A: 0},
+		StrokeWidth: 1.0,
+	}
+
+	laser.UpdatePosition()
+
+	return laser
+}
+
+// startPosition returns the coordinate of the edge the laser starts from
+func (l *LaserSweep) startPosition() float32 {
+	if l.Direction > 0 {
+		return 0
+	}
+	if l.Horizontal {
+		return l.Bounds.Height
+	}
+	return l.Bounds.Width
+}
+
+// Endpoints returns the two endpoints of the laser line
+func (l *LaserSweep) Endpoints() (float32, float32, float32, float32) {
+	if l.Horizontal {
+		return 0, l.Position, l.Bounds.Width, l.Position
+	}
+	return l.Position, 0, l.Position, l.Bounds.Height
+}
+
+// Update advances the telegraph countdown or the sweep
+func (l *LaserSweep) Update() {
+	if l.IsFinished {
+		return
+	}
+
+	if l.TelegraphTimer > 0 {
+		l.TelegraphTimer--
+		if l.TelegraphTimer == 0 {
+			l.IsActive = true
+		}
+	} else if l.IsActive {
+		l.Position += l.Speed * l.Direction
+
+		// Finish once the line has crossed the whole arena
+		limit := l.Bounds.Width
+		if l.Horizontal {
+			limit = l.Bounds.Height
+		}
+		if l.Position < 0 || l.Position > limit {
+			l.IsActive = false
+			l.IsFinished = true
+			l.Hide()
+			return
+		}
+	}
+
+	l.UpdatePosition()
+}
+
+// UpdatePosition updates the visual line and its telegraph/active styling
+func (l *LaserSweep) UpdatePosition() {
+	x1, y1, x2, y2 := l.Endpoints()
+	l.Glow.Position1 = fyne.NewPos(x1, y1)
+	l.Glow.Position2 = fyne.NewPos(x2, y2)
+	l.Beam.Position1 = fyne.NewPos(x1, y1)
+	l.Beam.Position2 = fyne.NewPos(x2, y2)
+
+	if l.IsActive {
+		// Solid, bright beam while dangerous
+		l.Glow.StrokeColor = color.RGBA{R: 255, G: 0, B: 0, A: 90}
+		l.Glow.StrokeWidth = 10.0
+		l.Beam.StrokeColor = color.RGBA{R: 255, G: 220, B: 220, A: 255}
+		l.Beam.StrokeWidth = 3.0
+	} else {
+		// Thin blinking warning line during telegraph
+		alpha := uint8(60)
+		if (l.TelegraphTimer/8)%2 == 0 {
+			alpha = 160
+		}
+		l.Glow.StrokeColor = color.RGBA{R: 255, G: 0, B: 0, A: alpha / 4}
+		l.Glow.StrokeWidth = 6.0
+		l.Beam.StrokeColor = color.RGBA{R: 255, G: 60, B: 60, A: alpha}
+		l.Beam.StrokeWidth = 1.0
+	}
+
+	l.Glow.Refresh()
+	l.Beam.Refresh()
+}
+
+// HitsCircle reports whether the active laser line touches a circle
+func (l *LaserSweep) HitsCircle(cx, cy, radius float32) bool {
+	if !l.IsActive {
+		return false
+	}
+	x1, y1, x2, y2 := l.Endpoints()
+	return LineIntersectsCircle(x1, y1, x2, y2, cx, cy, radius)
+}
+
+// CheckHuman reports whether the laser hits the human
+func (l *LaserSweep) CheckHuman(h *Human) bool {
+	if h == nil || !h.IsVulnerable() {
+		return false
+	}
+	return l.HitsCircle(h.X, h.Y, h.Size*0.6) // Same hit radius as ball collisions
+}
+
+// StunBalls stuns every ball currently touched by the laser
+func (l *LaserSweep) StunBalls(balls []*Ball) {
+	for _, ball := range balls {
+		if ball.StunTimer > 0 {
+			continue
+		}
+		if l.HitsCircle(ball.X, ball.Y, ball.Radius) {
+			ball.ApplyStatus(StatusStunned, l.StunDuration)
+			l.Events.Publish(Event{Type: EventLightning, X: ball.X, Y: ball.Y})
+		}
+	}
+}
+
+// GetVisualComponents returns the laser's visual components for UI management
+func (l *LaserSweep) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{l.Glow, l.Beam}
+}
+
+// Hide hides the laser
+func (l *LaserSweep) Hide() {
+	l.Glow.Hide()
+	l.Beam.Hide()
+}
+
+// LineIntersectsCircle reports whether the segment (x1,y1)-(x2,y2) comes within radius of (cx,cy)
+func LineIntersectsCircle(x1, y1, x2, y2, cx, cy, radius float32) bool {
+	dx := x2 - x1
+	dy := y2 - y1
+	lengthSquared := dx*dx + dy*dy
+
+	// Project circle center onto the segment and clamp to its ends
+	t := float32(0)
+	if lengthSquared > 0 {
+		t = ((cx-x1)*dx + (cy-y1)*dy) / lengthSquared
+		if t < 0 {
+			t = 0
+		} else if t > 1 {
+			t = 1
+		}
+	}
+
+	closestX := x1 + t*dx
+	closestY := y1 + t*dy
+	distX := cx - closestX
+	distY := cy - closestY
+	distance := float32(math.Sqrt(float64(distX*distX + distY*distY)))
+
+	return distance <= radius
+}
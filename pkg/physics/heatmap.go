@@ -0,0 +1,73 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+)
+
+// heatMapMaxSpeed is the speed shown as pure red in heat map mode (slower balls shade toward blue)
+const heatMapMaxSpeed = float32(5.0)
+
+// ShowSpeedColor recolors the iris from the ball's current speed: blue when slow, red when fast.
+// The ball's own iris colors are kept and brought back by RestoreIrisColor.
+func (b *Ball) ShowSpeedColor() {
+	if !b.isHeatMapped {
+		b.savedIrisFill = b.Iris.FillColor
+		b.savedIrisStroke = b.Iris.StrokeColor
+		b.isHeatMapped = true
+	}
+
+	speed := float32(math.Sqrt(float64(b.VX*b.VX + b.VY*b.VY)))
+	heat := speed / heatMapMaxSpeed
+	if heat > 1 {
+		heat = 1
+	}
+
+	fill := hueToRGB(240 * (1 - heat)) // 240° = blue, 0° = red
+	b.Iris.FillColor = fill
+	b.Iris.StrokeColor = color.RGBA{R: fill.R / 2, G: fill.G / 2, B: fill.B / 2, A: 255}
+	b.Iris.Refresh()
+}
+
+// RestoreIrisColor puts back the iris colors the ball had before heat map mode
+func (b *Ball) RestoreIrisColor() {
+	if !b.isHeatMapped {
+		return
+	}
+
+	b.Iris.FillColor = b.savedIrisFill
+	b.Iris.StrokeColor = b.savedIrisStroke
+	b.isHeatMapped = false
+	b.Iris.Refresh()
+}
+
+// irisColor returns the ball's own iris color, even while heat map mode has recolored it
+func (b *Ball) irisColor() color.Color {
+	if b.isHeatMapped {
+		return b.savedIrisFill
+	}
+	return b.Iris.FillColor
+}
+
+// hueToRGB converts a fully saturated, full brightness hue in degrees to a color
+func hueToRGB(hue float32) color.RGBA {
+	h := math.Mod(float64(hue), 360) / 60
+	x := 1 - math.Abs(math.Mod(h, 2)-1)
+
+	var r, g, b float64
+	switch int(h) {
+	case 0:
+		r, g, b = 1, x, 0
+	case 1:
+		r, g, b = x, 1, 0
+	case 2:
+		r, g, b = 0, 1, x
+	case 3:
+		r, g, b = 0, x, 1
+	case 4:
+		r, g, b = x, 0, 1
+	default:
+		r, g, b = 1, 0, x
+	}
+	return color.RGBA{R: uint8(r * 255), G: uint8(g * 255), B: uint8(b * 255), A: 255}
+}
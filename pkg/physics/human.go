@@ -6,34 +6,77 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
 )
 
 // Bullet represents a bullet fired by the human
 type Bullet struct {
 	X, Y     float32 // current position
 	VX, VY   float32 // velocity
+	Size     float32 // drawn diameter of the eyeball
 	// Eyeball components for bullets
 	Eyeball  *canvas.Circle  // White eyeball
 	Iris     *canvas.Circle  // Colored iris
 	Pupil    *canvas.Circle  // Black pupil
 	IsActive bool
+	// Weapon behavior
+	Damage   int     // HP removed from a ball on hit
+	Piercing bool    // passes through balls instead of stopping at the first hit
+	Homing   bool    // steers toward the closest ball
+	Chain    int     // extra balls a chain lightning spark arcs to after it hits (0 = none)
+	// Lifetime and ricochet (set from the human's BulletConfig when fired)
+	TTL        int     // frames before the bullet despawns (0 = until it leaves the screen)
+	MaxBounces int     // wall bounces before the bullet despawns
+	bounces    int     // wall bounces so far
+	hits       []*Ball // balls a piercing bullet has already hit
+	Trail      *Ribbon // thin streak behind the bullet (nil = none, e.g. alien plasma orbs)
 }
 
 // Human represents a human that avoids the balls
 type Human struct {
-	X, Y         float32   // current position
-	Size         float32   // size (similar to ball radius)
-	Speed        float32   // movement speed
-	Bounds       fyne.Size // movement bounds
-	IsActive     bool      // whether the human is active
-	IsExploding  bool      // whether the human is currently exploding
-	RespawnTimer int       // frames until respawn
-	Deaths       int       // death counter
-	// Keyboard control state
-	KeyUp    bool // up arrow key pressed
-	KeyDown  bool // down arrow key pressed
-	KeyLeft  bool // left arrow key pressed
-	KeyRight bool // right arrow key pressed
+	X, Y         float32     // current position
+	Size         float32     // size (similar to ball radius)
+	Speed        float32     // movement speed
+	Bounds       fyne.Size   // movement bounds
+	Obstacles    []*Obstacle // the level's blocks it walks around (set by the game)
+	IsActive     bool        // whether the human is active
+	IsExploding  bool        // whether the human is currently exploding
+	RespawnTimer int         // frames until respawn
+	Deaths       int         // death counter
+	Score        int         // points earned by destroying balls
+	ShotsFired   int         // total bullets fired
+	ShotsHit     int         // bullets that hit a ball
+	ShieldTimer  int         // frames of invulnerability left after an early respawn or a survived hit
+	HP           int         // hits left before exploding this life
+	MaxHP        int         // HP at the start of each life
+	earnedShield bool        // whether the respawn minigame was won this death
+	// Leveling
+	Progress *PlayerProgress // XP, level and chosen upgrades
+	// Manual control state (fed from the keyboard, mouse and gamepad)
+	Control   ControlMode // AI or manual movement
+	MoveX     float32     // manual movement, -1 (left) to 1 (right)
+	MoveY     float32     // manual movement, -1 (up) to 1 (down)
+	ManualAim bool        // whether the player aims (otherwise shots auto-target the closest ball)
+	AimAngle  float32     // manual aim direction in radians
+	FireHeld  bool        // whether the player is holding fire while aiming
+	// Stamina (spent sprinting at double speed, regained while walking)
+	Stamina     float32 // stamina left
+	MaxStamina  float32 // full stamina
+	SprintHeld  bool    // whether the player is holding sprint
+	IsSprinting bool    // whether the human sprinted this frame
+	exhausted   bool    // ran out of stamina and waiting to recover
+	// Melee (a short-range swipe in the facing direction)
+	MeleeHeld  bool // whether the player is holding melee
+	MeleeTimer int  // frames until the next swipe
+	SwingTimer int  // frames left in the current arm swing
+	// Walk animation
+	walkPhase float32 // position in the walk cycle, in radians
+	walkDirX  float32 // horizontal part of the walking direction (-1 to 1)
+	gait      float32 // stride strength, 0 (idle pose) to 1 (full stride)
+	// Knockback from nearby explosions and boss slams (overrides movement while it lasts)
+	knockVX, knockVY float32
+	// Dragon riding (see rider.go)
+	Mount *Dragon // dragon the human is riding (nil = on foot)
 	// Visual components - drawn programmatically
 	Head           *canvas.Circle    // Head (circle)
 	Body           *canvas.Rectangle // Body (rectangle)
@@ -55,12 +98,37 @@ type Human struct {
 	FiringAngle    float32           // Current angle where bullets are fired from
 	FiringEffectTimer int            // Timer for showing firing effect
 	FiringRadius   float32           // Radius of the firing circle
+	Firing         FiringConfig      // Firing pattern, orbit speed and burst size
 	// Explosion particles
-	ExplosionParticles []*canvas.Circle
+	Particles *effects.ParticleSystem // where the death explosion is emitted (set by the UI; nil = no particles)
 	// Bullet system
 	Bullets       []*Bullet
-	ShootTimer    int // frames until next shot
-	ShootCooldown int // frames between shots
+	ShootTimer    int  // frames until next shot
+	ShootCooldown int  // frames between shots
+	HoldFire      bool // never shoots or swipes (pacifist mode)
+	// Weapons (switched with the number keys)
+	Weapons      []Weapon             // selectable weapons, in number-key order
+	WeaponIndex  int                  // index of the selected weapon
+	BulletConfig BulletConfig         // bullet ricochets and lifetime
+	pendingBolts []*effects.Lightning // chain lightning bolts not yet handed to the UI
+	// Lock-on (Tab cycles targets)
+	LockedTarget *Ball    // ball the human shoots at instead of the closest one (nil = auto-target)
+	Reticle      *Reticle // crosshair drawn on the locked-on ball
+	// Respawn minigame and shield
+	RespawnGame *RespawnMinigame // Timing challenge shown while dead
+	Shield      *canvas.Circle   // Bubble shown while the respawn shield is up
+	Shadow      *Shadow          // Ground shadow (drawn on the shadow layer)
+	Ghosts      *effects.MotionGhosts // fading silhouettes left behind while sprinting (nil = off, see ghosts.go)
+	// AI scheduling (expensive searches run on AI ticks and are cached in between)
+	Brain              HumanBrain   // AI movement strategy (nil = classic dodger)
+	AI                 *AIScheduler // shared AI tick scheduler (nil = every frame)
+	avoidX, avoidY     float32      // cached avoidance force
+	avoidDanger        float32      // cached predicted danger at the human's position (drives AI sprinting)
+	stormDodge         float32      // sideways step out of a space storm's warned columns (see storm.go)
+	dangerMap          *DangerMap   // predicted danger grid the avoidance AI navigates
+	respawnX, respawnY float32      // cached safest respawn location
+	respawnClearance   float32      // clearance from the balls the planned location had when it was picked
+	hasRespawnPlan     bool         // whether respawnX/respawnY hold a planned location
 }
 
 // NewHuman creates a new human figure
@@ -69,12 +137,21 @@ func NewHuman(x, y, size float32) *Human {
 		X:             x,
 		Y:             y,
 		Size:          size,
-		Speed:         4.5, // Increased from 2.0 to 4.5 for much faster movement
+		Speed:         Tuning().HumanSpeed,
 		Bounds:        fyne.NewSize(800, 600),
 		IsActive:      true,
 		Bullets:       make([]*Bullet, 0),
 		ShootTimer:    0,
-		ShootCooldown: 15, // Shoot every 15 frames (4 times per second at 60 FPS)
+		ShootCooldown: Tuning().HumanShootCooldown,
+		Weapons:       DefaultWeapons(),
+		BulletConfig:  DefaultBulletConfig(),
+		Firing:        DefaultFiringConfig(),
+		Stamina:       maxStamina,
+		MaxStamina:    maxStamina,
+		Brain:         ClassicBrain{},
+		HP:            CurrentDifficulty.Config().HumanHP,
+		MaxHP:         CurrentDifficulty.Config().HumanHP,
+		Progress:      NewPlayerProgress(),
 		Rotation:      0,  // Start facing right (0 radians)
 	}
 
@@ -165,7 +242,7 @@ func NewHuman(x, y, size float32) *Human {
 	human.RightLeg.Move(fyne.NewPos(x+size*0.3, y+size*0.2))
 
 	// Create firing circle system
-	human.FiringRadius = size * 1.5 // Circle radius around human
+	human.FiringRadius = size * firingRadiusScale // Circle radius around human
 
 	// Transparent firing circle with only edge visible
 	human.FiringCircle = &canvas.Circle{
@@ -215,6 +292,22 @@ func NewHuman(x, y, size float32) *Human {
 	human.FiringAngle = 0
 	human.FiringEffectTimer = 0
 
+	// Create the ground shadow
+	human.Shadow = NewShadow()
+	human.Reticle = NewReticle()
+
+	// Create respawn minigame around the firing circle and the shield bubble
+	human.RespawnGame = NewRespawnMinigame(human.FiringRadius)
+	human.Shield = &canvas.Circle{
+		FillColor:   color.RGBA{R: 0, G: 220, B: 255, A: 40},  // Translucent cyan
+		StrokeColor: color.RGBA{R: 0, G: 220, B: 255, A: 200}, // Bright cyan edge
+		StrokeWidth: 2.0,
+	}
+	human.Shield.Hide()
+
+	// Draw it in the current theme
+	human.ApplyTheme()
+
 	// Set initial position
 	human.UpdatePosition()
 
@@ -250,12 +343,11 @@ func (h *Human) UpdatePosition() {
 	h.RightArm.Move(fyne.NewPos(h.X+h.Size*0.35, h.Y-h.Size*0.1))
 	h.RightArm.Resize(fyne.NewSize(h.Size*0.25, h.Size*0.35))
 
-	// Legs
-	h.LeftLeg.Move(fyne.NewPos(h.X-h.Size*0.25, h.Y+h.Size*0.3))
-	h.LeftLeg.Resize(fyne.NewSize(h.Size*0.2, h.Size*0.5))
+	// Legs (animated walk cycle)
+	h.updateLegs()
 
-	h.RightLeg.Move(fyne.NewPos(h.X+h.Size*0.05, h.Y+h.Size*0.3))
-	h.RightLeg.Resize(fyne.NewSize(h.Size*0.2, h.Size*0.5))
+	// Shadow under the feet (the human stays on the ground)
+	h.Shadow.Update(h.X, h.Y+h.Size*0.8, h.Size*0.9, 0)
 
 	// Update firing circle position
 	h.updateFiringCircle()
@@ -367,19 +459,19 @@ func (h *Human) findClosestBall(balls []*Ball) *Ball {
 	return closestBall
 }
 
-// UpdateRotation calculates and updates the rotation to face the closest ball
+// UpdateRotation calculates and updates the rotation to face the locked-on or closest ball
 func (h *Human) UpdateRotation(balls []*Ball) {
 	if !h.IsActive {
 		return
 	}
 
-	// Find the closest ball
-	closestBall := h.findClosestBall(balls)
+	// Find the locked-on ball, or else the closest one
+	closestBall := h.shootingTarget(balls)
 	if closestBall == nil {
 		return
 	}
 
-	// Calculate angle to the closest ball
+	// Calculate angle to the ball
 	dx := closestBall.X - h.X
 	dy := closestBall.Y - h.Y
 	targetAngle := math.Atan2(float64(dy), float64(dx))
@@ -397,121 +489,176 @@ func (h *Human) Update(balls []*Ball) {
 	// Update rotation to face closest ball
 	h.UpdateRotation(balls)
 
-	// Calculate avoidance force from all balls
-	avoidX, avoidY := h.calculateAvoidance(balls)
+	var totalForceX, totalForceY float32
+	if h.Control == ControlManual {
+		// The player drives, sprinting at double speed while stamina lasts
+		totalForceX, totalForceY = h.manualForce()
+		if h.updateStamina(h.wantsSprint(totalForceX != 0 || totalForceY != 0)) {
+			totalForceX *= sprintSpeedFactor
+			totalForceY *= sprintSpeedFactor
+		}
+	} else {
+		// Calculate avoidance force from all balls (refreshed on AI ticks)
+		brain := h.brain()
+		if h.AI.Due(AITaskHumanAvoidance) {
+			h.avoidX, h.avoidY, h.avoidDanger = brain.Avoid(h, balls)
+		}
 
-	// Calculate centering force to stay in bounds
-	centerX, centerY := h.calculateCentering()
+		// Let the strategy combine avoidance with where it likes to be
+		totalForceX, totalForceY = brain.Steer(h, h.avoidX, h.avoidY)
 
-	// Combine forces (avoidance has higher priority)
-	totalForceX := avoidX*0.8 + centerX*0.2
-	totalForceY := avoidY*0.8 + centerY*0.2
+		// Step out from under any storm bolt about to land
+		totalForceX += h.stormDodge
+
+		// Sprint away from close threats while stamina lasts
+		forceLength := float32(math.Sqrt(float64(totalForceX*totalForceX + totalForceY*totalForceY)))
+		maxSpeed := h.Speed
+		if h.updateStamina(h.wantsSprint(forceLength > 0)) {
+			maxSpeed *= sprintSpeedFactor
+		}
 
-	// Normalize force if too strong
-	forceLength := float32(math.Sqrt(float64(totalForceX*totalForceX + totalForceY*totalForceY)))
-	if forceLength > h.Speed {
-		totalForceX = (totalForceX / forceLength) * h.Speed
-		totalForceY = (totalForceY / forceLength) * h.Speed
+		// Normalize force if too strong
+		if forceLength > maxSpeed {
+			totalForceX = (totalForceX / forceLength) * maxSpeed
+			totalForceY = (totalForceY / forceLength) * maxSpeed
+		}
+	}
+
+	// A blast throws the human around whatever the AI or player wants
+	if h.IsKnockedBack() {
+		totalForceX, totalForceY = h.knockback()
+	}
+
+	// A rider steers the dragon instead of walking (the dragon carries the rider)
+	if h.Mount != nil {
+		if h.Speed > 0 {
+			h.Mount.Steer(totalForceX/h.Speed, totalForceY/h.Speed)
+		}
+		totalForceX, totalForceY = 0, 0
 	}
 
 	// Apply movement
+	prevX, prevY := h.X, h.Y
 	h.X += totalForceX
 	h.Y += totalForceY
 
-	// Keep within bounds
+	// Keep within bounds and out of the level's blocks
 	h.keepWithinBounds()
+	h.X, h.Y, _, _ = PushOut(h.Obstacles, h.X, h.Y, h.Size*0.5)
+
+	// Step the legs by how far the human actually moved (walking into a wall stands still)
+	h.updateGait(h.X-prevX, h.Y-prevY)
+	h.updateGhosts(h.X-prevX, h.Y-prevY)
 
 	// Update visual position
 	h.UpdatePosition()
 
+	// Swipe at balls in front (after positioning, so the swinging arm wins)
+	h.updateMelee(balls)
+
+	// Count down the respawn shield
+	h.updateShield()
+
 	// Update eye tracking to look at closest ball
 	h.updateEyeTrackingWithBalls(balls)
 
 	// Update pointing (now just a stub)
 	h.UpdatePointing(balls)
 
-	// Update bullets
+	// Update bullets (homing missiles turn toward their targets first)
+	h.steerHomingBullets(balls)
 	h.UpdateBullets()
 
 	// Update shooting
 	h.UpdateShooting(balls)
+	h.updateReticle()
 
 	// Check bullet collisions
 	h.CheckBulletCollisions(balls)
 }
 
-// calculateAvoidance calculates AI avoidance movement (extracted from original AvoidBalls method)
-func (h *Human) calculateAvoidance(balls []*Ball) (float32, float32) {
-	var totalAvoidanceX, totalAvoidanceY float32
-	dangerCount := 0
-	maxDanger := float32(0)
+// calculateAvoidance builds the danger map from the balls' predicted paths and heads down it toward the
+// safest nearby spot. It returns the movement along with the predicted danger at the human's position.
+func (h *Human) calculateAvoidance(balls []*Ball) (float32, float32, float32) {
+	if h.dangerMap == nil || h.dangerMap.bounds != h.Bounds {
+		h.dangerMap = NewDangerMap(h.Bounds, dangerCellSize)
+	}
+	h.dangerMap.Build(balls, h.Obstacles, h.Size)
+
+	danger := h.dangerMap.At(h.X, h.Y)
+	if danger > dangerMax {
+		danger = dangerMax
+	}
+
+	stepX, stepY, gain := h.dangerMap.SafestStep(h.X, h.Y)
+	if gain <= 0 {
+		return 0, 0, danger // Already in the safest spot around
+	}
+
+	// Move faster the more there is to gain
+	urgency := gain * 2
+	if urgency < 0.3 {
+		urgency = 0.3
+	} else if urgency > 2 {
+		urgency = 2
+	}
+	forceX, forceY := towards(h.X, h.Y, stepX, stepY, h.Speed*urgency)
+	return forceX, forceY, danger
+}
+
+// DangerLevel returns how threatened the human currently is, from 0 (calm) to 1 (surrounded). A nearby boss
+// counts for several ordinary balls.
+func (h *Human) DangerLevel(balls []*Ball) float32 {
+	if !h.IsActive {
+		return 0
+	}
+
+	dangerRange := float32(250) // Balls further than this don't count
+	danger := float32(0)
 
 	for _, ball := range balls {
 		if !ball.IsAnimated {
 			continue
 		}
 
-		// Calculate distance to ball
 		dx := h.X - ball.X
 		dy := h.Y - ball.Y
 		distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
-
-		// Much larger safety margin for earlier detection
-		dangerDistance := h.Size + ball.Radius + 120 // Increased from 50 to 120
-
-		if distance < dangerDistance {
-			// Calculate multiple future positions for better prediction
-			for t := float32(5); t <= 20; t += 5 { // Check 5, 10, 15, 20 frames ahead
-				futureBallX := ball.X + ball.VX*t
-				futureBallY := ball.Y + ball.VY*t
-
-				// Check if ball will be close to human
-				futureDx := h.X - futureBallX
-				futureDy := h.Y - futureBallY
-				futureDistance := float32(math.Sqrt(float64(futureDx*futureDx + futureDy*futureDy)))
-
-				if futureDistance < distance*0.8 { // Ball is approaching
-					// Calculate avoidance direction (away from ball)
-					if distance > 0 {
-						// More aggressive avoidance strength
-						avoidanceStrength := (dangerDistance - distance) / dangerDistance
-						avoidanceStrength = avoidanceStrength * avoidanceStrength * 2.0 // Quadratic increase
-
-						normalizedDx := dx / distance
-						normalizedDy := dy / distance
-
-						totalAvoidanceX += normalizedDx * avoidanceStrength
-						totalAvoidanceY += normalizedDy * avoidanceStrength
-						dangerCount++
-
-						if avoidanceStrength > maxDanger {
-							maxDanger = avoidanceStrength
-						}
-					}
-					break // Found threat, move to next ball
-				}
-			}
+		clearance := distance - ball.Radius - h.Size*0.6
+		if clearance >= dangerRange {
+			continue
 		}
-	}
 
-	// Calculate avoidance movement
-	if dangerCount > 0 {
-		// Normalize avoidance vector
-		avgAvoidanceX := totalAvoidanceX / float32(dangerCount)
-		avgAvoidanceY := totalAvoidanceY / float32(dangerCount)
+		proximity := 1.0 - clearance/dangerRange
+		if proximity > 1 {
+			proximity = 1
+		}
 
-		// Apply panic speed boost when in extreme danger
-		speedMultiplier := h.Speed
-		if maxDanger > 0.7 {
-			speedMultiplier *= 2.0 // Double speed in extreme danger
-		} else if maxDanger > 0.4 {
-			speedMultiplier *= 1.5 // 50% speed boost in moderate danger
+		// Balls heading toward the human are more threatening
+		if ball.VX*dx+ball.VY*dy > 0 {
+			proximity *= 1.5
 		}
 
-		return avgAvoidanceX * speedMultiplier, avgAvoidanceY * speedMultiplier
+		danger += proximity * 0.5 * bossDangerFactor(ball)
 	}
 
-	return 0, 0
+	if danger > 1 {
+		danger = 1
+	}
+	return danger
+}
+
+// bossDangerWeight is the extra share of the danger level a boss at full HP adds over an ordinary ball (2 = it counts triple)
+const bossDangerWeight = float32(2)
+
+// bossDangerFactor scales a ball's share of the danger level: bosses home in and slam, so they count for
+// more, the more HP they have left
+func bossDangerFactor(ball *Ball) float32 {
+	if ball.Kind != KindBoss || ball.MaxHP <= 0 {
+		return 1
+	}
+	health := min(max(float32(ball.HP)/float32(ball.MaxHP), 0), 1)
+	return 1 + bossDangerWeight*health
 }
 
 // calculateCentering calculates movement toward the center of the panel
@@ -563,7 +710,7 @@ func (h *Human) keepWithinBounds() {
 
 // CheckCollisionWithBalls checks if the human collides with any ball
 func (h *Human) CheckCollisionWithBalls(balls []*Ball) bool {
-	if !h.IsActive || h.IsExploding {
+	if !h.IsVulnerable() {
 		return false
 	}
 
@@ -586,6 +733,67 @@ func (h *Human) CheckCollisionWithBalls(balls []*Ball) bool {
 	return false
 }
 
+// humanExplosion is the burst the human goes up in when a ball catches them
+var humanExplosion = &effects.Emitter{
+	Count:  12,
+	Speed:  2.0,
+	Spread: math.Pi,
+	Even:   true,
+	Life:   60, // Gone after the first second of the respawn countdown
+	Size:   8,
+	Colors: []color.RGBA{
+		{R: 255, G: 100, B: 100, A: 255}, // Red
+		{R: 255, G: 200, B: 100, A: 255}, // Orange
+		{R: 255, G: 255, B: 100, A: 255}, // Yellow
+		{R: 100, G: 255, B: 100, A: 255}, // Green
+	},
+}
+
+// Impact sparks, sized by how hard the bullet struck
+const (
+	impactSparkCount     = 4            // sparks in the gentlest impact
+	impactSparksPerSpeed = float32(0.5) // extra sparks per pixel per frame of impact speed
+	impactSparkMaxCount  = 12           // sparks in the hardest impact
+	impactSparkSpeed     = float32(0.4) // spark speed as a fraction of the impact speed
+)
+
+// impactSparks is the spray a bullet kicks up where it strikes a ball. Count, Speed and Direction
+// are set for each impact.
+var impactSparks = effects.Emitter{
+	SpeedJitter: 1.0,
+	Spread:      0.5, // A narrow cone along the impact normal
+	Life:        15,
+	Size:        3,
+	Drag:        0.08,
+	Colors: []color.RGBA{
+		{R: 255, G: 255, B: 255, A: 255}, // White
+		{R: 255, G: 240, B: 150, A: 255}, // Pale yellow
+		{R: 255, G: 180, B: 60, A: 255},  // Orange
+	},
+}
+
+// emitImpactSparks sprays sparks from the point where the bullet struck the ball, back out along
+// the impact normal, with more and faster sparks the harder the bullet hit
+func (h *Human) emitImpactSparks(bullet *Bullet, ball *Ball) {
+	dx := bullet.X - ball.X
+	dy := bullet.Y - ball.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance == 0 {
+		return
+	}
+	normalX, normalY := dx/distance, dy/distance
+
+	relVX := bullet.VX - ball.VX
+	relVY := bullet.VY - ball.VY
+	impactSpeed := float32(math.Sqrt(float64(relVX*relVX + relVY*relVY)))
+
+	sparks := impactSparks
+	sparks.Count = min(impactSparkCount+int(impactSpeed*impactSparksPerSpeed), impactSparkMaxCount)
+	sparks.Speed = impactSpeed * impactSparkSpeed
+	sparks.Direction = float32(math.Atan2(float64(normalY), float64(normalX)))
+	h.Particles.Emit(&sparks, ball.X+normalX*ball.Radius, ball.Y+normalY*ball.Radius)
+}
+
 // Explode creates an explosion effect and hides the human
 func (h *Human) Explode() {
 	if h.IsExploding {
@@ -596,6 +804,9 @@ func (h *Human) Explode() {
 	h.IsActive = false
 	h.RespawnTimer = 180 // 3 seconds at 60 FPS
 	h.Deaths++           // Increment death counter
+	h.ShieldTimer = 0
+	h.earnedShield = false
+	h.hasRespawnPlan = false
 
 	// Hide human components
 	h.Head.Hide()
@@ -612,27 +823,16 @@ func (h *Human) Explode() {
 	h.FiringEye.Hide()
 	h.FiringIris.Hide()
 	h.FiringPupil.Hide()
+	h.Ghosts.Clear()
+	h.Shield.Hide()
+	h.Shadow.Hide()
+	h.Reticle.Hide()
 
-	// Create explosion particles
-	numParticles := 12
-	h.ExplosionParticles = make([]*canvas.Circle, numParticles)
-
-	colors := []color.RGBA{
-		{R: 255, G: 100, B: 100, A: 255}, // Red
-		{R: 255, G: 200, B: 100, A: 255}, // Orange
-		{R: 255, G: 255, B: 100, A: 255}, // Yellow
-		{R: 100, G: 255, B: 100, A: 255}, // Green
-	}
+	// Start the respawn minigame where the human died
+	h.RespawnGame.Start(h.X, h.Y)
 
-	for i := 0; i < numParticles; i++ {
-		particle := &canvas.Circle{
-			FillColor: colors[i%len(colors)],
-		}
-		particle.Resize(fyne.NewSize(8, 8))
-		// Position particles at human's center initially
-		particle.Move(fyne.NewPos(h.X-4, h.Y-4))
-		h.ExplosionParticles[i] = particle
-	}
+	// Burst apart in every direction
+	h.Particles.Emit(humanExplosion, h.X, h.Y)
 }
 
 // UpdateExplosion updates the explosion animation
@@ -642,44 +842,7 @@ func (h *Human) UpdateExplosion() {
 	}
 
 	h.RespawnTimer--
-
-	// Animate explosion particles
-	if h.RespawnTimer > 120 { // First 1 second - explosion expanding
-		explosionFrame := 180 - h.RespawnTimer
-		for i, particle := range h.ExplosionParticles {
-			if particle != nil {
-				// Calculate particle movement in different directions
-				angle := float64(i) * 2 * math.Pi / float64(len(h.ExplosionParticles))
-				radius := float32(explosionFrame) * 2.0
-
-				newX := h.X + float32(math.Cos(angle))*radius - 4
-				newY := h.Y + float32(math.Sin(angle))*radius - 4
-
-				particle.Move(fyne.NewPos(newX, newY))
-
-				// Fade particles
-				alphaInt := 255 - int(explosionFrame*4)
-				if alphaInt < 0 {
-					alphaInt = 0
-				}
-				alpha := uint8(alphaInt)
-				particle.FillColor = color.RGBA{
-					R: particle.FillColor.(color.RGBA).R,
-					G: particle.FillColor.(color.RGBA).G,
-					B: particle.FillColor.(color.RGBA).B,
-					A: alpha,
-				}
-				particle.Refresh()
-			}
-		}
-	} else if h.RespawnTimer == 120 {
-		// Hide explosion particles
-		for _, particle := range h.ExplosionParticles {
-			if particle != nil {
-				particle.Hide()
-			}
-		}
-	}
+	h.RespawnGame.Update()
 
 	// Respawn human
 	if h.RespawnTimer <= 0 {
@@ -694,48 +857,49 @@ func (h *Human) Respawn() {
 	safeY := h.Bounds.Height / 2
 
 	// Set new position
-	h.X = safeX
-	h.Y = safeY
+	h.X, h.Y, _, _ = PushOut(h.Obstacles, safeX, safeY, h.Size)
 
-	// Reset state
-	h.IsExploding = false
-	h.IsActive = true
-	h.RespawnTimer = 0
-	h.Rotation = 0 // Reset rotation
-
-	// Show human components
-	h.Head.Show()
-	h.Body.Show()
-	h.LeftEye.Show()
-	h.RightEye.Show()
-	h.LeftPupil.Show()
-	h.RightPupil.Show()
-	h.LeftArm.Show()
-	h.RightArm.Show()
-	h.LeftLeg.Show()
-	h.RightLeg.Show()
-	h.FiringCircle.Show()
-	h.FiringEye.Show()
-	h.FiringIris.Show()
-	h.FiringPupil.Show()
-
-	h.UpdatePosition()
+	h.revive()
 }
 
 // RespawnWithBalls brings the human back to life at the safest location away from all balls
 func (h *Human) RespawnWithBalls(balls []*Ball) {
-	safeX, safeY := h.findSafestRespawnLocation(balls)
+	// Use the location planned during the explosion if there is one
+	safeX, safeY := h.respawnX, h.respawnY
+	if !h.hasRespawnPlan {
+		safeX, safeY = h.findSafestRespawnLocation(balls)
+	}
+	h.hasRespawnPlan = false
 
 	// Set new position
 	h.X = safeX
 	h.Y = safeY
 
+	h.revive()
+}
+
+// revive resets the death state, shows the human again and applies any shield earned in the respawn minigame
+func (h *Human) revive() {
 	// Reset state
 	h.IsExploding = false
 	h.IsActive = true
 	h.RespawnTimer = 0
 	h.Rotation = 0 // Reset rotation
 
+	// Clean up the minigame in case it was cut short
+	h.RespawnGame.Stop()
+
+	if h.earnedShield {
+		h.ShieldTimer = respawnShieldTime
+		h.earnedShield = false
+	}
+	h.RestoreStamina()
+	h.HP = h.MaxHP
+	h.MeleeTimer = 0
+	h.SwingTimer = 0
+	h.gait = 0 // Respawn standing in the idle pose
+	h.knockVX, h.knockVY = 0, 0
+
 	// Show human components
 	h.Head.Show()
 	h.Body.Show()
@@ -751,11 +915,40 @@ func (h *Human) RespawnWithBalls(balls []*Ball) {
 	h.FiringEye.Show()
 	h.FiringIris.Show()
 	h.FiringPupil.Show()
+	h.Shadow.Show()
 
 	h.UpdatePosition()
+	h.updateShieldPosition()
 }
 
-// findSafestRespawnLocation finds the position that maximizes distance from all balls
+// Respawn planning
+const (
+	respawnPlanWindow   = 15          // frames before respawn the safe location search runs
+	respawnMinClearance = float32(60) // clearance a planned location can shrink to before it is searched for again
+)
+
+// PlanRespawn picks the safest respawn location once, when the explosion is about to end, so RespawnWithBalls
+// can reuse it instead of searching on the respawn frame. Later AI ticks only re-check the planned spot and
+// search again if the balls have closed in on it.
+func (h *Human) PlanRespawn(balls []*Ball) {
+	if !h.IsExploding || h.RespawnTimer > respawnPlanWindow {
+		return
+	}
+	if h.hasRespawnPlan {
+		if !h.AI.Due(AITaskRespawnSearch) {
+			return
+		}
+		clearance := h.respawnClearanceAt(balls, h.respawnX, h.respawnY)
+		if clearance >= respawnMinClearance || clearance >= h.respawnClearance {
+			return // Still as safe as when it was picked, or safe enough
+		}
+	}
+	h.respawnX, h.respawnY = h.findSafestRespawnLocation(balls)
+	h.respawnClearance = h.respawnClearanceAt(balls, h.respawnX, h.respawnY)
+	h.hasRespawnPlan = true
+}
+
+// findSafestRespawnLocation finds the position that maximizes distance from all balls, clear of the level's blocks
 func (h *Human) findSafestRespawnLocation(balls []*Ball) (float32, float32) {
 	// Define search grid parameters
 	gridSize := 20 // 20x20 grid for reasonable performance
@@ -771,27 +964,12 @@ func (h *Human) findSafestRespawnLocation(balls []*Ball) (float32, float32) {
 			// Calculate candidate position
 			x := margin + (float32(i)/float32(gridSize-1))*(h.Bounds.Width-2*margin)
 			y := 50 + margin + (float32(j)/float32(gridSize-1))*(h.Bounds.Height-100-2*margin) // Account for UI area
+			if Blocked(h.Obstacles, x, y, h.Size) {
+				continue // Inside or against one of the level's blocks
+			}
 
 			// Find minimum distance to all balls from this position
-			minDistanceToBalls := float32(math.Inf(1))
-
-			for _, ball := range balls {
-				if !ball.IsAnimated {
-					continue
-				}
-
-				// Calculate distance to this ball
-				dx := x - ball.X
-				dy := y - ball.Y
-				distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
-
-				// Account for ball radius and human size for true clearance
-				clearance := distance - ball.Radius - h.Size
-
-				if clearance < minDistanceToBalls {
-					minDistanceToBalls = clearance
-				}
-			}
+			minDistanceToBalls := h.respawnClearanceAt(balls, x, y)
 
 			// If this position has better minimum distance, use it
 			if minDistanceToBalls > maxMinDistance {
@@ -802,9 +980,30 @@ func (h *Human) findSafestRespawnLocation(balls []*Ball) (float32, float32) {
 		}
 	}
 
+	// The center fallback may sit in a block when nowhere had any clearance
+	bestX, bestY, _, _ = PushOut(h.Obstacles, bestX, bestY, h.Size)
 	return bestX, bestY
 }
 
+// respawnClearanceAt returns how far the human would be from the nearest ball's edge if it respawned at (x, y)
+func (h *Human) respawnClearanceAt(balls []*Ball, x, y float32) float32 {
+	minDistanceToBalls := float32(math.Inf(1))
+	for _, ball := range balls {
+		if !ball.IsAnimated {
+			continue
+		}
+
+		// Account for ball radius and human size for true clearance
+		dx := x - ball.X
+		dy := y - ball.Y
+		distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+		if clearance := distance - ball.Radius - h.Size; clearance < minDistanceToBalls {
+			minDistanceToBalls = clearance
+		}
+	}
+	return minDistanceToBalls
+}
+
 // UpdatePointing updates the arms to point at the closest ball
 // UpdatePointing is no longer needed since we're using a PNG image
 // The human image will show a static pose
@@ -820,7 +1019,7 @@ func NewBullet(startX, startY, targetX, targetY float32) *Bullet {
 	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
 
 	// Normalize direction and set bullet speed
-	bulletSpeed := float32(8.0) // Fast bullet speed
+	bulletSpeed := Tuning().BulletSpeed
 	vx := (dx / distance) * bulletSpeed
 	vy := (dy / distance) * bulletSpeed
 
@@ -829,11 +1028,13 @@ func NewBullet(startX, startY, targetX, targetY float32) *Bullet {
 		Y:        startY,
 		VX:       vx,
 		VY:       vy,
+		Size:     20, // Size of the bullet eyeball
 		IsActive: true,
+		Damage:   1,
 	}
 
 	// Create eyeball bullet components
-	bulletSize := float32(20) // Size of the bullet eyeball
+	bulletSize := bullet.Size
 
 	// White eyeball (outer layer)
 	bullet.Eyeball = &canvas.Circle{
@@ -876,75 +1077,82 @@ func (h *Human) UpdateBullets() {
 			continue
 		}
 
-		// Update bullet position
-		bulletSize := float32(20) // Size of the bullet eyeball (match NewBullet)
-		irisSize := bulletSize * 0.7
-		pupilSize := bulletSize * 0.35
-
-		bullet.X += bullet.VX
-		bullet.Y += bullet.VY
-		bullet.Eyeball.Move(fyne.NewPos(bullet.X-bulletSize/2, bullet.Y-bulletSize/2))
-		bullet.Iris.Move(fyne.NewPos(bullet.X-irisSize/2, bullet.Y-irisSize/2))
-		bullet.Pupil.Move(fyne.NewPos(bullet.X-pupilSize/2, bullet.Y-pupilSize/2))
-
-		// Remove bullets that go off screen
-		if bullet.X < 0 || bullet.X > h.Bounds.Width || bullet.Y < 0 || bullet.Y > h.Bounds.Height {
-			bullet.IsActive = false
-			bullet.Eyeball.Hide()
-			bullet.Iris.Hide()
-			bullet.Pupil.Hide()
-			// Remove from slice
+		// Remove bullets that expire or go off screen
+		if !bullet.advance(h.Bounds) || !bullet.bounceOffObstacles(h.Obstacles) {
 			h.Bullets = append(h.Bullets[:i], h.Bullets[i+1:]...)
 		}
 	}
 }
 
-// ShootAtTarget creates bullets from the firing circle edge toward the target
+// ShootAtTarget fires the current weapon from the firing circle toward the target, following the firing pattern
 func (h *Human) ShootAtTarget(targetX, targetY float32) {
 	if !h.IsActive || h.IsExploding {
 		return
 	}
 
-	// Calculate angle to target
-	dx := targetX - h.X
-	dy := targetY - h.Y
-	h.FiringAngle = float32(math.Atan2(float64(dy), float64(dx)))
-
-	// Calculate bullet spawn position on the firing circle edge
-	bulletX := h.X + float32(math.Cos(float64(h.FiringAngle))) * h.FiringRadius
-	bulletY := h.Y + float32(math.Sin(float64(h.FiringAngle))) * h.FiringRadius
+	// Fire the current weapon from the firing circle in the current pattern
+	bullets := h.firePattern(targetX, targetY)
+	for _, bullet := range bullets {
+		h.BulletConfig.apply(bullet)
+		bullet.Trail = NewRibbon(bulletTrailSegments, 1)
+	}
+	h.Bullets = append(h.Bullets, bullets...)
 
-	// Create bullet from the circle edge position
-	bullet := NewBullet(bulletX, bulletY, targetX, targetY)
-	h.Bullets = append(h.Bullets, bullet)
+	h.ShotsFired += len(bullets)
 
 	// Trigger firing effect
 	h.FiringEffectTimer = 15 // Show effect for 15 frames (quarter second at 60fps)
 }
 
+// FiringPosition returns the point on the firing circle where bullets currently leave from
+func (h *Human) FiringPosition() (float32, float32) {
+	x := h.X + float32(math.Cos(float64(h.FiringAngle)))*h.FiringRadius
+	y := h.Y + float32(math.Sin(float64(h.FiringAngle)))*h.FiringRadius
+	return x, y
+}
+
 // UpdateShooting handles the shooting timer and creates bullets when ready
 func (h *Human) UpdateShooting(balls []*Ball) {
-	if !h.IsActive || h.IsExploding {
+	if !h.IsActive || h.IsExploding || h.HoldFire {
 		return
 	}
 
+	// The firing eye follows the player's aim, unless it is orbiting the circle
+	h.advanceOrbit()
+	if h.ManualAim && h.Firing.Pattern != FiringOrbit {
+		h.FiringAngle = h.AimAngle
+	}
+
 	// Decrement shoot timer
 	if h.ShootTimer > 0 {
 		h.ShootTimer--
 		return
 	}
 
-	// Find closest ball to shoot at
-	closestBall := h.findClosestBall(balls)
-	if closestBall == nil {
+	// Player aiming: shoot along the aim only while fire is held
+	if h.ManualAim {
+		if !h.FireHeld {
+			return
+		}
+		targetX := h.X + float32(math.Cos(float64(h.AimAngle)))*h.FiringRadius*2
+		targetY := h.Y + float32(math.Sin(float64(h.AimAngle)))*h.FiringRadius*2
+		h.ShootAtTarget(targetX, targetY)
+		h.ShootTimer = h.shotCooldown()
+		return
+	}
+
+	// Shoot at the locked-on ball, or else the closest one
+	target := h.shootingTarget(balls)
+	if target == nil {
 		return
 	}
 
-	// Shoot at the closest ball
-	h.ShootAtTarget(closestBall.X, closestBall.Y)
+	// Lead the ball so the shot meets it where it is going
+	targetX, targetY := h.InterceptPoint(target)
+	h.ShootAtTarget(targetX, targetY)
 
-	// Reset shoot timer
-	h.ShootTimer = h.ShootCooldown
+	// Reset shoot timer for the current weapon
+	h.ShootTimer = h.shotCooldown()
 }
 
 // CheckBulletCollisions checks if any bullets hit any balls and handles the collision
@@ -956,7 +1164,7 @@ func (h *Human) CheckBulletCollisions(balls []*Ball) {
 		}
 
 		for _, ball := range balls {
-			if !ball.IsAnimated {
+			if !ball.IsAnimated || bullet.hasHit(ball) {
 				continue
 			}
 
@@ -966,11 +1174,24 @@ func (h *Human) CheckBulletCollisions(balls []*Ball) {
 			distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
 
 			if distance < ball.Radius+10 { // bullet radius is now 10 (bulletSize/2 = 20/2 = 10)
-				// Bullet hit ball!
-				bullet.IsActive = false
-				bullet.Eyeball.Hide()
-				bullet.Iris.Hide()
-				bullet.Pupil.Hide()
+				// Bullet hit ball! A piercing bullet only counts as one hit however many balls it passes through
+				if len(bullet.hits) == 0 {
+					h.ShotsHit++
+				}
+				bullet.hits = append(bullet.hits, ball)
+
+				// Damage the ball; destroying it grants score. Both earn XP.
+				destroyed := ball.TakeDamage(bullet.Damage)
+				if destroyed {
+					h.Score += ball.ScoreValue()
+				}
+				h.awardHit(ball, destroyed)
+				h.emitImpactSparks(bullet, ball)
+
+				// A chain lightning spark arcs on from the ball it hit
+				if bullet.Chain > 0 {
+					h.chainLightning(ball, balls, bullet.Chain)
+				}
 
 				// Apply repulsion force to the ball
 				if distance > 0 {
@@ -996,7 +1217,13 @@ func (h *Human) CheckBulletCollisions(balls []*Ball) {
 					ball.triggerJiggle(0.3) // Smaller jiggle than wall bounces
 				}
 
+				// Piercing bullets keep flying
+				if bullet.Piercing {
+					continue
+				}
+
 				// Remove bullet from slice
+				bullet.remove()
 				h.Bullets = append(h.Bullets[:i], h.Bullets[i+1:]...)
 				break // Bullet can only hit one ball
 			}
@@ -1004,11 +1231,12 @@ func (h *Human) CheckBulletCollisions(balls []*Ball) {
 	}
 }
 
-// GetBulletVisuals returns all bullet visual objects for UI management
-func (h *Human) GetBulletVisuals() []*canvas.Circle {
-	visuals := make([]*canvas.Circle, 0, len(h.Bullets))
+// GetBulletVisuals returns all bullet visual objects for UI management, streaks first so they draw behind
+func (h *Human) GetBulletVisuals() []fyne.CanvasObject {
+	visuals := make([]fyne.CanvasObject, 0, len(h.Bullets)*(bulletTrailSegments+3))
 	for _, bullet := range h.Bullets {
 		if bullet.IsActive {
+			visuals = append(visuals, bullet.Trail.GetVisualComponents()...)
 			visuals = append(visuals, bullet.Eyeball)
 			visuals = append(visuals, bullet.Iris)
 			visuals = append(visuals, bullet.Pupil)
@@ -1026,10 +1254,11 @@ func (h *Human) updateFiringCircle() {
 	// Update firing circle position (centered on human)
 	h.FiringCircle.Move(fyne.NewPos(h.X-h.FiringRadius, h.Y-h.FiringRadius))
 
-	// Update firing effect timer and visibility
+	// Update firing effect timer and visibility (the orbiting firing point is always shown)
 	if h.FiringEffectTimer > 0 {
 		h.FiringEffectTimer--
-
+	}
+	if h.FiringEffectTimer > 0 || h.Firing.Pattern == FiringOrbit {
 		// Position firing effect to appear as a highlighted segment on the main circle edge
 		// Position effect center on the main circle's edge at the firing angle
 		effectCenterX := h.X + float32(math.Cos(float64(h.FiringAngle))) * h.FiringRadius
@@ -0,0 +1,130 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+)
+
+// BallKind selects a ball's collision behavior and styling
+type BallKind int
+
+// Ball kinds
+const (
+	KindStandard  BallKind = iota // regular elastic eyeball
+	KindGhost                     // passes through other balls
+	KindExplosive                 // detonates when it touches another ball
+	KindHeavy                     // 5x density, barely moved by collisions
+	KindBoss                      // big and tough, homes in on the human (never picked at random)
+)
+
+// Kind tuning
+const (
+	heavyDensity        = float32(5.0) // mass multiplier for heavy balls
+	explosiveDamage     = 2            // damage dealt to the ball an explosive touches
+	explosiveKnockback  = float32(4.0) // speed the blast gives the ball it touches
+	explosiveStrokeSize = float32(3.0) // stroke width marking explosive balls
+)
+
+// String returns the kind's display name
+func (k BallKind) String() string {
+	switch k {
+	case KindGhost:
+		return "Ghost"
+	case KindExplosive:
+		return "Explosive"
+	case KindHeavy:
+		return "Heavy"
+	case KindBoss:
+		return "Boss"
+	default:
+		return "Standard"
+	}
+}
+
+// RandomBallKind picks a kind for a randomly spawned ball (mostly standard)
+func RandomBallKind() BallKind {
+	roll := rand.Float32()
+	switch {
+	case roll < 0.7:
+		return KindStandard
+	case roll < 0.8:
+		return KindGhost
+	case roll < 0.9:
+		return KindExplosive
+	default:
+		return KindHeavy
+	}
+}
+
+// SetKind changes the ball's kind and applies its styling
+func (b *Ball) SetKind(kind BallKind) {
+	b.Kind = kind
+	b.applyKindStyle()
+
+	if kind == KindBoss {
+		b.makeBoss()
+	}
+}
+
+// applyKindStyle colors and outlines the sclera for the ball's kind in the current theme
+func (b *Ball) applyKindStyle() {
+	b.Circle.FillColor = b.kindFillColor()
+	if b.FrozenTimer > 0 {
+		b.Circle.FillColor = frozenTint
+	}
+	b.Circle.StrokeColor = b.kindStrokeColor()
+	b.Circle.StrokeWidth = CurrentTheme.Style().ScleraStrokeWidth
+	if b.Kind == KindExplosive || b.Kind == KindBoss {
+		b.Circle.StrokeWidth = explosiveStrokeSize // Always marked, whatever the theme
+	}
+	b.Circle.Refresh()
+}
+
+// kindFillColor returns the sclera color for the ball's kind: the theme's sclera for standard balls,
+// see-through ghosts and dull metal gray heavy balls
+func (b *Ball) kindFillColor() color.RGBA {
+	switch b.Kind {
+	case KindGhost:
+		return color.RGBA{R: 255, G: 255, B: 255, A: 90}
+	case KindHeavy:
+		return color.RGBA{R: 170, G: 170, B: 185, A: 255}
+	case KindBoss:
+		return color.RGBA{R: 255, G: 225, B: 225, A: 255} // Bloodshot pink
+	default:
+		return CurrentTheme.Style().Sclera
+	}
+}
+
+// kindStrokeColor returns the sclera border color for the ball's kind
+func (b *Ball) kindStrokeColor() color.RGBA {
+	switch b.Kind {
+	case KindGhost:
+		return color.RGBA{R: 200, G: 200, B: 255, A: 120} // Pale translucent blue
+	case KindExplosive:
+		return color.RGBA{R: 255, G: 80, B: 0, A: 255} // Hot orange
+	case KindHeavy:
+		return color.RGBA{R: 80, G: 80, B: 90, A: 255} // Dark steel
+	case KindBoss:
+		return color.RGBA{R: 150, G: 0, B: 60, A: 255} // Deep crimson
+	default:
+		return CurrentTheme.Style().ScleraStroke
+	}
+}
+
+// detonate blows up the explosive ball b, damaging and knocking back the ball it touched
+func (b *Ball) detonate(target *Ball) {
+	b.TakeDamage(b.HP)
+	b.addBlast(b.X, b.Y, explosiveBlastStrength, b.Radius*explosionBlastScale*1.5) // Hits harder than a plain explosion
+
+	// Push the target straight away from the blast
+	dx := target.X - b.X
+	dy := target.Y - b.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance > 0 {
+		target.VX = dx / distance * explosiveKnockback
+		target.VY = dy / distance * explosiveKnockback
+	}
+	target.triggerJiggle(0.8)
+	target.TakeDamage(explosiveDamage)
+}
@@ -0,0 +1,91 @@
+package physics
+
+import "math"
+
+// Knockback tuning
+const (
+	explosionBlastStrength = float32(6.0)  // knockback speed right at the center of a ball explosion
+	explosionBlastScale    = float32(4.0)  // explosion reach relative to the ball's radius
+	explosiveBlastStrength = float32(9.0)  // knockback speed at the center of an explosive ball's detonation
+	bossSlamStrength       = float32(10.0) // knockback speed right where a boss slams into a wall or ball
+	bossSlamRange          = float32(220)  // how far a boss slam reaches
+	knockbackDecay         = float32(0.85) // knockback speed kept each frame
+	knockbackMinSpeed      = float32(0.3)  // below this speed the knockback ends and control returns
+	knockbackMaxSpeed      = float32(14.0) // stacked blasts never throw the human faster than this
+)
+
+// Blast is a shockwave that knocks nearby humans away from its center
+type Blast struct {
+	X, Y     float32 // center
+	Strength float32 // knockback speed at the center
+	Range    float32 // distance at which the knockback fades to nothing
+	Slam     bool    // a boss slamming into something rather than an explosion
+}
+
+// addBlast queues a shockwave for the UI to apply to the humans
+func (b *Ball) addBlast(x, y, strength, reach float32) {
+	b.pendingBlasts = append(b.pendingBlasts, Blast{X: x, Y: y, Strength: strength, Range: reach})
+}
+
+// slam queues a boss slam at the point where the boss hit something
+func (b *Ball) slam(x, y float32) {
+	if b.Kind == KindBoss {
+		b.pendingBlasts = append(b.pendingBlasts, Blast{X: x, Y: y, Strength: bossSlamStrength, Range: bossSlamRange, Slam: true})
+	}
+}
+
+// TakePendingBlasts returns the explosions and boss slams since the last call so the UI can apply them once
+func (b *Ball) TakePendingBlasts() []Blast {
+	blasts := b.pendingBlasts
+	b.pendingBlasts = nil
+	return blasts
+}
+
+// ApplyBlast pushes the human away from a blast, harder the closer it was.
+// The push overrides the AI or player movement until it dies down.
+func (h *Human) ApplyBlast(blast Blast) {
+	if !h.IsActive || h.IsExploding || blast.Range <= 0 {
+		return
+	}
+
+	dx := h.X - blast.X
+	dy := h.Y - blast.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance >= blast.Range {
+		return
+	}
+
+	// Straight on top of the blast: fly straight up
+	if distance > 0 {
+		dx /= distance
+		dy /= distance
+	} else {
+		dx, dy = 0, -1
+	}
+
+	impulse := blast.Strength * (1 - distance/blast.Range)
+	h.knockVX += dx * impulse
+	h.knockVY += dy * impulse
+
+	speed := float32(math.Sqrt(float64(h.knockVX*h.knockVX + h.knockVY*h.knockVY)))
+	if speed > knockbackMaxSpeed {
+		h.knockVX = h.knockVX / speed * knockbackMaxSpeed
+		h.knockVY = h.knockVY / speed * knockbackMaxSpeed
+	}
+}
+
+// IsKnockedBack reports whether a blast is currently throwing the human around
+func (h *Human) IsKnockedBack() bool {
+	return h.knockVX != 0 || h.knockVY != 0
+}
+
+// knockback returns this frame's knockback movement and lets it die down
+func (h *Human) knockback() (float32, float32) {
+	x, y := h.knockVX, h.knockVY
+	h.knockVX *= knockbackDecay
+	h.knockVY *= knockbackDecay
+	if h.knockVX*h.knockVX+h.knockVY*h.knockVY < knockbackMinSpeed*knockbackMinSpeed {
+		h.knockVX, h.knockVY = 0, 0
+	}
+	return x, y
+}
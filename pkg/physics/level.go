@@ -0,0 +1,196 @@
+package physics
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"os"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// Level is a custom stage loaded from a JSON level file: the starting balls, obstacles, wave schedule,
+// arena and background. Anything the file leaves out keeps the game's usual setup.
+type Level struct {
+	Name       string           `json:"name"`       // shown when the level starts
+	Balls      []LevelBall      `json:"balls"`      // balls at the start of each round (empty = the usual starters)
+	Obstacles  []LevelObstacle  `json:"obstacles"`  // blocks the balls bounce off
+	Waves      []WaveDefinition `json:"waves"`      // wave mode's first waves, in order
+	Arena      LevelArena       `json:"arena"`      // arena shape and walls
+	Background string           `json:"background"` // background preset: "deep_space", "nebula", "warp" or "calm" ("" = unchanged)
+}
+
+// LevelBall places one starting ball. Zero values are randomized like any spawned ball.
+type LevelBall struct {
+	X      float32 `json:"x"`
+	Y      float32 `json:"y"`
+	VX     float32 `json:"vx"`
+	VY     float32 `json:"vy"`
+	Radius float32 `json:"radius"`
+	Color  string  `json:"color"` // iris color as "#rrggbb"
+	Name   string  `json:"name"`  // label under the ball
+	Kind   string  `json:"kind"`  // "standard", "ghost", "explosive", "heavy" or "boss"
+}
+
+// LevelObstacle places one rectangular block by its top-left corner and size
+type LevelObstacle struct {
+	X      float32 `json:"x"`
+	Y      float32 `json:"y"`
+	Width  float32 `json:"width"`
+	Height float32 `json:"height"`
+	Color  string  `json:"color"` // fill as "#rrggbb" ("" = default)
+}
+
+// LevelArena picks the arena's shape and what its walls do
+type LevelArena struct {
+	Shape    string `json:"shape"`     // "open", "pillars", "cross" or "box": built-in obstacle layouts ("" = open)
+	WallMode string `json:"wall_mode"` // "bounce", "wrap" or "absorb" ("" = unchanged)
+}
+
+// LoadLevel reads and checks a level file
+func LoadLevel(path string) (*Level, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var level Level
+	if err := json.Unmarshal(data, &level); err != nil {
+		return nil, err
+	}
+	if err := level.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &level, nil
+}
+
+// validate reports the first value in the level that can't be used
+func (l *Level) validate() error {
+	for i, ball := range l.Balls {
+		if _, err := ParseHexColor(ball.Color); err != nil {
+			return fmt.Errorf("ball %d: %w", i+1, err)
+		}
+		if _, ok := BallKindByName(ball.Kind); !ok {
+			return fmt.Errorf("ball %d: unknown kind %q", i+1, ball.Kind)
+		}
+	}
+	for i, obstacle := range l.Obstacles {
+		if obstacle.Width <= 0 || obstacle.Height <= 0 {
+			return fmt.Errorf("obstacle %d: width and height must be positive", i+1)
+		}
+		if _, err := ParseHexColor(obstacle.Color); err != nil {
+			return fmt.Errorf("obstacle %d: %w", i+1, err)
+		}
+	}
+	if _, ok := arenaShapes[strings.ToLower(l.Arena.Shape)]; !ok && l.Arena.Shape != "" {
+		return fmt.Errorf("unknown arena shape %q", l.Arena.Shape)
+	}
+	if _, ok := WallModeByName(l.Arena.WallMode); !ok && l.Arena.WallMode != "" {
+		return fmt.Errorf("unknown wall mode %q", l.Arena.WallMode)
+	}
+	if _, ok := backgroundPresets[strings.ToLower(l.Background)]; !ok && l.Background != "" {
+		return fmt.Errorf("unknown background %q", l.Background)
+	}
+	return nil
+}
+
+// arenaShapes lays out each built-in arena shape's obstacles as fractions of the arena (x, y, width, height)
+var arenaShapes = map[string][][4]float32{
+	"open": nil,
+	"pillars": {
+		{0.2, 0.25, 0.05, 0.12}, {0.75, 0.25, 0.05, 0.12},
+		{0.2, 0.63, 0.05, 0.12}, {0.75, 0.63, 0.05, 0.12},
+	},
+	"cross": {
+		{0.47, 0.2, 0.06, 0.22}, {0.47, 0.58, 0.06, 0.22},
+		{0.2, 0.47, 0.2, 0.06}, {0.6, 0.47, 0.2, 0.06},
+	},
+	"box": {
+		{0.3, 0.3, 0.4, 0.03}, {0.3, 0.67, 0.4, 0.03},
+		{0.3, 0.33, 0.03, 0.1}, {0.67, 0.57, 0.03, 0.1},
+	},
+}
+
+// ArenaObstacles returns the obstacles of the level's arena shape followed by its own, in an arena of the given size
+func (l *Level) ArenaObstacles(bounds fyne.Size) []*Obstacle {
+	var obstacles []*Obstacle
+	for _, block := range arenaShapes[strings.ToLower(l.Arena.Shape)] {
+		obstacles = append(obstacles, NewObstacle(block[0]*bounds.Width, block[1]*bounds.Height,
+			block[2]*bounds.Width, block[3]*bounds.Height, color.RGBA{}))
+	}
+	for _, block := range l.Obstacles {
+		fill, _ := ParseHexColor(block.Color)
+		obstacles = append(obstacles, NewObstacle(block.X, block.Y, block.Width, block.Height, fill))
+	}
+	return obstacles
+}
+
+// backgroundPreset is a star field look a level can pick
+type backgroundPreset struct {
+	warp           int  // warp level
+	nebula         bool // nebula clouds shown
+	constellations bool // constellation overlay shown
+	static         bool // low-power static stars
+}
+
+// backgroundPresets are the star field looks a level can pick, by name
+var backgroundPresets = map[string]backgroundPreset{
+	"deep_space": {},
+	"nebula":     {nebula: true},
+	"warp":       {warp: MaxWarp, nebula: true},
+	"calm":       {nebula: true, constellations: true, static: true},
+}
+
+// ApplyBackground switches the star field to the named background preset (any case); "" leaves it unchanged
+func (sf *StarField) ApplyBackground(name string) {
+	preset, ok := backgroundPresets[strings.ToLower(name)]
+	if !ok {
+		return
+	}
+	sf.SetWarp(preset.warp)
+	sf.Nebula.SetVisible(preset.nebula)
+	sf.Constellations.SetVisible(preset.constellations)
+	sf.SetStatic(preset.static)
+}
+
+// ParseHexColor parses an opaque "#rrggbb" color. An empty string is the zero color (no color chosen).
+func ParseHexColor(hex string) (color.RGBA, error) {
+	if hex == "" {
+		return color.RGBA{}, nil
+	}
+	var c color.RGBA
+	if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &c.R, &c.G, &c.B); err != nil || len(hex) != 7 {
+		return color.RGBA{}, fmt.Errorf("color %q is not #rrggbb", hex)
+	}
+	c.A = 255
+	return c, nil
+}
+
+// FormatHexColor formats a color as "#rrggbb", the form ParseHexColor reads (alpha is dropped)
+func FormatHexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// BallKindByName returns the ball kind with the given display name (any case); "" is a standard ball
+func BallKindByName(name string) (BallKind, bool) {
+	if name == "" {
+		return KindStandard, true
+	}
+	for kind := KindStandard; kind <= KindBoss; kind++ {
+		if strings.EqualFold(kind.String(), name) {
+			return kind, true
+		}
+	}
+	return KindStandard, false
+}
+
+// WallModeByName returns the wall mode with the given display name (any case)
+func WallModeByName(name string) (WallMode, bool) {
+	for mode := WallBounce; mode <= WallAbsorb; mode++ {
+		if strings.EqualFold(mode.String(), name) {
+			return mode, true
+		}
+	}
+	return WallBounce, false
+}
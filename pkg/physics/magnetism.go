@@ -0,0 +1,96 @@
+package physics
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Magnetism tuning
+const (
+	MagneticStrength = float32(300000) // force constant (balls have mass π·r², so this gives gentle pulls)
+	MagneticRange    = float32(250)    // balls further apart than this don't interact
+	maxMagneticSpeed = float32(4.0)    // magnetism never speeds a ball up past this
+)
+
+// randomCharge returns a random charge: +1, -1 or 0 (neutral)
+func randomCharge() float32 {
+	return float32(rand.Intn(3) - 1)
+}
+
+// ApplyMagneticForces applies a pairwise inverse-square force between charged balls.
+// Opposite charges attract and like charges repel. Only the speed the force adds is capped, so a ball
+// already flying faster for another reason isn't slowed down.
+func ApplyMagneticForces(balls []*Ball) {
+	// Sum each ball's pull from every other ball first, then apply it in one step
+	dvx := make([]float32, len(balls))
+	dvy := make([]float32, len(balls))
+	for i := 0; i < len(balls); i++ {
+		a := balls[i]
+		if !a.canFeelMagnetism() {
+			continue
+		}
+
+		for j := i + 1; j < len(balls); j++ {
+			b := balls[j]
+			if !b.canFeelMagnetism() {
+				continue
+			}
+
+			dx := b.X - a.X
+			dy := b.Y - a.Y
+			distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+			if distance == 0 || distance > MagneticRange {
+				continue
+			}
+
+			// Don't let the force blow up when balls overlap
+			effectiveDistance := distance
+			if minDistance := a.Radius + b.Radius; effectiveDistance < minDistance {
+				effectiveDistance = minDistance
+			}
+
+			// Positive force pushes the pair apart (like charges), negative pulls them together
+			force := MagneticStrength * a.Charge * b.Charge / (effectiveDistance * effectiveDistance)
+			nx := dx / distance
+			ny := dy / distance
+
+			// Heavier balls are moved less
+			accelA := force / a.GetMass()
+			accelB := force / b.GetMass()
+			dvx[i] -= nx * accelA
+			dvy[i] -= ny * accelA
+			dvx[j] += nx * accelB
+			dvy[j] += ny * accelB
+		}
+	}
+
+	for i, ball := range balls {
+		if dvx[i] != 0 || dvy[i] != 0 {
+			ball.addVelocity(dvx[i], dvy[i], maxMagneticSpeed)
+		}
+	}
+}
+
+// canFeelMagnetism reports whether the ball takes part in magnetic interactions
+func (b *Ball) canFeelMagnetism() bool {
+	return b.Charge != 0 && b.IsAnimated && b.StunTimer == 0 && !b.IsDestroyed && !b.IsAbsorbed
+}
+
+// ShowCharge adds or removes the charge sign on the ball's label
+func (b *Ball) ShowCharge(show bool) {
+	if b.Text == nil {
+		return
+	}
+
+	label := b.LLMName
+	if show {
+		switch {
+		case b.Charge > 0:
+			label += " (+)"
+		case b.Charge < 0:
+			label += " (−)"
+		}
+	}
+	b.Text.Text = label
+	b.Text.Refresh()
+}
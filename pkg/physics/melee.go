@@ -0,0 +1,129 @@
+package physics
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// Melee tuning
+const (
+	meleeArc         = math.Pi / 3  // half-angle of the swipe around the facing direction (60 degrees)
+	meleeSwingFrames = 12           // frames the arm takes to sweep across the arc
+	meleeJiggle      = float32(0.5) // jiggle on a swiped ball
+	meleeFistScale   = float32(0.3) // size of the swinging fist relative to the human
+	meleeReachScale  = float32(0.7) // distance of the fist from the shoulder relative to the human
+)
+
+// meleeReach returns how far the swipe reaches from the human's center
+func (h *Human) meleeReach() float32 {
+	return h.Size * Tuning().MeleeRangeFactor
+}
+
+// inMeleeArc reports whether a ball is within the swipe's reach and in front of the human
+func (h *Human) inMeleeArc(ball *Ball) bool {
+	dx := ball.X - h.X
+	dy := ball.Y - h.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance-ball.Radius > h.meleeReach() {
+		return false
+	}
+	if distance == 0 {
+		return true
+	}
+
+	// Angle between the facing direction and the ball, folded into [0, pi]
+	offset := math.Abs(math.Atan2(float64(dy), float64(dx)) - h.Rotation)
+	if offset > math.Pi {
+		offset = 2*math.Pi - offset
+	}
+	return offset <= meleeArc
+}
+
+// MeleeReady reports whether the human can swipe this frame
+func (h *Human) MeleeReady() bool {
+	return h.IsActive && !h.IsExploding && !h.HoldFire && h.MeleeTimer == 0
+}
+
+// Melee swings the arm in the facing direction, knocking back every ball in front of the human.
+// It returns the number of balls hit, or -1 if the swipe is still on cooldown.
+func (h *Human) Melee(balls []*Ball) int {
+	if !h.MeleeReady() {
+		return -1
+	}
+	h.MeleeTimer = Tuning().MeleeCooldown
+	h.SwingTimer = meleeSwingFrames
+
+	hits := 0
+	for _, ball := range balls {
+		if !ball.IsAnimated || !h.inMeleeArc(ball) {
+			continue
+		}
+
+		// Knock the ball straight away from the human (along the facing direction if on top of it)
+		dx := ball.X - h.X
+		dy := ball.Y - h.Y
+		distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+		if distance > 0 {
+			dx /= distance
+			dy /= distance
+		} else {
+			dx = float32(math.Cos(h.Rotation))
+			dy = float32(math.Sin(h.Rotation))
+		}
+		ball.VX += dx * Tuning().MeleeKnockback
+		ball.VY += dy * Tuning().MeleeKnockback
+		ball.limitSpeed(Tuning().MeleeMaxSpeed)
+		ball.triggerJiggle(meleeJiggle)
+		hits++
+	}
+	return hits
+}
+
+// updateMelee counts down the swipe cooldown, swipes when the player presses melee (or, under AI
+// control, when a ball comes within reach in front) and animates the swinging arm
+func (h *Human) updateMelee(balls []*Ball) {
+	if h.MeleeTimer > 0 {
+		h.MeleeTimer--
+	}
+
+	if h.MeleeReady() {
+		if h.Control == ControlManual {
+			if h.MeleeHeld {
+				h.Melee(balls)
+			}
+		} else {
+			for _, ball := range balls {
+				if ball.IsAnimated && h.inMeleeArc(ball) {
+					h.Melee(balls)
+					break
+				}
+			}
+		}
+	}
+
+	if h.SwingTimer > 0 {
+		h.SwingTimer--
+		h.updateSwing()
+	}
+}
+
+// updateSwing moves the arm on the facing side along the swipe's arc.
+// UpdatePosition puts the arm back at the human's side every frame, so the swing ends on its own.
+func (h *Human) updateSwing() {
+	arm := h.RightArm
+	if math.Cos(h.Rotation) < 0 {
+		arm = h.LeftArm
+	}
+
+	// Sweep from one edge of the arc to the other
+	progress := 1 - float64(h.SwingTimer)/meleeSwingFrames
+	angle := h.Rotation - meleeArc + 2*meleeArc*progress
+
+	fist := h.Size * meleeFistScale
+	reach := h.Size * meleeReachScale
+	fistX := h.X + float32(math.Cos(angle))*reach
+	fistY := h.Y + float32(math.Sin(angle))*reach
+	arm.Move(fyne.NewPos(fistX-fist/2, fistY-fist/2))
+	arm.Resize(fyne.NewSize(fist, fist))
+}
@@ -0,0 +1,147 @@
+package physics
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// LayerMilkyWay is the name of the galactic band layer
+const LayerMilkyWay = "milkyway"
+
+// Milky Way tuning
+const (
+	milkyWayAngle     = float32(-0.5) // band tilt in radians (rising to the right)
+	milkyWayWidth     = float32(70)   // distance from the band's spine at which the glow has faded to about a third
+	milkyWayBulge     = float32(1.5)  // extra glow at the galactic center
+	milkyWayBulgeSize = float32(220)  // how far along the band the central bulge reaches
+	milkyWayGlowAlpha = float32(45)   // glow opacity along the spine
+	milkyWayDustStars = 1800          // faint stars crowded into the band
+	milkyWayDustMaxA  = 90            // brightest dust star opacity
+	milkyWaySeed      = 1987          // fixed seed, so the band looks the same every time it is drawn
+)
+
+// Milky Way colors
+var (
+	milkyWayGlowColor = color.RGBA{R: 190, G: 200, B: 255, A: 255} // Pale blue glow
+	milkyWayCoreColor = color.RGBA{R: 255, G: 225, B: 180, A: 255} // Warmer light from the bulge
+	milkyWayDustColor = color.RGBA{R: 225, G: 230, B: 255, A: 255} // Faint crowded stars
+)
+
+// MilkyWay is a soft diagonal band of glow and densely packed faint stars through the galactic center,
+// so the backdrop reads as a view across the galaxy. The galaxy is too far away to move with the travel.
+type MilkyWay struct {
+	CenterX, CenterY float32        // galactic center the band passes through
+	Bounds           fyne.Size      // area the band is drawn across
+	Raster           *canvas.Raster // the band, drawn once per size
+	mu               sync.Mutex     // guards the center and cached frame, shared with the render thread
+	frame            *image.RGBA    // cached band
+	frameCenter      fyne.Position  // galactic center the cached band was drawn for
+}
+
+// NewMilkyWay creates a Milky Way band through the galactic center at (centerX, centerY)
+func NewMilkyWay(centerX, centerY float32, bounds fyne.Size) *MilkyWay {
+	m := &MilkyWay{CenterX: centerX, CenterY: centerY, Bounds: bounds}
+	m.Raster = canvas.NewRaster(m.draw)
+	m.Raster.Resize(bounds)
+	return m
+}
+
+// SetCenter moves the band to pass through a new galactic center
+func (m *MilkyWay) SetCenter(x, y float32) {
+	m.mu.Lock()
+	m.CenterX, m.CenterY = x, y
+	m.mu.Unlock()
+	m.Raster.Refresh()
+}
+
+// Update does nothing: the galaxy is too far away for the travel to move it
+func (m *MilkyWay) Update(vx, vy, dirX, dirY float32) {}
+
+// UpdateBounds resizes the band
+func (m *MilkyWay) UpdateBounds(bounds fyne.Size) {
+	m.mu.Lock()
+	m.Bounds = bounds
+	m.mu.Unlock()
+	m.Raster.Resize(bounds)
+	m.Raster.Refresh()
+}
+
+// Visual returns the raster the band is drawn into
+func (m *MilkyWay) Visual() fyne.CanvasObject {
+	return m.Raster
+}
+
+// draw renders the band at w x h pixels, reusing the last frame while the size and center are unchanged
+func (m *MilkyWay) draw(w, h int) image.Image {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if w <= 0 || h <= 0 || m.Bounds.Width <= 0 {
+		return image.NewRGBA(image.Rect(0, 0, 1, 1))
+	}
+	center := fyne.NewPos(m.CenterX, m.CenterY)
+	if m.frame != nil && m.frame.Rect.Dx() == w && m.frame.Rect.Dy() == h && m.frameCenter == center {
+		return m.frame
+	}
+
+	scale := float32(w) / m.Bounds.Width
+	frame := image.NewRGBA(image.Rect(0, 0, w, h))
+	alongX := float32(math.Cos(float64(milkyWayAngle)))
+	alongY := float32(math.Sin(float64(milkyWayAngle)))
+
+	// Glow: brightest along the spine, fading across the band, with a bulge at the galactic center
+	for py := 0; py < h; py++ {
+		for px := 0; px < w; px++ {
+			dx := (float32(px)+0.5)/scale - m.CenterX
+			dy := (float32(py)+0.5)/scale - m.CenterY
+			along := dx*alongX + dy*alongY
+			across := -dx*alongY + dy*alongX
+			bulge := milkyWayBulge * gaussian(along/milkyWayBulgeSize) * gaussian(across/(milkyWayWidth*1.5))
+			glow := gaussian(across/milkyWayWidth) + bulge
+			if glow < 0.01 {
+				continue
+			}
+			tint := mixColor(milkyWayGlowColor, milkyWayCoreColor, bulge/glow)
+			blendPixel(frame, px, py, tint, min(glow*milkyWayGlowAlpha/255, 1))
+		}
+	}
+
+	// Dust: faint stars crowded around the spine, thickest in the bulge
+	random := rand.New(rand.NewSource(milkyWaySeed))
+	length := m.Bounds.Width + m.Bounds.Height
+	for i := 0; i < milkyWayDustStars; i++ {
+		along := (random.Float32()*2 - 1) * length
+		across := float32(random.NormFloat64()) * milkyWayWidth * 0.6 * (1 + gaussian(along/milkyWayBulgeSize))
+		x := (m.CenterX + along*alongX - across*alongY) * scale
+		y := (m.CenterY + along*alongY + across*alongX) * scale
+		if x < 0 || y < 0 || x >= float32(w) || y >= float32(h) {
+			continue
+		}
+		dust := milkyWayDustColor
+		dust.A = uint8(20 + random.Intn(milkyWayDustMaxA-20))
+		blendPixel(frame, int(x), int(y), dust, 1)
+	}
+
+	m.frame = frame
+	m.frameCenter = center
+	return frame
+}
+
+// gaussian is a bell curve: 1 at 0, about 0.37 at ±1
+func gaussian(x float32) float32 {
+	return float32(math.Exp(-float64(x * x)))
+}
+
+// mixColor blends from a to b by t (0 to 1)
+func mixColor(a, b color.RGBA, t float32) color.RGBA {
+	mix := func(a, b uint8) uint8 {
+		return uint8(float32(a) + (float32(b)-float32(a))*t + 0.5)
+	}
+	return color.RGBA{R: mix(a.R, b.R), G: mix(a.G, b.G), B: mix(a.B, b.B), A: mix(a.A, b.A)}
+}
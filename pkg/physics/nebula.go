@@ -0,0 +1,165 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+)
+
+// Nebula tuning
+const (
+	nebulaMinRadius    = float32(90)  // smallest cloud radius
+	nebulaMaxRadius    = float32(220) // largest cloud radius
+	nebulaBlobs        = 3            // overlapping soft blobs per cloud, for an irregular shape
+	nebulaAreaPerCloud = 100000       // square pixels the density is measured against
+)
+
+// Nebula palettes: each cloud picks one and tints its blobs with it
+var nebulaPalettes = [][]color.RGBA{
+	{{R: 120, G: 60, B: 200, A: 60}, {R: 200, G: 80, B: 180, A: 45}}, // Violet and magenta
+	{{R: 40, G: 100, B: 220, A: 55}, {R: 60, G: 180, B: 200, A: 40}}, // Deep blue and teal
+	{{R: 220, G: 90, B: 120, A: 50}, {R: 240, G: 150, B: 90, A: 35}}, // Rose and amber
+	{{R: 60, G: 160, B: 120, A: 45}, {R: 120, G: 90, B: 200, A: 40}}, // Green and purple
+}
+
+// NebulaCloud is a soft, translucent cloud of gas made of a few overlapping radial gradients
+type NebulaCloud struct {
+	X, Y    float32                  // center
+	Radius  float32                  // overall size
+	Blobs   []*canvas.RadialGradient // soft blobs fading to transparent at their edges
+	offsets []fyne.Position          // blob centers relative to the cloud center
+	sizes   []float32                // blob radii
+}
+
+// NebulaLayer is a layer of nebula clouds drifting behind the stars
+type NebulaLayer struct {
+	Clouds    []*NebulaCloud  // clouds in the layer
+	Density   float32         // clouds per 100,000 square pixels
+	Bounds    fyne.Size       // area the clouds drift across
+	IsVisible bool            // whether the layer is shown
+	Container *fyne.Container // holds every cloud's blobs; added to the UI once
+}
+
+// NewNebulaLayer creates a nebula layer with the given density
+func NewNebulaLayer(density float32, bounds fyne.Size) *NebulaLayer {
+	layer := &NebulaLayer{
+		Bounds:    bounds,
+		IsVisible: true,
+		Container: container.NewWithoutLayout(),
+	}
+	layer.Container.Resize(bounds)
+	layer.SetDensity(density)
+	return layer
+}
+
+// SetDensity adds or removes clouds to match the density (clouds per 100,000 square pixels)
+func (l *NebulaLayer) SetDensity(density float32) {
+	if density < 0 {
+		density = 0
+	}
+	l.Density = density
+	l.resize()
+}
+
+// resize adds or removes clouds so the count matches the density for the current bounds
+func (l *NebulaLayer) resize() {
+	target := int(l.Density*l.Bounds.Width*l.Bounds.Height/nebulaAreaPerCloud + 0.5)
+	for len(l.Clouds) < target {
+		cloud := newNebulaCloud()
+		cloud.X = rand.Float32() * l.Bounds.Width
+		cloud.Y = rand.Float32() * l.Bounds.Height
+		cloud.place()
+		l.Clouds = append(l.Clouds, cloud)
+		for _, blob := range cloud.Blobs {
+			l.Container.Add(blob)
+		}
+	}
+	for len(l.Clouds) > target {
+		cloud := l.Clouds[len(l.Clouds)-1]
+		l.Clouds = l.Clouds[:len(l.Clouds)-1]
+		for _, blob := range cloud.Blobs {
+			l.Container.Remove(blob)
+		}
+	}
+}
+
+// newNebulaCloud creates a cloud with a random size, shape and palette
+func newNebulaCloud() *NebulaCloud {
+	cloud := &NebulaCloud{
+		Blobs:   make([]*canvas.RadialGradient, nebulaBlobs),
+		offsets: make([]fyne.Position, nebulaBlobs),
+		sizes:   make([]float32, nebulaBlobs),
+	}
+	for i := range cloud.Blobs {
+		cloud.Blobs[i] = canvas.NewRadialGradient(color.Transparent, color.Transparent)
+	}
+	cloud.reshape()
+	return cloud
+}
+
+// reshape gives the cloud a new random size, shape and palette
+func (c *NebulaCloud) reshape() {
+	c.Radius = nebulaMinRadius + rand.Float32()*(nebulaMaxRadius-nebulaMinRadius)
+	palette := nebulaPalettes[rand.Intn(len(nebulaPalettes))]
+
+	for i, blob := range c.Blobs {
+		angle := rand.Float64() * 2 * math.Pi
+		spread := c.Radius * 0.4 * rand.Float32()
+		c.offsets[i] = fyne.NewPos(float32(math.Cos(angle))*spread, float32(math.Sin(angle))*spread)
+		c.sizes[i] = c.Radius * (0.5 + 0.5*rand.Float32())
+
+		tint := palette[i%len(palette)]
+		blob.StartColor = tint
+		blob.EndColor = color.RGBA{R: tint.R, G: tint.G, B: tint.B, A: 0}
+		blob.Refresh()
+	}
+}
+
+// place moves the cloud's blobs to its position
+func (c *NebulaCloud) place() {
+	for i, blob := range c.Blobs {
+		size := c.sizes[i]
+		blob.Resize(fyne.NewSize(size*2, size*2))
+		blob.Move(fyne.NewPos(c.X+c.offsets[i].X-size, c.Y+c.offsets[i].Y-size))
+	}
+}
+
+// Update drifts the clouds by the layer's streaming velocity (vx, vy), sending clouds that leave the
+// trailing edge back in at the edge the ship is heading toward (dirX, dirY) with a new shape
+func (l *NebulaLayer) Update(vx, vy, dirX, dirY float32) {
+	if !l.IsVisible {
+		return
+	}
+	for _, cloud := range l.Clouds {
+		cloud.X += vx
+		cloud.Y += vy
+
+		if margin := cloud.Radius * 1.5; isOffscreen(cloud.X, cloud.Y, margin, l.Bounds) {
+			cloud.reshape()
+			cloud.X, cloud.Y = leadingEdgePosition(l.Bounds, dirX, dirY, cloud.Radius*1.5)
+		}
+		cloud.place()
+	}
+}
+
+// SetVisible shows or hides the nebula layer
+func (l *NebulaLayer) SetVisible(visible bool) {
+	l.IsVisible = visible
+	setVisible(l.Container, visible)
+}
+
+// UpdateBounds resizes the layer, adding or removing clouds to keep its density
+func (l *NebulaLayer) UpdateBounds(bounds fyne.Size) {
+	l.Bounds = bounds
+	l.Container.Resize(bounds)
+	l.resize()
+}
+
+// Visual returns the container holding the clouds
+func (l *NebulaLayer) Visual() fyne.CanvasObject {
+	return l.Container
+}
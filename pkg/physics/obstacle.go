@@ -0,0 +1,183 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// defaultObstacleColor is the fill of obstacles that don't pick their own
+var defaultObstacleColor = color.RGBA{R: 90, G: 100, B: 130, A: 255}
+
+// Obstacle is a fixed rectangular block in the arena that balls bounce off, placed by a level file
+type Obstacle struct {
+	X, Y          float32           // top-left corner
+	Width, Height float32           // size
+	Rect          *canvas.Rectangle // the block
+}
+
+// NewObstacle creates an obstacle with the given top-left corner, size and fill (zero = default gray-blue)
+func NewObstacle(x, y, width, height float32, fill color.RGBA) *Obstacle {
+	if fill.A == 0 {
+		fill = defaultObstacleColor
+	}
+	rect := canvas.NewRectangle(fill)
+	rect.StrokeColor = color.RGBA{R: fill.R / 2, G: fill.G / 2, B: fill.B / 2, A: 255}
+	rect.StrokeWidth = 2
+	rect.CornerRadius = 4
+	rect.Move(fyne.NewPos(x, y))
+	rect.Resize(fyne.NewSize(width, height))
+	return &Obstacle{X: x, Y: y, Width: width, Height: height, Rect: rect}
+}
+
+// Bounce pushes a ball that overlaps the obstacle back out of it and reflects its velocity off the face it hit.
+// It returns whether the ball hit.
+func (o *Obstacle) Bounce(b *Ball) bool {
+	if b.IsDestroyed || b.IsAbsorbed || b.CarriedBy != nil {
+		return false
+	}
+
+	normalX, normalY, overlap, hit := o.push(b.X, b.Y, b.Radius)
+	if !hit {
+		return false
+	}
+	b.X += normalX * overlap
+	b.Y += normalY * overlap
+
+	// Reflect the velocity if it's still heading into the block
+	approach := b.VX*normalX + b.VY*normalY
+	if approach < 0 {
+		b.VX -= 2 * approach * normalX
+		b.VY -= 2 * approach * normalY
+		b.triggerJiggle(float32(math.Abs(float64(approach))) / 8.0) // Same gentle jiggle as the walls
+	}
+	return true
+}
+
+// push returns the outward normal of the face a circle at (x, y) overlaps and how far along it the circle must
+// move to clear the block, or false if the circle is clear
+func (o *Obstacle) push(x, y, radius float32) (normalX, normalY, overlap float32, hit bool) {
+	// Closest point on the block to the circle's center
+	closestX := min(max(x, o.X), o.X+o.Width)
+	closestY := min(max(y, o.Y), o.Y+o.Height)
+	dx := x - closestX
+	dy := y - closestY
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance >= radius {
+		return 0, 0, 0, false
+	}
+
+	if distance > 0 {
+		return dx / distance, dy / distance, radius - distance, true
+	}
+
+	// The center is inside the block: out through the nearest face
+	normalX, normalY, depth := o.nearestFace(x, y)
+	return normalX, normalY, radius + depth, true
+}
+
+// Blocks reports whether a circle at (x, y) overlaps the obstacle
+func (o *Obstacle) Blocks(x, y, radius float32) bool {
+	_, _, _, hit := o.push(x, y, radius)
+	return hit
+}
+
+// nearestFace returns the outward direction of the face nearest a point inside the block, and how deep
+// inside the point is
+func (o *Obstacle) nearestFace(x, y float32) (normalX, normalY, depth float32) {
+	left := x - o.X
+	right := o.X + o.Width - x
+	top := y - o.Y
+	bottom := o.Y + o.Height - y
+	depth = min(left, right, top, bottom)
+	switch depth {
+	case left:
+		return -1, 0, depth
+	case right:
+		return 1, 0, depth
+	case top:
+		return 0, -1, depth
+	default:
+		return 0, 1, depth
+	}
+}
+
+// BounceBalls bounces every ball off every obstacle
+func BounceBalls(obstacles []*Obstacle, balls []*Ball) {
+	for _, obstacle := range obstacles {
+		for _, ball := range balls {
+			obstacle.Bounce(ball)
+		}
+	}
+}
+
+// Blocked reports whether a circle at (x, y) overlaps any of the obstacles
+func Blocked(obstacles []*Obstacle, x, y, radius float32) bool {
+	for _, obstacle := range obstacles {
+		if obstacle.Blocks(x, y, radius) {
+			return true
+		}
+	}
+	return false
+}
+
+// PushOut moves a circle at (x, y) out of every obstacle it overlaps. It returns the new position and the
+// outward normal of the last face it was pushed from (0, 0 = it was clear).
+func PushOut(obstacles []*Obstacle, x, y, radius float32) (float32, float32, float32, float32) {
+	var pushX, pushY float32
+	for _, obstacle := range obstacles {
+		normalX, normalY, overlap, hit := obstacle.push(x, y, radius)
+		if !hit {
+			continue
+		}
+		x += normalX * overlap
+		y += normalY * overlap
+		pushX, pushY = normalX, normalY
+	}
+	return x, y, pushX, pushY
+}
+
+// Obstacle avoidance tuning
+const (
+	avoidSamples = 4            // points checked along the path ahead
+	avoidTurn    = float32(0.8) // share of the heading turned aside when a block is right ahead
+)
+
+// SteerAround bends a velocity around the obstacles. If keeping it for the next lookahead frames would run a
+// circle of the given radius into a block, it turns aside toward whichever side of the block it is already
+// nearer, harder the closer the block is, keeping the speed. Velocities with a clear path are returned as is.
+func SteerAround(obstacles []*Obstacle, x, y, vx, vy, radius, lookahead float32) (float32, float32) {
+	speed := float32(math.Sqrt(float64(vx*vx + vy*vy)))
+	if speed == 0 || len(obstacles) == 0 {
+		return vx, vy
+	}
+
+	for sample := 1; sample <= avoidSamples; sample++ {
+		ahead := lookahead * float32(sample) / avoidSamples
+		for _, obstacle := range obstacles {
+			if !obstacle.Blocks(x+vx*ahead, y+vy*ahead, radius) {
+				continue
+			}
+
+			// Sidestep away from the block's center, picking a side once the heading points straight at it
+			sideX, sideY := -vy/speed, vx/speed
+			centerX, centerY := obstacle.X+obstacle.Width/2, obstacle.Y+obstacle.Height/2
+			if (x-centerX)*sideX+(y-centerY)*sideY < 0 {
+				sideX, sideY = -sideX, -sideY
+			}
+			turn := avoidTurn * (1 - float32(sample-1)/avoidSamples)
+			steerX := vx/speed*(1-turn) + sideX*turn
+			steerY := vy/speed*(1-turn) + sideY*turn
+			length := float32(math.Sqrt(float64(steerX*steerX + steerY*steerY)))
+			return steerX / length * speed, steerY / length * speed
+		}
+	}
+	return vx, vy
+}
+
+// GetVisualComponents returns the obstacle's block for UI management
+func (o *Obstacle) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{o.Rect}
+}
@@ -0,0 +1,37 @@
+package physics
+
+import "math"
+
+// orientation turns offsets from a figure's layout (drawn facing right, +x forward and -y up) into screen
+// offsets for a figure facing any direction. Facing anywhere leftward mirrors the layout top to bottom
+// before turning it, so the figure stays upright instead of flying upside down.
+type orientation struct {
+	cos, sin float32 // rotation to the facing direction
+	mirrored bool    // flip the layout top to bottom first (facing left)
+}
+
+// newOrientation returns the orientation for a figure facing angle radians (0 = right, π/2 = down)
+func newOrientation(angle float32) orientation {
+	cos := float32(math.Cos(float64(angle)))
+	return orientation{
+		cos:      cos,
+		sin:      float32(math.Sin(float64(angle))),
+		mirrored: cos < 0,
+	}
+}
+
+// newRotation returns a plain rotation by angle radians, with no mirroring (for spins)
+func newRotation(angle float32) orientation {
+	return orientation{
+		cos: float32(math.Cos(float64(angle))),
+		sin: float32(math.Sin(float64(angle))),
+	}
+}
+
+// apply turns a layout offset into a screen offset
+func (o orientation) apply(x, y float32) (float32, float32) {
+	if o.mirrored {
+		y = -y
+	}
+	return x*o.cos - y*o.sin, x*o.sin + y*o.cos
+}
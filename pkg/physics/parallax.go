@@ -0,0 +1,241 @@
+package physics
+
+import (
+	"image/color"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+)
+
+// Built-in background layer names, back to front
+const (
+	LayerNebulae = "nebulae"
+	LayerPlanets = "planets"
+	LayerStars   = "stars"
+	LayerDebris  = "debris"
+)
+
+// Built-in layer speeds, as multiples of the travel speed
+const (
+	nebulaParallax = float32(0.15) // nebulae are far behind the stars
+	planetParallax = float32(0.3)  // planets sit between the nebulae and the stars
+	starParallax   = float32(1.0)  // stars add their own distance-based parallax on top
+	debrisParallax = float32(2.5)  // debris is closer to the ship than any star
+)
+
+// Built-in planets and debris
+const (
+	planetCount     = 2
+	planetMinRadius = float32(10)
+	planetMaxRadius = float32(28)
+	debrisCount     = 8
+	debrisMinRadius = float32(1)
+	debrisMaxRadius = float32(3)
+)
+
+// BackgroundLayer is anything the ship can fly past: it moves by a streaming velocity each frame
+type BackgroundLayer interface {
+	// Update moves the layer by this frame's streaming velocity (vx, vy); (dirX, dirY) is the
+	// direction of travel, where new objects come into view
+	Update(vx, vy, dirX, dirY float32)
+	// UpdateBounds resizes the layer
+	UpdateBounds(bounds fyne.Size)
+	// Visual returns the canvas object the layer draws into
+	Visual() fyne.CanvasObject
+}
+
+// ParallaxLayer is a named layer of the background with its own speed
+type ParallaxLayer struct {
+	Name  string          // unique name, used to find or remove the layer
+	Speed float32         // multiple of the travel speed the layer streams by at
+	Layer BackgroundLayer // what the layer draws
+}
+
+// ParallaxBackground is a stack of named layers, each streaming past the ship at its own speed.
+// Layers can be added and removed at any time to compose custom backdrops.
+type ParallaxBackground struct {
+	Layers    []*ParallaxLayer // back to front
+	Bounds    fyne.Size        // area the layers cover
+	Container *fyne.Container  // holds every layer's visual, back to front; added to the UI once
+}
+
+// NewParallaxBackground creates a background with no layers
+func NewParallaxBackground(bounds fyne.Size) *ParallaxBackground {
+	b := &ParallaxBackground{Bounds: bounds, Container: container.NewWithoutLayout()}
+	b.Container.Resize(bounds)
+	return b
+}
+
+// AddLayer adds a layer in front of the others, or replaces the layer with the same name in place
+func (b *ParallaxBackground) AddLayer(name string, speed float32, layer BackgroundLayer) {
+	layer.UpdateBounds(b.Bounds)
+	added := &ParallaxLayer{Name: name, Speed: speed, Layer: layer}
+
+	for i, existing := range b.Layers {
+		if existing.Name == name {
+			b.Layers[i] = added
+			b.rebuild()
+			return
+		}
+	}
+	b.Layers = append(b.Layers, added)
+	b.Container.Add(layer.Visual())
+}
+
+// RemoveLayer removes the named layer, reporting whether there was one
+func (b *ParallaxBackground) RemoveLayer(name string) bool {
+	for i, layer := range b.Layers {
+		if layer.Name == name {
+			b.Layers = append(b.Layers[:i], b.Layers[i+1:]...)
+			b.Container.Remove(layer.Layer.Visual())
+			return true
+		}
+	}
+	return false
+}
+
+// Layer returns the named layer, or nil
+func (b *ParallaxBackground) Layer(name string) *ParallaxLayer {
+	for _, layer := range b.Layers {
+		if layer.Name == name {
+			return layer
+		}
+	}
+	return nil
+}
+
+// rebuild re-adds every layer's visual in order
+func (b *ParallaxBackground) rebuild() {
+	b.Container.RemoveAll()
+	for _, layer := range b.Layers {
+		b.Container.Add(layer.Layer.Visual())
+	}
+}
+
+// Update streams every layer at its speed for the travel velocity (vx, vy) in the direction of travel (dirX, dirY)
+func (b *ParallaxBackground) Update(vx, vy, dirX, dirY float32) {
+	for _, layer := range b.Layers {
+		layer.Layer.Update(vx*layer.Speed, vy*layer.Speed, dirX, dirY)
+	}
+}
+
+// UpdateBounds resizes every layer
+func (b *ParallaxBackground) UpdateBounds(bounds fyne.Size) {
+	b.Bounds = bounds
+	b.Container.Resize(bounds)
+	for _, layer := range b.Layers {
+		layer.Layer.UpdateBounds(bounds)
+	}
+}
+
+// BackdropObject is a canvas object drifting in an ObjectLayer
+type BackdropObject struct {
+	X, Y   float32           // center
+	Object fyne.CanvasObject // what is drawn
+}
+
+// ObjectLayer is a background layer of arbitrary canvas objects (planets, debris, ...) that drift with the
+// travel and come back in at the leading edge once they leave the screen
+type ObjectLayer struct {
+	Objects   []*BackdropObject // objects in the layer
+	Bounds    fyne.Size         // area the objects drift across
+	Container *fyne.Container   // holds every object
+}
+
+// NewObjectLayer creates an empty object layer
+func NewObjectLayer(bounds fyne.Size) *ObjectLayer {
+	l := &ObjectLayer{Bounds: bounds, Container: container.NewWithoutLayout()}
+	l.Container.Resize(bounds)
+	return l
+}
+
+// Add puts a canvas object in the layer centered at (x, y). The object keeps the size it was given.
+func (l *ObjectLayer) Add(object fyne.CanvasObject, x, y float32) *BackdropObject {
+	backdrop := &BackdropObject{X: x, Y: y, Object: object}
+	backdrop.place()
+	l.Objects = append(l.Objects, backdrop)
+	l.Container.Add(object)
+	return backdrop
+}
+
+// Remove takes an object out of the layer
+func (l *ObjectLayer) Remove(backdrop *BackdropObject) {
+	for i, existing := range l.Objects {
+		if existing == backdrop {
+			l.Objects = append(l.Objects[:i], l.Objects[i+1:]...)
+			l.Container.Remove(backdrop.Object)
+			return
+		}
+	}
+}
+
+// Update drifts every object, sending those that leave the trailing edge back in at the leading edge
+func (l *ObjectLayer) Update(vx, vy, dirX, dirY float32) {
+	for _, backdrop := range l.Objects {
+		backdrop.X += vx
+		backdrop.Y += vy
+
+		size := backdrop.Object.Size()
+		if margin := max(size.Width, size.Height); isOffscreen(backdrop.X, backdrop.Y, margin, l.Bounds) {
+			backdrop.X, backdrop.Y = leadingEdgePosition(l.Bounds, dirX, dirY, margin)
+		}
+		backdrop.place()
+	}
+}
+
+// UpdateBounds resizes the layer
+func (l *ObjectLayer) UpdateBounds(bounds fyne.Size) {
+	l.Bounds = bounds
+	l.Container.Resize(bounds)
+}
+
+// Visual returns the container holding the objects
+func (l *ObjectLayer) Visual() fyne.CanvasObject {
+	return l.Container
+}
+
+// place moves the object so it is centered on its position
+func (b *BackdropObject) place() {
+	size := b.Object.Size()
+	b.Object.Move(fyne.NewPos(b.X-size.Width/2, b.Y-size.Height/2))
+}
+
+// Planet colors: muted, so the planets stay in the background
+var planetColors = []color.RGBA{
+	{R: 170, G: 120, B: 90, A: 200},  // Rusty desert world
+	{R: 90, G: 130, B: 170, A: 200},  // Ice giant
+	{R: 150, G: 150, B: 110, A: 200}, // Gas giant
+	{R: 120, G: 90, B: 140, A: 200},  // Violet moon
+}
+
+// NewPlanetLayer creates the built-in layer of a few distant planets
+func NewPlanetLayer(bounds fyne.Size) *ObjectLayer {
+	l := NewObjectLayer(bounds)
+	for i := 0; i < planetCount; i++ {
+		tint := planetColors[rand.Intn(len(planetColors))]
+		radius := planetMinRadius + rand.Float32()*(planetMaxRadius-planetMinRadius)
+		planet := &canvas.Circle{
+			FillColor:   tint,
+			StrokeColor: color.RGBA{R: tint.R / 2, G: tint.G / 2, B: tint.B / 2, A: tint.A}, // Shadowed rim
+			StrokeWidth: radius * 0.15,
+		}
+		planet.Resize(fyne.NewSize(radius*2, radius*2))
+		l.Add(planet, rand.Float32()*bounds.Width, rand.Float32()*bounds.Height)
+	}
+	return l
+}
+
+// NewDebrisLayer creates the built-in layer of small rocks flying past close to the ship
+func NewDebrisLayer(bounds fyne.Size) *ObjectLayer {
+	l := NewObjectLayer(bounds)
+	for i := 0; i < debrisCount; i++ {
+		shade := uint8(90 + rand.Intn(60))
+		radius := debrisMinRadius + rand.Float32()*(debrisMaxRadius-debrisMinRadius)
+		rock := &canvas.Circle{FillColor: color.RGBA{R: shade, G: shade, B: shade - 10, A: 180}}
+		rock.Resize(fyne.NewSize(radius*2, radius*2))
+		l.Add(rock, rand.Float32()*bounds.Width, rand.Float32()*bounds.Height)
+	}
+	return l
+}
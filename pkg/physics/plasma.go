@@ -0,0 +1,156 @@
+package physics
+
+import (
+	"image/color"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+)
+
+// Plasma orb tuning
+const (
+	plasmaOrbSpeed     = float32(2.5) // slow enough for the human to dodge and the dragon to block
+	plasmaOrbSize      = float32(16)  // drawn diameter
+	plasmaOrbTTL       = 480          // frames before an orb fizzles out (8 seconds)
+	alienFireInterval  = 240          // frames between a hostile alien's shots (plus up to half again at random)
+	alienFireWarmup    = 120          // frames a hostile alien waits after materializing before firing
+	dragonOrbBlockCost = float32(0.5) // energy the dragon spends blocking an orb with its body
+)
+
+// NewPlasmaOrb creates a slow glowing orb fired from (startX, startY) toward the target. Orbs are bullets,
+// so they share the bullets' movement, lifetime and drawing.
+func NewPlasmaOrb(startX, startY, targetX, targetY float32) *Bullet {
+	orb := NewBullet(startX, startY, targetX, targetY)
+	scale := plasmaOrbSpeed / Tuning().BulletSpeed
+	orb.VX *= scale
+	orb.VY *= scale
+	orb.Size = plasmaOrbSize
+	orb.TTL = plasmaOrbTTL
+
+	orb.Eyeball.FillColor = color.RGBA{R: 0, G: 255, B: 120, A: 90}    // Faint green halo
+	orb.Eyeball.StrokeColor = color.RGBA{R: 0, G: 255, B: 150, A: 200} // Alien green rim
+	orb.Eyeball.StrokeWidth = 1.5
+	orb.Iris.FillColor = color.RGBA{R: 120, G: 255, B: 170, A: 220} // Bright plasma
+	orb.Iris.StrokeColor = color.Transparent
+	orb.Pupil.FillColor = color.RGBA{R: 240, G: 255, B: 245, A: 255} // White-hot core
+	orb.Pupil.StrokeColor = color.Transparent
+
+	orb.Eyeball.Resize(fyne.NewSize(orb.Size, orb.Size))
+	orb.Iris.Resize(fyne.NewSize(orb.Size*0.7, orb.Size*0.7))
+	orb.Pupil.Resize(fyne.NewSize(orb.Size*0.35, orb.Size*0.35))
+	orb.place()
+	return orb
+}
+
+// updateHostility moves the alien's orbs and, in hostile mode, fires a new one at the human now and then
+func (a *Alien) updateHostility(ctx *AlienContext) {
+	for i := len(a.Orbs) - 1; i >= 0; i-- {
+		if !a.Orbs[i].IsActive || !a.Orbs[i].advance(a.Bounds) {
+			a.Orbs = append(a.Orbs[:i], a.Orbs[i+1:]...)
+		}
+	}
+
+	// Only a fully materialized alien that isn't leaving fires
+	if !a.Hostile || ctx == nil || !ctx.HumanActive || a.IsDeparting || a.fading != 0 || a.Alpha < alienMaxAlpha {
+		return
+	}
+	a.fireTimer--
+	if a.fireTimer > 0 {
+		return
+	}
+	a.Orbs = append(a.Orbs, NewPlasmaOrb(a.X, a.Y, ctx.HumanX, ctx.HumanY))
+	a.fireTimer = alienFireInterval + rand.Intn(alienFireInterval/2)
+}
+
+// OrbHits reports whether one of the alien's orbs hit the human, using up that orb
+func (a *Alien) OrbHits(human *Human) bool {
+	if !human.IsVulnerable() {
+		return false
+	}
+	for _, orb := range a.Orbs {
+		if !orb.IsActive {
+			continue
+		}
+		dx := human.X - orb.X
+		dy := human.Y - orb.Y
+		reach := human.Size*0.6 + orb.Size/2
+		if dx*dx+dy*dy < reach*reach {
+			orb.remove()
+			return true
+		}
+	}
+	return false
+}
+
+// ClearOrbs removes every orb in flight
+func (a *Alien) ClearOrbs() {
+	for _, orb := range a.Orbs {
+		orb.remove()
+	}
+	a.Orbs = nil
+}
+
+// GetOrbVisuals returns all orb visual objects for UI management
+func (a *Alien) GetOrbVisuals() []fyne.CanvasObject {
+	visuals := make([]fyne.CanvasObject, 0, len(a.Orbs)*3)
+	for _, orb := range a.Orbs {
+		if orb.IsActive {
+			visuals = append(visuals, orb.Eyeball, orb.Iris, orb.Pupil)
+		}
+	}
+	return visuals
+}
+
+// orbThreat returns the orb closest to the human among those headed for it within the dragon's chase radius, or nil
+func (d *Dragon) orbThreat(human *Human) *Bullet {
+	var threat *Bullet
+	closest := d.chaseRadius() * d.chaseRadius()
+	for _, orb := range d.Orbs {
+		if !orb.IsActive {
+			continue
+		}
+		dx := human.X - orb.X
+		dy := human.Y - orb.Y
+		distance := dx*dx + dy*dy
+		if distance <= closest && dx*orb.VX+dy*orb.VY > 0 {
+			closest = distance
+			threat = orb
+		}
+	}
+	return threat
+}
+
+// updateBlocking flies into the path of the orb headed for the human
+func (d *Dragon) updateBlocking(human *Human) {
+	orb := d.orbThreat(human)
+	if orb == nil {
+		d.followHuman(human)
+		return
+	}
+
+	// Meet the orb where it will be, or chase it straight if it can't be caught
+	targetX, targetY := orb.X, orb.Y
+	if t, ok := interceptTime(orb.X-d.X, orb.Y-d.Y, orb.VX, orb.VY, d.interceptSpeed()); ok {
+		targetX += orb.VX * t
+		targetY += orb.VY * t
+	}
+	d.turnToward(targetX-d.X, targetY-d.Y)
+	d.flyToward(targetX, targetY, d.interceptSpeed())
+	d.spendEnergy(dragonInterceptCost)
+}
+
+// absorbOrbs destroys any orb that touches the dragon's body
+func (d *Dragon) absorbOrbs() {
+	for _, orb := range d.Orbs {
+		if !orb.IsActive {
+			continue
+		}
+		dx := orb.X - d.X
+		dy := orb.Y - d.Y
+		reach := d.Size*0.4 + orb.Size/2
+		if dx*dx+dy*dy < reach*reach {
+			orb.remove()
+			d.spendEnergy(dragonOrbBlockCost)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package physics
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+)
+
+// GetVisualComponents returns the human's motion ghosts, figure, firing circle, lock-on reticle, shield and respawn ring
+// (the shadow goes on the shadow layer and bullets are managed separately)
+func (h *Human) GetVisualComponents() []fyne.CanvasObject {
+	components := h.Ghosts.GetVisualComponents() // Trailing behind the human
+	components = append(components,
+		h.FiringCircle, // Behind the human
+		h.Head,
+		h.Body,
+		h.LeftEye,
+		h.RightEye,
+		h.LeftPupil,
+		h.RightPupil,
+		h.LeftArm,
+		h.RightArm,
+		h.LeftLeg,
+		h.RightLeg,
+		h.FiringEye,
+		h.FiringIris,
+		h.FiringPupil,
+	)
+	components = append(components, h.Reticle.GetVisualComponents()...)
+	return append(components, h.GetRespawnVisuals()...)
+}
+
+// SetPlayerColor outlines the human's figure in a player color so co-op players can tell themselves apart
+func (h *Human) SetPlayerColor(outline color.RGBA) {
+	h.Head.StrokeColor = outline
+	h.Body.StrokeColor = outline
+	h.Head.Refresh()
+	h.Body.Refresh()
+}
+
+// Reset puts the human back at (x, y) alive, with a fresh score, stamina and level
+func (h *Human) Reset(x, y float32) {
+	h.Dismount()
+	h.X = x
+	h.Y = y
+	h.IsExploding = false
+	h.IsActive = true
+	h.RespawnTimer = 0
+	h.Score = 0
+	h.Deaths = 0
+	h.Rotation = 0 // Reset rotation
+	h.ShieldTimer = 0
+	h.Shield.Hide()
+	h.RestoreStamina()
+	h.ResetProgress()
+	h.RespawnGame.Stop()
+	h.ReleaseLock()
+
+	// Show human components
+	h.Head.Show()
+	h.Body.Show()
+	h.LeftEye.Show()
+	h.RightEye.Show()
+	h.LeftPupil.Show()
+	h.RightPupil.Show()
+	h.LeftArm.Show()
+	h.RightArm.Show()
+	h.LeftLeg.Show()
+	h.RightLeg.Show()
+	h.FiringCircle.Show()
+	h.FiringEye.Show()
+	h.FiringIris.Show()
+	h.FiringPupil.Show()
+	h.Shadow.Show()
+	h.UpdatePosition()
+}
+
+// NearestHuman returns the closest active human to (x, y), or nil if every human is dead
+func NearestHuman(humans []*Human, x, y float32) *Human {
+	var nearest *Human
+	closest := float32(0)
+	for _, human := range humans {
+		if human == nil || !human.IsActive {
+			continue
+		}
+		dx := human.X - x
+		dy := human.Y - y
+		if distance := dx*dx + dy*dy; nearest == nil || distance < closest {
+			nearest = human
+			closest = distance
+		}
+	}
+	return nearest
+}
@@ -0,0 +1,181 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Cloning pickup tuning
+const (
+	cloningPickupRadius   = float32(14)
+	cloningPickupLifetime = 600 // frames a pickup waits to be touched before vanishing (10 seconds)
+)
+
+// CloningPickup is a power-up that duplicates the first ball to touch it
+type CloningPickup struct {
+	X, Y     float32        // center position
+	Radius   float32        // touch radius
+	IsActive bool           // whether the pickup is on screen
+	Timer    int            // frames left before the pickup vanishes
+	Ring     *canvas.Circle // Pulsing outer ring
+	Core     *canvas.Circle // Glowing center
+	Label    *canvas.Text   // "×2" marker
+	phase    float32        // pulse animation phase
+}
+
+// NewCloningPickup creates an inactive cloning pickup
+func NewCloningPickup() *CloningPickup {
+	p := &CloningPickup{
+		Radius: cloningPickupRadius,
+		Ring: &canvas.Circle{
+			StrokeColor: color.RGBA{R: 255, G: 120, B: 255, A: 255}, // Magenta
+			StrokeWidth: 2,
+		},
+		Core: &canvas.Circle{
+			FillColor: color.RGBA{R: 255, G: 180, B: 255, A: 200},
+		},
+		Label: &canvas.Text{
+			Text:      "×2",
+			Color:     color.RGBA{R: 80, G: 0, B: 80, A: 255},
+			TextSize:  11,
+			TextStyle: fyne.TextStyle{Bold: true},
+			Alignment: fyne.TextAlignCenter,
+		},
+	}
+	p.Hide()
+	return p
+}
+
+// Spawn places the pickup at (x, y) and starts its lifetime
+func (p *CloningPickup) Spawn(x, y float32) {
+	p.X = x
+	p.Y = y
+	p.IsActive = true
+	p.Timer = cloningPickupLifetime
+	p.phase = 0
+	p.UpdatePosition()
+	p.Ring.Show()
+	p.Core.Show()
+	p.Label.Show()
+}
+
+// Update pulses the pickup and returns the ball that touched it, if any (the pickup is used up)
+func (p *CloningPickup) Update(balls []*Ball) *Ball {
+	if !p.IsActive {
+		return nil
+	}
+
+	p.Timer--
+	if p.Timer <= 0 {
+		p.Hide()
+		return nil
+	}
+
+	for _, ball := range balls {
+		if ball.IsDestroyed || ball.IsAbsorbed || !ball.IsAnimated {
+			continue
+		}
+		dx := ball.X - p.X
+		dy := ball.Y - p.Y
+		touchDistance := ball.Radius + p.Radius
+		if dx*dx+dy*dy < touchDistance*touchDistance {
+			p.Hide()
+			return ball
+		}
+	}
+
+	p.phase += 0.1
+	p.UpdatePosition()
+	return nil
+}
+
+// UpdatePosition redraws the pickup with its pulse
+func (p *CloningPickup) UpdatePosition() {
+	pulse := float32(math.Sin(float64(p.phase))) * 3
+	ringRadius := p.Radius + pulse
+	p.Ring.Resize(fyne.NewSize(ringRadius*2, ringRadius*2))
+	p.Ring.Move(fyne.NewPos(p.X-ringRadius, p.Y-ringRadius))
+
+	coreRadius := p.Radius * 0.75
+	p.Core.Resize(fyne.NewSize(coreRadius*2, coreRadius*2))
+	p.Core.Move(fyne.NewPos(p.X-coreRadius, p.Y-coreRadius))
+
+	labelSize := p.Label.MinSize()
+	p.Label.Resize(labelSize)
+	p.Label.Move(fyne.NewPos(p.X-labelSize.Width/2, p.Y-labelSize.Height/2))
+
+	// Blink during the last two seconds
+	if p.Timer < 120 && p.Timer%20 < 10 {
+		p.Ring.Hide()
+	} else {
+		p.Ring.Show()
+	}
+
+	p.Ring.Refresh()
+	p.Core.Refresh()
+	p.Label.Refresh()
+}
+
+// Hide removes the pickup from play
+func (p *CloningPickup) Hide() {
+	p.IsActive = false
+	p.Ring.Hide()
+	p.Core.Hide()
+	p.Label.Hide()
+}
+
+// GetVisualComponents returns the pickup's visual components
+func (p *CloningPickup) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{p.Ring, p.Core, p.Label}
+}
+
+// Clone returns a copy of the ball moving with mirrored velocity, placed just behind it
+// so the two fly apart. The clone still has to be added to the game area.
+func (b *Ball) Clone() *Ball {
+	fill, _ := b.irisColor().(color.RGBA)
+	stroke := b.Iris.StrokeColor
+	if b.isHeatMapped {
+		stroke = b.savedIrisStroke
+	}
+	strokeRGBA, _ := stroke.(color.RGBA)
+
+	// Start the clone one diameter back along the ball's path
+	offsetX, offsetY := float32(0), -2*b.Radius
+	if speed := float32(math.Sqrt(float64(b.VX*b.VX + b.VY*b.VY))); speed > 0 {
+		offsetX = -b.VX / speed * 2 * b.Radius
+		offsetY = -b.VY / speed * 2 * b.Radius
+	}
+	x := clampCoordinate(b.X+offsetX, b.Radius, b.Bounds.Width-b.Radius)
+	y := clampCoordinate(b.Y+offsetY, b.Radius, b.Bounds.Height-b.Radius)
+
+	clone := NewCustomBall(x, y, -b.VX, -b.VY, b.FullRadius, fill, strokeRGBA)
+	clone.Bounds = b.Bounds
+	clone.World = b.World
+	clone.IsAnimated = b.IsAnimated
+	clone.Frozen = b.Frozen
+	clone.Charge = b.Charge
+	clone.SetName(b.LLMName)
+	if b.Kind != KindStandard {
+		clone.SetKind(b.Kind)
+	}
+	if clone.OriginalRadius != b.OriginalRadius {
+		clone.setRadius(b.OriginalRadius) // Same current size (e.g. while regrowing)
+	}
+	clone.HP = b.HP
+	clone.MaxHP = b.MaxHP
+	return clone
+}
+
+// clampCoordinate limits a coordinate to [min, max]
+func clampCoordinate(value, min, max float32) float32 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
@@ -0,0 +1,160 @@
+package physics
+
+import "fyne.io/fyne/v2"
+
+// Upgrade is a permanent boost the player picks on leveling up
+type Upgrade int
+
+// Upgrades
+const (
+	UpgradeCooldown     Upgrade = iota // shoot faster
+	UpgradeFiringRadius                // bullets leave from a bigger firing circle
+	UpgradeExtraHP                     // survive one more hit
+)
+
+// Leveling tuning
+const (
+	xpPerHit           = 1             // XP for each bullet that hits a ball
+	xpPerKill          = 5             // XP for destroying a regular ball (scaled by its score value)
+	xpPerLevel         = 25            // XP needed for level 2; each level needs this much more than the last
+	cooldownUpgrade    = float32(0.85) // each cooldown upgrade cuts the time between shots by 15%
+	firingRadiusGrowth = float32(0.2)  // each firing radius upgrade grows the circle by 20% of its base size
+	hitShieldTime      = 90            // frames of invulnerability after surviving a hit
+	firingRadiusScale  = float32(1.5)  // base firing circle radius as a multiple of the human's size
+)
+
+// Upgrades lists every upgrade in the order they are offered
+var Upgrades = []Upgrade{UpgradeCooldown, UpgradeFiringRadius, UpgradeExtraHP}
+
+// String returns the upgrade's display name
+func (u Upgrade) String() string {
+	switch u {
+	case UpgradeCooldown:
+		return "⚡ Faster Cooldown"
+	case UpgradeFiringRadius:
+		return "🎯 Bigger Firing Radius"
+	case UpgradeExtraHP:
+		return "❤️ Extra HP"
+	default:
+		return "Unknown"
+	}
+}
+
+// Description returns what one more pick of the upgrade does
+func (u Upgrade) Description() string {
+	switch u {
+	case UpgradeCooldown:
+		return "15% less time between shots"
+	case UpgradeFiringRadius:
+		return "Bullets leave from a 20% bigger circle"
+	case UpgradeExtraHP:
+		return "Survive one more hit per life"
+	default:
+		return ""
+	}
+}
+
+// PlayerProgress tracks the human's experience, level and chosen upgrades
+type PlayerProgress struct {
+	XP            int             // experience earned toward the next level
+	Level         int             // current level, starting at 1
+	PendingLevels int             // level-ups still waiting for an upgrade choice
+	Upgrades      map[Upgrade]int // times each upgrade was picked
+}
+
+// NewPlayerProgress creates level 1 progress with no upgrades
+func NewPlayerProgress() *PlayerProgress {
+	return &PlayerProgress{
+		Level:    1,
+		Upgrades: make(map[Upgrade]int),
+	}
+}
+
+// XPForNextLevel returns the XP needed to reach the next level
+func (p *PlayerProgress) XPForNextLevel() int {
+	return xpPerLevel * p.Level
+}
+
+// AddXP adds experience, leveling up as many times as it covers.
+// It returns true if at least one level was gained.
+func (p *PlayerProgress) AddXP(xp int) bool {
+	p.XP += xp
+
+	leveled := false
+	for p.XP >= p.XPForNextLevel() {
+		p.XP -= p.XPForNextLevel()
+		p.Level++
+		p.PendingLevels++
+		leveled = true
+	}
+	return leveled
+}
+
+// awardHit grants XP for a bullet hit, plus the kill bonus if the ball was destroyed
+func (h *Human) awardHit(ball *Ball, destroyed bool) {
+	xp := xpPerHit
+	if destroyed {
+		xp += xpPerKill * ball.ScoreValue() / BallScoreValue
+	}
+	h.Progress.AddXP(xp)
+}
+
+// ApplyUpgrade spends one pending level-up on the given upgrade
+func (h *Human) ApplyUpgrade(upgrade Upgrade) {
+	if h.Progress.PendingLevels <= 0 {
+		return
+	}
+	h.Progress.PendingLevels--
+	h.Progress.Upgrades[upgrade]++
+
+	switch upgrade {
+	case UpgradeFiringRadius:
+		h.applyFiringRadius()
+	case UpgradeExtraHP:
+		h.MaxHP++
+		h.HP++
+	}
+}
+
+// cooldownScale returns how much of each weapon's cooldown remains after cooldown upgrades
+func (h *Human) cooldownScale() float32 {
+	scale := float32(1)
+	for i := 0; i < h.Progress.Upgrades[UpgradeCooldown]; i++ {
+		scale *= cooldownUpgrade
+	}
+	return scale
+}
+
+// applyFiringRadius resizes the firing circle for the picked firing radius upgrades
+func (h *Human) applyFiringRadius() {
+	growth := 1 + firingRadiusGrowth*float32(h.Progress.Upgrades[UpgradeFiringRadius])
+	h.FiringRadius = h.Size * firingRadiusScale * growth
+	h.FiringCircle.Resize(fyne.NewSize(h.FiringRadius*2, h.FiringRadius*2))
+	h.FiringCircle.Move(fyne.NewPos(h.X-h.FiringRadius, h.Y-h.FiringRadius))
+}
+
+// TakeHit takes one HP for a deadly hit. It returns true if that was the last HP and the human should explode;
+// otherwise the human gets a brief shield to escape.
+func (h *Human) TakeHit() bool {
+	if h.HP > 1 {
+		h.HP--
+		h.ShieldTimer = hitShieldTime
+		h.updateShieldPosition()
+		h.Shield.Show()
+		return false
+	}
+	return true
+}
+
+// LowHealth reports whether the next deadly hit will be the human's last, once upgrades have given it more than one HP
+func (h *Human) LowHealth() bool {
+	return h.IsActive && h.HP == 1 && h.MaxHP > 1
+}
+
+// ResetProgress drops back to level 1 and removes every upgrade
+func (h *Human) ResetProgress() {
+	h.Progress = NewPlayerProgress()
+	h.MaxHP = CurrentDifficulty.Config().HumanHP
+	h.HP = h.MaxHP
+	h.applyFiringRadius()
+}
@@ -0,0 +1,79 @@
+package physics
+
+import "math/rand"
+
+// RandomEvent identifies a random world event
+type RandomEvent int
+
+// Random events
+const (
+	RandomEventNone       RandomEvent = iota // nothing starts this frame
+	RandomEventSpaceStorm                    // lightning bolts strike down from the top of the screen
+)
+
+// Random event scheduling
+const (
+	randomEventMinInterval = 1500 // fewest frames between one event ending and the next starting (25 seconds)
+	randomEventMaxInterval = 3000 // most frames between events (50 seconds)
+)
+
+// randomEventWeights is how likely each event is to be picked when one is due
+var randomEventWeights = []struct {
+	Event  RandomEvent
+	Weight int
+}{
+	{RandomEventSpaceStorm, 1},
+}
+
+// RandomEvents schedules the random world events, one at a time, at random intervals
+type RandomEvents struct {
+	Enabled bool // false never starts an event
+	timer   int  // frames until the next event
+}
+
+// NewRandomEvents creates an enabled schedule with its first event a random interval away
+func NewRandomEvents() *RandomEvents {
+	r := &RandomEvents{Enabled: true}
+	r.Reset()
+	return r
+}
+
+// Update counts down to the next event and returns it on the frame it starts (RandomEventNone otherwise).
+// The countdown waits while an event is still running.
+func (r *RandomEvents) Update(running bool) RandomEvent {
+	if !r.Enabled || running {
+		return RandomEventNone
+	}
+
+	r.timer--
+	if r.timer > 0 {
+		return RandomEventNone
+	}
+	r.Reset()
+	return pickRandomEvent()
+}
+
+// Reset puts the next event a fresh random interval away
+func (r *RandomEvents) Reset() {
+	r.timer = randomEventMinInterval + rand.Intn(randomEventMaxInterval-randomEventMinInterval+1)
+}
+
+// pickRandomEvent picks an event by weight
+func pickRandomEvent() RandomEvent {
+	total := 0
+	for _, entry := range randomEventWeights {
+		total += entry.Weight
+	}
+	if total <= 0 {
+		return RandomEventNone
+	}
+
+	pick := rand.Intn(total)
+	for _, entry := range randomEventWeights {
+		if pick < entry.Weight {
+			return entry.Event
+		}
+		pick -= entry.Weight
+	}
+	return RandomEventNone
+}
@@ -0,0 +1,219 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Respawn minigame tuning
+const (
+	respawnMarkerSpeed = float32(0.09) // radians per frame (~1.2 seconds per lap)
+	respawnZoneWidth   = float32(0.8)  // angular width of the highlighted zone in radians
+	respawnZoneDots    = 5             // dots used to draw the highlighted zone
+	respawnShieldTime  = 120           // frames of shield after a successful early respawn
+)
+
+// RespawnMinigame is the timing challenge shown while the human is dead: a marker
+// sweeps around the firing circle and pressing the respawn key inside the
+// highlighted zone brings the human back early with a shield
+type RespawnMinigame struct {
+	CenterX, CenterY float32 // center of the ring (where the human died)
+	Radius           float32 // ring radius (matches the firing circle)
+	MarkerAngle      float32 // current marker angle in radians
+	ZoneAngle        float32 // center angle of the highlighted zone
+	AttemptUsed      bool    // only one press per death
+	Succeeded        bool    // whether the press landed in the zone
+	IsActive         bool    // whether the minigame is running
+	// Visual components
+	Ring   *canvas.Circle   // Faint ring the marker travels along
+	Zone   []*canvas.Circle // Dots marking the highlighted zone
+	Marker *canvas.Circle   // Sweeping marker
+}
+
+// NewRespawnMinigame creates a hidden respawn minigame for a ring of the given radius
+func NewRespawnMinigame(radius float32) *RespawnMinigame {
+	game := &RespawnMinigame{Radius: radius}
+
+	game.Ring = &canvas.Circle{
+		FillColor:   color.RGBA{R: 0, G: 0, B: 0, A: 0},
+		StrokeColor: color.RGBA{R: 0, G: 150, B: 255, A: 90}, // Faint blue ring
+		StrokeWidth: 2.0,
+	}
+	game.Ring.Resize(fyne.NewSize(radius*2, radius*2))
+
+	game.Zone = make([]*canvas.Circle, respawnZoneDots)
+	for i := range game.Zone {
+		dot := &canvas.Circle{
+			FillColor: color.RGBA{R: 0, G: 255, B: 120, A: 200}, // Green target zone
+		}
+		dot.Resize(fyne.NewSize(8, 8))
+		game.Zone[i] = dot
+	}
+
+	game.Marker = &canvas.Circle{
+		FillColor:   color.RGBA{R: 255, G: 255, B: 255, A: 255}, // White marker
+		StrokeColor: color.RGBA{R: 0, G: 150, B: 255, A: 255},
+		StrokeWidth: 2.0,
+	}
+	game.Marker.Resize(fyne.NewSize(12, 12))
+
+	game.Hide()
+
+	return game
+}
+
+// Start begins the minigame around the given point with a random target zone
+func (g *RespawnMinigame) Start(x, y float32) {
+	g.CenterX = x
+	g.CenterY = y
+	g.MarkerAngle = 0
+	g.ZoneAngle = rand.Float32() * 2 * math.Pi
+	g.AttemptUsed = false
+	g.Succeeded = false
+	g.IsActive = true
+
+	g.Ring.Move(fyne.NewPos(x-g.Radius, y-g.Radius))
+	for i, dot := range g.Zone {
+		// Spread the dots evenly across the zone width
+		t := float32(i)/float32(len(g.Zone)-1) - 0.5
+		angle := float64(g.ZoneAngle + t*respawnZoneWidth)
+		dotX := x + float32(math.Cos(angle))*g.Radius
+		dotY := y + float32(math.Sin(angle))*g.Radius
+		dot.FillColor = color.RGBA{R: 0, G: 255, B: 120, A: 200}
+		dot.Move(fyne.NewPos(dotX-4, dotY-4))
+		dot.Refresh()
+	}
+
+	g.Show()
+	g.updateMarker()
+}
+
+// Update advances the sweeping marker
+func (g *RespawnMinigame) Update() {
+	if !g.IsActive {
+		return
+	}
+	g.MarkerAngle += respawnMarkerSpeed
+	if g.MarkerAngle > 2*math.Pi {
+		g.MarkerAngle -= 2 * math.Pi
+	}
+	g.updateMarker()
+}
+
+// updateMarker moves the marker to its current angle on the ring
+func (g *RespawnMinigame) updateMarker() {
+	markerX := g.CenterX + float32(math.Cos(float64(g.MarkerAngle)))*g.Radius
+	markerY := g.CenterY + float32(math.Sin(float64(g.MarkerAngle)))*g.Radius
+	g.Marker.Move(fyne.NewPos(markerX-6, markerY-6))
+}
+
+// Press attempts the timing check; returns true if the marker is inside the zone
+func (g *RespawnMinigame) Press() bool {
+	if !g.IsActive || g.AttemptUsed {
+		return false
+	}
+	g.AttemptUsed = true
+
+	// Smallest angle between marker and zone center
+	diff := float64(g.MarkerAngle - g.ZoneAngle)
+	diff = math.Mod(diff+3*math.Pi, 2*math.Pi) - math.Pi
+	g.Succeeded = math.Abs(diff) <= float64(respawnZoneWidth/2)
+
+	// Color the zone to show the result of the attempt
+	zoneColor := color.RGBA{R: 255, G: 60, B: 60, A: 200} // Red miss
+	if g.Succeeded {
+		zoneColor = color.RGBA{R: 255, G: 255, B: 255, A: 255} // White flash on hit
+	}
+	for _, dot := range g.Zone {
+		dot.FillColor = zoneColor
+		dot.Refresh()
+	}
+
+	return g.Succeeded
+}
+
+// Stop ends the minigame and hides it
+func (g *RespawnMinigame) Stop() {
+	g.IsActive = false
+	g.Hide()
+}
+
+// Show shows the minigame components
+func (g *RespawnMinigame) Show() {
+	g.Ring.Show()
+	for _, dot := range g.Zone {
+		dot.Show()
+	}
+	g.Marker.Show()
+}
+
+// Hide hides the minigame components
+func (g *RespawnMinigame) Hide() {
+	g.Ring.Hide()
+	for _, dot := range g.Zone {
+		dot.Hide()
+	}
+	g.Marker.Hide()
+}
+
+// GetVisualComponents returns the minigame's visual components for UI management
+func (g *RespawnMinigame) GetVisualComponents() []fyne.CanvasObject {
+	components := []fyne.CanvasObject{g.Ring}
+	for _, dot := range g.Zone {
+		components = append(components, dot)
+	}
+	return append(components, g.Marker)
+}
+
+// PressRespawn is called when the player hits the respawn key while dead.
+// Hitting the zone respawns the human on the next frame with a shield; a miss keeps the full timer.
+func (h *Human) PressRespawn() bool {
+	if !h.IsExploding || h.RespawnGame == nil {
+		return false
+	}
+	if !h.RespawnGame.Press() {
+		return false
+	}
+
+	h.earnedShield = true
+	h.RespawnTimer = 1 // Respawn on the next explosion update
+	return true
+}
+
+// IsVulnerable reports whether the human can currently be killed
+func (h *Human) IsVulnerable() bool {
+	return h.IsActive && !h.IsExploding && h.ShieldTimer == 0
+}
+
+// updateShield counts down the respawn shield and keeps its bubble on the human
+func (h *Human) updateShield() {
+	if h.ShieldTimer <= 0 {
+		h.Shield.Hide()
+		return
+	}
+	h.ShieldTimer--
+	h.updateShieldPosition()
+
+	// Blink during the last half second so the player knows it's ending
+	if h.ShieldTimer < 30 && (h.ShieldTimer/5)%2 == 0 {
+		h.Shield.Hide()
+	} else {
+		h.Shield.Show()
+	}
+}
+
+// updateShieldPosition keeps the shield bubble centered on the human
+func (h *Human) updateShieldPosition() {
+	shieldRadius := h.Size * 0.9
+	h.Shield.Resize(fyne.NewSize(shieldRadius*2, shieldRadius*2))
+	h.Shield.Move(fyne.NewPos(h.X-shieldRadius, h.Y-shieldRadius))
+}
+
+// GetRespawnVisuals returns the shield and respawn minigame components for UI management
+func (h *Human) GetRespawnVisuals() []fyne.CanvasObject {
+	return append([]fyne.CanvasObject{h.Shield}, h.RespawnGame.GetVisualComponents()...)
+}
@@ -0,0 +1,139 @@
+package physics
+
+import "math"
+
+// Dragon rider tuning
+const (
+	mountRangeScale   = float32(1.2) // the human can mount when within 1.2x the combined sizes of human and dragon
+	riddenSpeedScale  = float32(1.5) // a ridden dragon flies this much faster than its escort speed
+	riderSeatHeight   = float32(0.5) // rider sits this far above the dragon's center, relative to the dragon size
+	dragonMaxHP       = 10           // chip damage a ridden dragon can take before bucking its rider off
+	dragonChipDamage  = 1            // HP a ridden dragon loses per hit on its rider
+	dragonChipShield  = 45           // frames after a chip hit before the dragon can be chipped again
+	dragonRegenFrames = 120          // frames per HP regained while nobody rides the dragon (2 seconds)
+	dragonRemountHP   = dragonMaxHP / 2
+)
+
+// CanMount reports whether the human is close enough to climb onto the dragon and the dragon is fit to carry it
+func (h *Human) CanMount(d *Dragon) bool {
+	if d == nil || !d.IsActive || d.Rival || d.Rider != nil || d.HP < dragonRemountHP {
+		return false
+	}
+	if !h.IsActive || h.IsExploding || h.Mount != nil {
+		return false
+	}
+
+	dx := d.X - h.X
+	dy := d.Y - h.Y
+	reach := (d.Size + h.Size) * mountRangeScale
+	return dx*dx+dy*dy <= reach*reach
+}
+
+// MountDragon climbs onto the dragon. It returns false if the human can't mount it right now.
+func (h *Human) MountDragon(d *Dragon) bool {
+	if !h.CanMount(d) {
+		return false
+	}
+	h.Mount = d
+	d.Rider = h
+	d.SetState(DragonRidden)
+	d.carryRider()
+	return true
+}
+
+// Dismount climbs off the dragon, landing at the dragon's position
+func (h *Human) Dismount() {
+	d := h.Mount
+	if d == nil {
+		return
+	}
+	h.Mount = nil
+	d.Rider = nil
+
+	h.X = d.X
+	h.Y = d.Y
+	h.keepWithinBounds()
+	h.UpdatePosition()
+}
+
+// ToggleMount mounts the dragon if the human is next to it, or dismounts if already riding
+func (h *Human) ToggleMount(d *Dragon) bool {
+	if h.Mount != nil {
+		h.Dismount()
+		return true
+	}
+	return h.MountDragon(d)
+}
+
+// IsRiding reports whether the human is riding the dragon
+func (h *Human) IsRiding() bool {
+	return h.Mount != nil
+}
+
+// Steer passes the rider's movement to the dragon. x and y are the rider's movement as a fraction
+// of its walking speed (more than 1 while sprinting).
+func (d *Dragon) Steer(x, y float32) {
+	d.rideX = x
+	d.rideY = y
+}
+
+// TakeChipDamage absorbs a hit aimed at the rider. A dragon out of HP bucks its rider off,
+// leaving the rider behind a brief shield. It returns true if the rider was thrown off.
+func (d *Dragon) TakeChipDamage() bool {
+	if d.Rider == nil || d.chipTimer > 0 {
+		return false
+	}
+	d.chipTimer = dragonChipShield
+	d.HP -= dragonChipDamage
+	if d.HP > 0 {
+		return false
+	}
+
+	d.HP = 0
+	rider := d.Rider
+	rider.Dismount()
+	rider.ShieldTimer = hitShieldTime
+	rider.updateShieldPosition()
+	rider.Shield.Show()
+	return true
+}
+
+// updateRidden flies the dragon where its rider steers it. Collisions still deflect balls,
+// but the dragon doesn't drift or spin off course while carrying someone (see HandleBallCollision).
+func (d *Dragon) updateRidden() {
+	d.VX = d.rideX * d.Speed * riddenSpeedScale
+	d.VY = d.rideY * d.Speed * riddenSpeedScale
+
+	// Lean into the direction of flight
+	if d.VX != 0 || d.VY != 0 {
+		d.TargetAngle = float32(math.Atan2(float64(d.VY), float64(d.VX)))
+		angleDiff := float32(math.Remainder(float64(d.TargetAngle-d.InterceptAngle), 2*math.Pi))
+		d.InterceptAngle += angleDiff * 0.15
+	}
+}
+
+// updateHealth counts down the chip shield and slowly heals the dragon while nobody rides it
+func (d *Dragon) updateHealth() {
+	if d.chipTimer > 0 {
+		d.chipTimer--
+	}
+	if d.Rider != nil || d.HP >= d.MaxHP {
+		d.regenTimer = 0
+		return
+	}
+	d.regenTimer++
+	if d.regenTimer >= dragonRegenFrames {
+		d.regenTimer = 0
+		d.HP++
+	}
+}
+
+// carryRider keeps the rider seated on the dragon's back
+func (d *Dragon) carryRider() {
+	if d.Rider == nil {
+		return
+	}
+	d.Rider.X = d.X
+	d.Rider.Y = d.Y - d.Size*riderSeatHeight
+	d.Rider.UpdatePosition()
+}
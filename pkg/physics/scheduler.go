@@ -0,0 +1,46 @@
+package physics
+
+// AI tasks that can be spread across different physics frames
+const (
+	AITaskHumanAvoidance = iota // human ball-avoidance prediction
+	AITaskDragonThreats         // dragon threat scanning
+	AITaskRespawnSearch         // safest respawn location grid search
+	AITaskRivalHerding          // rival dragon picking a ball to herd
+)
+
+// AIScheduler decides which physics frames run the expensive AI computations.
+// Physics still runs every frame; AI results are cached between AI ticks.
+type AIScheduler struct {
+	Interval int // physics frames per AI tick (1 = every frame)
+	frame    int // physics frames seen so far
+}
+
+// NewAIScheduler creates a scheduler that runs AI every interval physics frames
+func NewAIScheduler(interval int) *AIScheduler {
+	s := &AIScheduler{}
+	s.SetInterval(interval)
+	return s
+}
+
+// SetInterval changes how many physics frames pass between AI ticks
+func (s *AIScheduler) SetInterval(interval int) {
+	if interval < 1 {
+		interval = 1
+	}
+	s.Interval = interval
+}
+
+// Advance moves the scheduler forward one physics frame
+func (s *AIScheduler) Advance() {
+	s.frame++
+}
+
+// Due reports whether the given AI task should run this frame.
+// Tasks are staggered so they don't all land on the same frame.
+// A nil scheduler runs every task every frame.
+func (s *AIScheduler) Due(task int) bool {
+	if s == nil || s.Interval <= 1 {
+		return true
+	}
+	return s.frame%s.Interval == task%s.Interval
+}
@@ -0,0 +1,71 @@
+package physics
+
+import "fyne.io/fyne/v2"
+
+// SequenceStep is one step of a scripted sequence: a prompt that stays up until the step's condition is met
+type SequenceStep struct {
+	Prompt    string                            // what to tell the player
+	Enter     func()                            // sets the step up when it starts (nil = nothing to set up)
+	Done      func() bool                       // reports whether the step is complete (nil = after MinFrames)
+	Highlight func() (fyne.Position, fyne.Size) // region to draw attention to (nil or zero size = none)
+	MinFrames int                               // frames the step stays up at least, so its prompt can be read
+}
+
+// Sequence runs scripted steps one after another, each gated on its own condition
+type Sequence struct {
+	Steps   []SequenceStep
+	index   int  // current step
+	frames  int  // frames spent on the current step
+	entered bool // whether the current step has been set up
+}
+
+// NewSequence creates a sequence of the given steps, starting at the first
+func NewSequence(steps ...SequenceStep) *Sequence {
+	return &Sequence{Steps: steps}
+}
+
+// Current returns the step in progress, or false once the sequence has finished
+func (s *Sequence) Current() (SequenceStep, bool) {
+	if s.Finished() {
+		return SequenceStep{}, false
+	}
+	return s.Steps[s.index], true
+}
+
+// Index returns the position of the step in progress, counting from 0
+func (s *Sequence) Index() int {
+	return s.index
+}
+
+// Finished reports whether every step is complete
+func (s *Sequence) Finished() bool {
+	return s.index >= len(s.Steps)
+}
+
+// Update sets up the current step on its first frame and moves on to the next step once it is complete.
+// It returns true when a new step has started (or the sequence has just finished).
+func (s *Sequence) Update() bool {
+	step, ok := s.Current()
+	if !ok {
+		return false
+	}
+	if !s.entered {
+		s.entered = true
+		if step.Enter != nil {
+			step.Enter()
+		}
+		return true
+	}
+
+	s.frames++
+	if s.frames < step.MinFrames || (step.Done != nil && !step.Done()) {
+		return false
+	}
+	s.index++
+	s.frames = 0
+	s.entered = false
+	if s.Finished() {
+		return true
+	}
+	return s.Update() // Set the next step up straight away
+}
@@ -0,0 +1,69 @@
+package physics
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Shadow is a soft elliptical shadow drawn on the ground beneath an entity.
+// It shrinks and fades as the entity's pseudo-height increases.
+type Shadow struct {
+	Outer *canvas.Rectangle // Wide, faint edge of the shadow
+	Inner *canvas.Rectangle // Darker core of the shadow
+}
+
+// NewShadow creates a shadow
+func NewShadow() *Shadow {
+	return &Shadow{
+		Outer: &canvas.Rectangle{FillColor: color.RGBA{R: 0, G: 0, B: 0, A: 40}},
+		Inner: &canvas.Rectangle{FillColor: color.RGBA{R: 0, G: 0, B: 0, A: 70}},
+	}
+}
+
+// Update places the shadow centered at (x, groundY) for an entity of the given width.
+// height is the entity's pseudo-height above the ground in pixels.
+func (s *Shadow) Update(x, groundY, width, height float32) {
+	if height < 0 {
+		height = 0
+	}
+
+	// Higher entities cast smaller, fainter shadows that fall further below them
+	scale := 1 / (1 + height/60)
+	shadowWidth := width * scale
+	shadowHeight := shadowWidth * 0.3 // Flattened ellipse
+	groundY += height * 0.5
+
+	s.Outer.Resize(fyne.NewSize(shadowWidth, shadowHeight))
+	s.Outer.Move(fyne.NewPos(x-shadowWidth/2, groundY-shadowHeight/2))
+	s.Outer.CornerRadius = shadowHeight / 2
+	s.Outer.FillColor = color.RGBA{R: 0, G: 0, B: 0, A: uint8(40 * scale)}
+
+	innerWidth := shadowWidth * 0.7
+	innerHeight := shadowHeight * 0.6
+	s.Inner.Resize(fyne.NewSize(innerWidth, innerHeight))
+	s.Inner.Move(fyne.NewPos(x-innerWidth/2, groundY-innerHeight/2))
+	s.Inner.CornerRadius = innerHeight / 2
+	s.Inner.FillColor = color.RGBA{R: 0, G: 0, B: 0, A: uint8(70 * scale)}
+
+	s.Outer.Refresh()
+	s.Inner.Refresh()
+}
+
+// Show shows the shadow
+func (s *Shadow) Show() {
+	s.Outer.Show()
+	s.Inner.Show()
+}
+
+// Hide hides the shadow
+func (s *Shadow) Hide() {
+	s.Outer.Hide()
+	s.Inner.Hide()
+}
+
+// GetVisualComponents returns the shadow's visual components for the shadow layer
+func (s *Shadow) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{s.Outer, s.Inner}
+}
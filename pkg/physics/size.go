@@ -0,0 +1,48 @@
+package physics
+
+import "fmt"
+
+// Default ball size range
+const (
+	defaultMinBallRadius = float32(15) // balls never shrink below this radius
+	defaultMaxBallRadius = float32(90) // balls never grow (by merging or regrowing) beyond this radius
+)
+
+// SetBallSizeRange sets the radius range the world's balls are kept within
+func (w *World) SetBallSizeRange(min, max float32) error {
+	if min <= 0 || max < min {
+		return fmt.Errorf("invalid ball size range %.1f-%.1f", min, max)
+	}
+
+	w.MinBallRadius = min
+	w.MaxBallRadius = max
+	return nil
+}
+
+// ballSizeRange returns the world's ball size range, or the default range for a ball outside any world
+func (w *World) ballSizeRange() (float32, float32) {
+	if w == nil {
+		return defaultMinBallRadius, defaultMaxBallRadius
+	}
+	return w.MinBallRadius, w.MaxBallRadius
+}
+
+// clampRadius limits a radius to the ball size range
+func (w *World) clampRadius(radius float32) float32 {
+	minRadius, maxRadius := w.ballSizeRange()
+	if radius < minRadius {
+		return minRadius
+	}
+	if radius > maxRadius {
+		return maxRadius
+	}
+	return radius
+}
+
+// ConstrainSize brings the ball (and the size it regrows to) back within its world's ball size range
+func (b *Ball) ConstrainSize() {
+	b.FullRadius = b.World.clampRadius(b.FullRadius)
+	if radius := b.World.clampRadius(b.OriginalRadius); radius != b.OriginalRadius {
+		b.setRadius(radius)
+	}
+}
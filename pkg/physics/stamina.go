@@ -0,0 +1,71 @@
+package physics
+
+// Stamina tuning
+const (
+	sprintSpeedFactor   = float32(2.0)   // sprinting doubles the human's speed
+	maxStamina          = float32(100)   // full stamina
+	staminaDrain        = float32(1.0)   // stamina used per sprinting frame (~1.7 seconds of sprint)
+	staminaRegen        = float32(0.4)   // stamina regained per frame while walking
+	staminaRecoverLevel = float32(25)    // stamina an exhausted human needs before sprinting again
+	sprintPanicDanger   = float32(0.7)   // AI avoidance danger at which the human always sprints
+	sprintAlertDanger   = float32(0.4)   // AI avoidance danger at which the human sprints if it has stamina to spare
+	sprintAlertReserve  = maxStamina / 2 // stamina the AI keeps in reserve for panics
+)
+
+// wantsSprint decides whether the human tries to sprint this frame.
+// The player sprints while holding sprint and moving; the AI sprints when a ball is about to hit,
+// and in moderate danger only while it has stamina to spare.
+func (h *Human) wantsSprint(moving bool) bool {
+	if !moving {
+		return false
+	}
+	if h.Control == ControlManual {
+		return h.SprintHeld
+	}
+	return h.avoidDanger > sprintPanicDanger ||
+		(h.avoidDanger > sprintAlertDanger && h.Stamina > sprintAlertReserve)
+}
+
+// updateStamina drains stamina while sprinting and regenerates it while walking.
+// Running out leaves the human exhausted until stamina recovers a little.
+// It returns whether the human sprints this frame.
+func (h *Human) updateStamina(wantSprint bool) bool {
+	h.IsSprinting = wantSprint && !h.exhausted && h.Stamina > 0
+	if h.IsSprinting {
+		h.Stamina -= staminaDrain
+		if h.Stamina <= 0 {
+			h.Stamina = 0
+			h.exhausted = true
+		}
+		return true
+	}
+
+	h.Stamina += staminaRegen
+	if h.Stamina > h.MaxStamina {
+		h.Stamina = h.MaxStamina
+	}
+	if h.exhausted && h.Stamina >= staminaRecoverLevel {
+		h.exhausted = false
+	}
+	return false
+}
+
+// StaminaFraction returns the stamina left, from 0 (empty) to 1 (full)
+func (h *Human) StaminaFraction() float32 {
+	if h.MaxStamina <= 0 {
+		return 0
+	}
+	return h.Stamina / h.MaxStamina
+}
+
+// IsExhausted reports whether the human ran out of stamina and can't sprint yet
+func (h *Human) IsExhausted() bool {
+	return h.exhausted
+}
+
+// RestoreStamina refills the human's stamina (e.g. on respawn)
+func (h *Human) RestoreStamina() {
+	h.Stamina = h.MaxStamina
+	h.IsSprinting = false
+	h.exhausted = false
+}
@@ -0,0 +1,55 @@
+package physics
+
+// DragonStance sets how far from the human the dragon is willing to chase threats
+type DragonStance int
+
+// Dragon stances
+const (
+	StanceDefensive  DragonStance = iota // stays close, only chasing balls inside the protect radius
+	StanceAggressive                     // ranges out, chasing balls well beyond the protect radius
+)
+
+// aggressiveChaseScale is how far past its protect radius an aggressive dragon chases balls
+const aggressiveChaseScale = float32(1.75)
+
+// DragonStances lists every stance in the order they are offered
+var DragonStances = []DragonStance{StanceDefensive, StanceAggressive}
+
+// String returns the stance's display name
+func (s DragonStance) String() string {
+	switch s {
+	case StanceAggressive:
+		return "Aggressive"
+	default:
+		return "Defensive"
+	}
+}
+
+// DragonStanceByName returns the stance with the given display name, or StanceDefensive for unknown names
+func DragonStanceByName(name string) DragonStance {
+	for _, stance := range DragonStances {
+		if stance.String() == name {
+			return stance
+		}
+	}
+	return StanceDefensive
+}
+
+// chaseRadius returns how far from the human a ball can be and still get chased down
+func (d *Dragon) chaseRadius() float32 {
+	if d.Stance == StanceAggressive {
+		return d.ProtectRadius * aggressiveChaseScale
+	}
+	return d.ProtectRadius
+}
+
+// SetProtectRadius sets the hatchling protect radius; the dragon's tier and the difficulty scale it from there
+func (d *Dragon) SetProtectRadius(radius float32) {
+	d.baseProtectRadius = radius
+	d.ProtectRadius = radius * d.Progression.Tier.protectScale() * CurrentDifficulty.Config().DragonPower
+}
+
+// BaseProtectRadius returns the protect radius before the dragon's tier scales it
+func (d *Dragon) BaseProtectRadius() float32 {
+	return d.baseProtectRadius
+}
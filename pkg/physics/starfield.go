@@ -1,9 +1,11 @@
 package physics
 
 import (
+	"image"
 	"image/color"
 	"math"
 	"math/rand"
+	"sync"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -43,18 +45,48 @@ type Star struct {
 	Size         float32   // star size
 	Brightness   uint8     // star brightness (alpha value)
 	TwinklePhase float32   // current twinkling phase
-	Visual       *canvas.Circle
+	Color        color.RGBA // star-type color with the current twinkle alpha
+	// Warp streak (see warp.go)
+	IsStreaking  bool    // drawn as a motion-blur streak instead of a dot
+	TailX, TailY float32 // end of the streak, trailing back along the star's motion
 }
 
 // StarField represents a collection of moving stars with realistic distribution
 type StarField struct {
 	Stars       []*Star
 	Bounds      fyne.Size
+	Density     float32        // Stars per square pixel; the star count follows the bounds
+	Layer       *canvas.Raster // Every star is drawn into this one raster (see starlayer.go); added to the UI once
 	GalacticCenterX float32 // Center of galaxy for density calculations
 	GalacticCenterY float32
 	StarClasses map[StarType]StarClass
 	TravelSpeed float32     // Base speed of travel through space
-	TravelAngle float32     // Direction of travel (in radians)
+	TravelAngle float32     // Direction of travel (in radians); the stars stream the opposite way
+	// Gameplay intensity reaction
+	Intensity       float32 // Smoothed gameplay intensity (0.0 calm to 1.0 tense)
+	TargetIntensity float32 // Intensity the star field is easing toward
+	// Background layers
+	Background *ParallaxBackground // Named layers streaming past at their own speeds: nebulae, planets, stars, debris (see parallax.go)
+	Ambience   *Ambience           // Slow backdrop tint and star brightness cycle (see ambience.go)
+	MilkyWay   *MilkyWay           // Galactic band through the galactic center (see milkyway.go)
+	Nebula     *NebulaLayer        // Nebula clouds drifting behind the stars (see nebula.go)
+	BlackHole  *BlackHole          // Rare black hole whose gravity pulls on the arena (see blackhole.go)
+	Supernova  *Supernova          // Occasional exploding star that pushes the balls away (see supernova.go)
+	// Overlay
+	Constellations *ConstellationLayer // Optional constellation lines and names (see constellation.go)
+	// Performance
+	TwinkleStride int  // stars twinkle in turns: 1/TwinkleStride of them update each frame (1 = all)
+	Static        bool // low-power mode: stars stay put and don't twinkle, and the layer redraws rarely
+	twinkleTurn   int  // which share of the stars twinkles this frame
+	staticTimer   int  // frames until the next redraw in static mode
+	// Warp speed (see warp.go)
+	Warp       int     // warp level (0 = normal travel)
+	warpFactor float32 // travel speed multiplier, easing toward the warp level's speed
+	// Rendering snapshot shared with the render thread (see starlayer.go)
+	spriteMu     sync.Mutex
+	sprites      []starSprite
+	spriteBounds fyne.Size
+	frame        *image.RGBA
 }
 
 // Initialize star classification system based on real stellar populations
@@ -135,26 +167,69 @@ func getStarClasses() map[StarType]StarClass {
 	}
 }
 
-// NewStarField creates a new realistic star field with space travel effect
+// NewStarField creates a new realistic star field with space travel effect. numStars sets the
+// density for the initial bounds; resizing adds or removes stars to keep it.
 func NewStarField(numStars int, bounds fyne.Size) *StarField {
 	starField := &StarField{
-		Stars:           make([]*Star, numStars),
 		Bounds:          bounds,
 		GalacticCenterX: bounds.Width * 0.6,  // Offset galactic center
 		GalacticCenterY: bounds.Height * 0.4,
 		StarClasses:     getStarClasses(),
 		TravelSpeed:     0.75, // Base speed of travel through space (slowed by half)
-		TravelAngle:     math.Pi,  // Traveling horizontally to the left (stars move right)
+		TravelAngle:     0,    // Traveling to the right (stars stream left)
+		Ambience:        NewAmbience(Tuning().AmbiencePeriod, bounds),
+		Nebula:          NewNebulaLayer(Tuning().NebulaDensity, bounds),
+		Constellations:  NewConstellationLayer(bounds),
+		BlackHole:       NewBlackHole(),
+		Supernova:       NewSupernova(bounds),
+		TwinkleStride:   Tuning().TwinkleStride,
+		warpFactor:      1,
 	}
 
+	starField.Layer = starField.newStarLayer()
+	starField.MilkyWay = NewMilkyWay(starField.GalacticCenterX, starField.GalacticCenterY, bounds)
+
 	// Create stars with realistic distribution
-	for i := 0; i < numStars; i++ {
-		starField.Stars[i] = starField.createRealisticStar()
+	density := float32(0)
+	if area := bounds.Width * bounds.Height; area > 0 {
+		density = float32(numStars) / area
 	}
+	starField.SetTargetDensity(density)
+
+	// Stack the layers back to front
+	starField.Background = NewParallaxBackground(bounds)
+	starField.Background.AddLayer(LayerAmbience, 0, starField.Ambience)
+	starField.Background.AddLayer(LayerMilkyWay, 0, starField.MilkyWay)
+	starField.Background.AddLayer(LayerNebulae, nebulaParallax, starField.Nebula)
+	starField.Background.AddLayer(LayerPlanets, planetParallax, NewPlanetLayer(bounds))
+	starField.Background.AddLayer(LayerStars, starParallax, &starLayer{starField})
+	starField.Background.AddLayer(LayerDebris, debrisParallax, NewDebrisLayer(bounds))
 
 	return starField
 }
 
+// SetTargetDensity adds or removes stars to match the density (stars per square pixel), so large
+// windows aren't sparse and small ones don't update stars nobody can see
+func (sf *StarField) SetTargetDensity(starsPerPixel float32) {
+	if starsPerPixel < 0 {
+		starsPerPixel = 0
+	}
+	sf.Density = starsPerPixel
+	sf.resize()
+}
+
+// resize adds or removes stars so the count matches the density for the current bounds
+func (sf *StarField) resize() {
+	target := int(sf.Density*sf.Bounds.Width*sf.Bounds.Height + 0.5)
+	for len(sf.Stars) < target {
+		sf.Stars = append(sf.Stars, sf.createRealisticStar())
+	}
+	if len(sf.Stars) > target {
+		sf.Stars = sf.Stars[:target]
+		sf.Constellations.Clear() // Some may have lost stars; new ones form from the rest
+	}
+}
+
 // selectStarType chooses a star type based on realistic stellar population frequencies
 func (sf *StarField) selectStarType() StarType {
 	random := rand.Float32()
@@ -243,25 +318,58 @@ func (sf *StarField) createRealisticStar() *Star {
 		TwinklePhase: rand.Float32() * 2 * math.Pi,
 	}
 
-	// Create visual representation with star-type-specific color
-	starColor := starClass.Color
-	starColor.A = brightness
-
-	star.Visual = &canvas.Circle{
-		FillColor:   starColor,
-		StrokeColor: color.RGBA{R: starColor.R, G: starColor.G, B: starColor.B, A: 0},
-		StrokeWidth: 0,
-	}
-
-	star.Visual.Resize(fyne.NewSize(star.Size, star.Size))
-	star.Visual.Move(fyne.NewPos(star.X-star.Size/2, star.Y-star.Size/2))
+	// Star-type-specific color
+	star.Color = starClass.Color
+	star.Color.A = brightness
 
 	return star
 }
 
 // Update updates all stars with space travel parallax effect
 func (sf *StarField) Update() {
-	for _, star := range sf.Stars {
+	// Ease toward the target intensity so the background changes subtly
+	sf.Intensity += (sf.TargetIntensity - sf.Intensity) * 0.02
+
+	// Tense moments speed up travel; warp speeds it up dramatically
+	sf.updateWarp()
+	travelSpeed := sf.TravelSpeed * (1.0 + sf.Intensity*1.5) * sf.warpFactor
+
+	// Every layer streams against the direction of travel at its own speed
+	dirX := float32(math.Cos(float64(sf.TravelAngle)))
+	dirY := float32(math.Sin(float64(sf.TravelAngle)))
+	sf.Background.Update(-dirX*travelSpeed, -dirY*travelSpeed, dirX, dirY)
+	sf.BlackHole.Update(-dirX*travelSpeed, -dirY*travelSpeed, dirX, dirY, sf.Bounds)
+
+	sf.updateSupernova(dirX, dirY)
+	sf.Constellations.Update(sf.Stars, sf.Bounds)
+
+	// Static stars only need redrawing now and then to pick up the ambience, constellations and supernovae
+	if sf.Static {
+		sf.staticTimer--
+		if sf.staticTimer > 0 {
+			return
+		}
+		sf.staticTimer = staticRedrawFrames
+	}
+	sf.redraw()
+}
+
+// moveStars streams the stars by the star layer's velocity (vx, vy), closer stars faster, and
+// regenerates stars that leave the screen at the edge the ship is heading toward (dirX, dirY)
+func (sf *StarField) moveStars(vx, vy, dirX, dirY float32) {
+	if sf.Static {
+		return
+	}
+
+	speed := float32(math.Sqrt(float64(vx*vx + vy*vy)))
+	twinkleBoost := 1.0 + sf.Intensity*2.0 // Tense moments make stars twinkle harder
+	streaking := sf.isStreaking()
+
+	// Each star twinkles once every stride frames, catching up on the frames it skipped
+	stride := max(sf.TwinkleStride, 1)
+	sf.twinkleTurn = (sf.twinkleTurn + 1) % stride
+
+	for i, star := range sf.Stars {
 		if star == nil {
 			continue
 		}
@@ -270,26 +378,57 @@ func (sf *StarField) Update() {
 		// Closer stars (lower distance values) move faster
 		parallaxMultiplier := (1.0 - star.Distance) * 3.0 + 0.5 // Range from 0.5x to 3.5x speed
 
-		// Simple horizontal movement: we're traveling forward, so stars move from right to left
-		star.X -= sf.TravelSpeed * parallaxMultiplier
+		// We're traveling forward, so stars move past us the opposite way
+		step := speed * parallaxMultiplier
+		star.X += vx * parallaxMultiplier
+		star.Y += vy * parallaxMultiplier
 
-		// Regenerate stars that have moved off the left edge
+		// Regenerate stars that have moved off the trailing edge on the edge we're heading toward
 		margin := float32(50.0)
-		if star.X < -margin {
-			// Regenerate star on the right edge with random Y position
-			star.X = sf.Bounds.Width + margin
-			star.Y = rand.Float32() * sf.Bounds.Height
+		if isOffscreen(star.X, star.Y, margin, sf.Bounds) {
+			star.X, star.Y = leadingEdgePosition(sf.Bounds, dirX, dirY, margin)
 
 			// Generate new star properties for variety
 			sf.regenerateStarProperties(star)
 		}
 
-		// Update visual position
-		star.Visual.Move(fyne.NewPos(star.X-star.Size/2, star.Y-star.Size/2))
-
 		// Advanced twinkling based on star type and atmospheric effects
-		star.updateTwinkling()
+		if i%stride == sf.twinkleTurn {
+			star.updateTwinkling(twinkleBoost, stride)
+		}
+
+		// At warp speed, stretch into a streak
+		star.updateStreak(streaking, dirX, dirY, step)
+	}
+}
+
+// isOffscreen reports whether (x, y) is more than margin outside the bounds
+func isOffscreen(x, y, margin float32, bounds fyne.Size) bool {
+	return x < -margin || x > bounds.Width+margin || y < -margin || y > bounds.Height+margin
+}
+
+// leadingEdgePosition returns a random spot just outside the edge the ship is heading toward (dirX, dirY),
+// where new stars come into view. Diagonal travel spreads them over both leading edges, in proportion
+// to how fast each edge comes toward the ship.
+func leadingEdgePosition(bounds fyne.Size, dirX, dirY, margin float32) (float32, float32) {
+	absX := float32(math.Abs(float64(dirX)))
+	absY := float32(math.Abs(float64(dirY)))
+	if absX+absY == 0 {
+		return rand.Float32() * bounds.Width, rand.Float32() * bounds.Height
+	}
+
+	if rand.Float32()*(absX+absY) < absX {
+		x := -margin
+		if dirX > 0 {
+			x = bounds.Width + margin
+		}
+		return x, rand.Float32() * bounds.Height
 	}
+	y := -margin
+	if dirY > 0 {
+		y = bounds.Height + margin
+	}
+	return rand.Float32() * bounds.Width, y
 }
 
 // regenerateStarProperties generates new properties for a star that has moved off screen
@@ -318,18 +457,16 @@ func (sf *StarField) regenerateStarProperties(star *Star) {
 	star.Brightness = brightness
 	star.TwinklePhase = rand.Float32() * 2 * math.Pi
 
-	// Update visual properties
-	starColor := starClass.Color
-	starColor.A = brightness
-	star.Visual.FillColor = starColor
-	star.Visual.Resize(fyne.NewSize(star.Size, star.Size))
+	// Update color
+	star.Color = starClass.Color
+	star.Color.A = brightness
 }
 
-// updateTwinkling creates realistic twinkling effects
-func (s *Star) updateTwinkling() {
+// updateTwinkling creates realistic twinkling effects, scaled by boost, advancing the given number of frames
+func (s *Star) updateTwinkling(boost float32, frames int) {
 	// Update twinkling phase
 	twinkleSpeed := 0.05 + rand.Float32()*0.03 // Vary twinkling speed
-	s.TwinklePhase += twinkleSpeed
+	s.TwinklePhase += twinkleSpeed * float32(frames)
 
 	// Different star types twinkle differently
 	baseBrightness := float32(s.Brightness)
@@ -343,6 +480,8 @@ func (s *Star) updateTwinkling() {
 		twinkleIntensity *= (1.0 - s.Distance) // Closer stars twinkle more
 	}
 
+	twinkleIntensity *= boost
+
 	// Apply sine wave twinkling
 	twinkleEffect := float32(math.Sin(float64(s.TwinklePhase))) * twinkleIntensity
 	newBrightness := baseBrightness * (1.0 + twinkleEffect)
@@ -354,29 +493,26 @@ func (s *Star) updateTwinkling() {
 		newBrightness = 255
 	}
 
-	// Update visual color with new brightness
-	currentColor := s.Visual.FillColor.(color.RGBA)
-	currentColor.A = uint8(newBrightness)
-	s.Visual.FillColor = currentColor
-	s.Visual.Refresh()
+	// Update color with new brightness
+	s.Color.A = uint8(newBrightness)
 }
 
-// GetVisuals returns all star visual objects for UI management
-func (sf *StarField) GetVisuals() []*canvas.Circle {
-	visuals := make([]*canvas.Circle, 0, len(sf.Stars))
-	for _, star := range sf.Stars {
-		if star != nil && star.Visual != nil {
-			visuals = append(visuals, star.Visual)
-		}
-	}
-	return visuals
-}
-
-// UpdateBounds updates the star field bounds and redistributes stars
+// UpdateBounds updates the star field bounds and resizes every layer
 func (sf *StarField) UpdateBounds(newBounds fyne.Size) {
 	sf.Bounds = newBounds
+	sf.Constellations.Container.Resize(newBounds)
+	sf.Supernova.Flash.Resize(newBounds)
 	sf.GalacticCenterX = newBounds.Width * 0.6
 	sf.GalacticCenterY = newBounds.Height * 0.4
+	sf.MilkyWay.SetCenter(sf.GalacticCenterX, sf.GalacticCenterY)
+	sf.Background.UpdateBounds(newBounds)
+	sf.redraw()
+}
+
+// fitStars redistributes stars outside the bounds and adds or removes stars to keep the density
+func (sf *StarField) fitStars() {
+	sf.Layer.Resize(sf.Bounds)
+	newBounds := sf.Bounds
 
 	// Reposition stars that are now outside the new bounds
 	for _, star := range sf.Stars {
@@ -390,10 +526,23 @@ func (sf *StarField) UpdateBounds(newBounds fyne.Size) {
 		if star.Y > newBounds.Height {
 			star.Y = rand.Float32() * newBounds.Height
 		}
-
-		// Update visual position
-		star.Visual.Move(fyne.NewPos(star.X-star.Size/2, star.Y-star.Size/2))
 	}
+	sf.resize()
+}
+
+// staticRedrawFrames is how often static stars are redrawn
+const staticRedrawFrames = 30
+
+// SetTwinkleStride makes 1/stride of the stars twinkle each frame, in turns (1 = every star every frame)
+func (sf *StarField) SetTwinkleStride(stride int) {
+	sf.TwinkleStride = max(stride, 1)
+	sf.twinkleTurn = 0
+}
+
+// SetStatic switches the low-power static stars mode on or off
+func (sf *StarField) SetStatic(static bool) {
+	sf.Static = static
+	sf.staticTimer = 0
 }
 
 // SetTravelSpeed allows dynamic adjustment of travel speed
@@ -401,7 +550,18 @@ func (sf *StarField) SetTravelSpeed(speed float32) {
 	sf.TravelSpeed = speed
 }
 
-// SetTravelDirection allows dynamic adjustment of travel direction
+// SetIntensity sets the gameplay intensity (0.0 to 1.0) the star field eases toward
+func (sf *StarField) SetIntensity(level float32) {
+	if level < 0 {
+		level = 0
+	} else if level > 1 {
+		level = 1
+	}
+	sf.TargetIntensity = level
+}
+
+// SetTravelDirection turns the ship to travel toward angle (radians, 0 = right, Pi/2 = down);
+// the stars and nebulae stream the opposite way
 func (sf *StarField) SetTravelDirection(angle float32) {
 	sf.TravelAngle = angle
 }
@@ -0,0 +1,151 @@
+package physics
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// starSprite is a star as the layer draws it: a dot, or a streak from the head back to the tail
+type starSprite struct {
+	X, Y         float32    // head (the star's position)
+	TailX, TailY float32    // end of the streak (the head for a dot)
+	Radius       float32    // dot radius, or half the streak's width
+	Color        color.RGBA // color with the current twinkle alpha
+}
+
+// starLayer is the star field's place among the parallax layers
+type starLayer struct {
+	sf *StarField
+}
+
+// Update streams the stars by the layer's velocity
+func (l *starLayer) Update(vx, vy, dirX, dirY float32) {
+	l.sf.moveStars(vx, vy, dirX, dirY)
+}
+
+// UpdateBounds fits the stars to the star field's bounds
+func (l *starLayer) UpdateBounds(fyne.Size) {
+	l.sf.fitStars()
+}
+
+// Visual returns the raster the stars are drawn into
+func (l *starLayer) Visual() fyne.CanvasObject {
+	return l.sf.Layer
+}
+
+// newStarLayer creates the single raster every star is drawn into, instead of a canvas object per star
+func (sf *StarField) newStarLayer() *canvas.Raster {
+	layer := canvas.NewRaster(sf.drawStars)
+	layer.Resize(sf.Bounds)
+	return layer
+}
+
+// redraw snapshots the stars for the render thread and refreshes the layer
+func (sf *StarField) redraw() {
+	sf.spriteMu.Lock()
+	sf.sprites = append(sf.sprites[:0], sf.Constellations.sprites()...) // Lines first, so the stars sit on top
+	for _, star := range sf.Stars {
+		if star == nil {
+			continue
+		}
+		sprite := starSprite{X: star.X, Y: star.Y, TailX: star.X, TailY: star.Y, Radius: star.Size / 2, Color: star.Color}
+		sprite.Color.A = uint8(float32(star.Color.A) * sf.Ambience.StarBrightness)
+		if star.IsStreaking {
+			sprite.TailX, sprite.TailY = star.TailX, star.TailY
+			sprite.Radius = star.Size * 0.3
+		}
+		sf.sprites = append(sf.sprites, sprite)
+	}
+	sf.spriteBounds = sf.Bounds
+	sf.spriteMu.Unlock()
+
+	sf.Layer.Refresh()
+}
+
+// drawStars renders the latest snapshot at w x h pixels. The frame buffer is reused between frames,
+// since Fyne uploads a raster's image as soon as it is generated.
+func (sf *StarField) drawStars(w, h int) image.Image {
+	sf.spriteMu.Lock()
+	defer sf.spriteMu.Unlock()
+
+	if w <= 0 || h <= 0 || sf.spriteBounds.Width <= 0 || sf.spriteBounds.Height <= 0 {
+		return image.NewRGBA(image.Rect(0, 0, 1, 1))
+	}
+	if sf.frame == nil || sf.frame.Rect.Dx() != w || sf.frame.Rect.Dy() != h {
+		sf.frame = image.NewRGBA(image.Rect(0, 0, w, h))
+	} else {
+		clear(sf.frame.Pix)
+	}
+
+	// The raster is drawn in pixels, which may be denser than the layout units the stars move in
+	scale := float32(w) / sf.spriteBounds.Width
+	for _, sprite := range sf.sprites {
+		drawStarSprite(sf.frame, sprite, scale)
+	}
+	return sf.frame
+}
+
+// drawStarSprite blends an anti-aliased capsule (a dot when head and tail meet) into the frame
+func drawStarSprite(frame *image.RGBA, sprite starSprite, scale float32) {
+	x1, y1 := sprite.X*scale, sprite.Y*scale
+	x2, y2 := sprite.TailX*scale, sprite.TailY*scale
+	radius := sprite.Radius * scale
+	if radius < 0.5 {
+		radius = 0.5 // Keep the faintest stars at least a pixel wide
+	}
+
+	bounds := frame.Rect
+	minX := clampPixel(int(math.Floor(float64(min(x1, x2)-radius-1))), bounds.Min.X, bounds.Max.X)
+	maxX := clampPixel(int(math.Ceil(float64(max(x1, x2)+radius+1))), bounds.Min.X, bounds.Max.X)
+	minY := clampPixel(int(math.Floor(float64(min(y1, y2)-radius-1))), bounds.Min.Y, bounds.Max.Y)
+	maxY := clampPixel(int(math.Ceil(float64(max(y1, y2)+radius+1))), bounds.Min.Y, bounds.Max.Y)
+
+	dx, dy := x2-x1, y2-y1
+	lengthSq := dx*dx + dy*dy
+	for py := minY; py < maxY; py++ {
+		for px := minX; px < maxX; px++ {
+			// Distance from the pixel center to the closest point on the head-tail segment
+			cx, cy := float32(px)+0.5-x1, float32(py)+0.5-y1
+			t := float32(0)
+			if lengthSq > 0 {
+				t = clampCoordinate((cx*dx+cy*dy)/lengthSq, 0, 1)
+			}
+			ox, oy := cx-t*dx, cy-t*dy
+			distance := float32(math.Sqrt(float64(ox*ox + oy*oy)))
+
+			if coverage := radius + 0.5 - distance; coverage > 0 {
+				blendPixel(frame, px, py, sprite.Color, min(coverage, 1))
+			}
+		}
+	}
+}
+
+// clampPixel limits a pixel coordinate to [min, max]
+func clampPixel(v, min, max int) int {
+	if v < min {
+		return min
+	} else if v > max {
+		return max
+	}
+	return v
+}
+
+// blendPixel draws a color over a pre-multiplied pixel with the given coverage (0 to 1)
+func blendPixel(frame *image.RGBA, x, y int, c color.RGBA, coverage float32) {
+	alpha := float32(c.A) / 255 * coverage
+	i := frame.PixOffset(x, y)
+	pix := frame.Pix[i : i+4 : i+4]
+	pix[0] = uint8(float32(c.R)*alpha + float32(pix[0])*(1-alpha) + 0.5)
+	pix[1] = uint8(float32(c.G)*alpha + float32(pix[1])*(1-alpha) + 0.5)
+	pix[2] = uint8(float32(c.B)*alpha + float32(pix[2])*(1-alpha) + 0.5)
+	pix[3] = uint8(255*alpha + float32(pix[3])*(1-alpha) + 0.5)
+}
+
+// GetVisuals returns the star layer for UI management
+func (sf *StarField) GetVisuals() []fyne.CanvasObject {
+	return []fyne.CanvasObject{sf.Layer}
+}
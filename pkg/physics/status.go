@@ -0,0 +1,150 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
+)
+
+// StatusEffect is a temporary condition applied to a ball by attacks and hazards
+type StatusEffect int
+
+// Status effects
+const (
+	StatusFrozen  StatusEffect = iota // moves at half speed with an icy blue tint
+	StatusBurning                     // loses HP over time, with flames flickering above it
+	StatusStunned                     // stops moving entirely
+)
+
+// Status effect tuning
+const (
+	frozenSpeedFactor = float32(0.5) // frozen balls move at half speed
+	burnDamageFrames  = 45           // frames between burn damage ticks (~0.75 seconds)
+	burnDamage        = 1            // HP lost per burn tick
+	flameInterval     = 2            // frames between the flames a burning ball gives off
+)
+
+// frozenTint is the sclera color of a frozen ball
+var frozenTint = color.RGBA{R: 170, G: 215, B: 255, A: 255}
+
+// String returns the effect's display name
+func (e StatusEffect) String() string {
+	switch e {
+	case StatusFrozen:
+		return "Frozen"
+	case StatusBurning:
+		return "Burning"
+	case StatusStunned:
+		return "Stunned"
+	default:
+		return "Unknown"
+	}
+}
+
+// burnFlame is a flame licking up off a burning ball, orange to yellow, reddening as it fades.
+// Its size is set from the ball's radius when it is emitted.
+var burnFlame = effects.Emitter{
+	Count:       1,
+	Speed:       0.6,
+	SpeedJitter: 0.6,
+	Direction:   -math.Pi / 2, // Straight up
+	Spread:      0.4,
+	Life:        16,
+	Gravity:     -0.03, // Hot air rises
+	Colors: []color.RGBA{
+		{R: 255, G: 120, B: 30, A: 220}, // Orange
+		{R: 255, G: 200, B: 30, A: 220}, // Yellow
+		{R: 255, G: 80, B: 30, A: 220},  // Deep orange
+	},
+	EndColor: color.RGBA{R: 255, G: 40, B: 20, A: 0},
+}
+
+// ApplyStatus applies a status effect for the given number of frames.
+// Reapplying an effect that is already active extends it to the longer duration.
+func (b *Ball) ApplyStatus(effect StatusEffect, frames int) {
+	if b.IsDestroyed || b.IsAbsorbed || frames <= 0 {
+		return
+	}
+
+	switch effect {
+	case StatusFrozen:
+		if frames > b.FrozenTimer {
+			b.FrozenTimer = frames
+		}
+		b.Circle.FillColor = frozenTint
+		b.Circle.Refresh()
+	case StatusBurning:
+		if b.BurnTimer == 0 {
+			b.burnTick = burnDamageFrames
+		}
+		if frames > b.BurnTimer {
+			b.BurnTimer = frames
+		}
+	case StatusStunned:
+		if frames > b.StunTimer {
+			b.Stun(frames)
+		}
+	}
+}
+
+// HasStatus reports whether the given status effect is active on the ball
+func (b *Ball) HasStatus(effect StatusEffect) bool {
+	switch effect {
+	case StatusFrozen:
+		return b.FrozenTimer > 0
+	case StatusBurning:
+		return b.BurnTimer > 0
+	case StatusStunned:
+		return b.StunTimer > 0
+	default:
+		return false
+	}
+}
+
+// statusSpeedFactor returns how much of its velocity the ball covers this frame
+func (b *Ball) statusSpeedFactor() float32 {
+	if b.FrozenTimer > 0 {
+		return frozenSpeedFactor
+	}
+	return 1
+}
+
+// updateStatusEffects counts down the frozen and burning effects and applies burn damage
+func (b *Ball) updateStatusEffects() {
+	if b.FrozenTimer > 0 {
+		b.FrozenTimer--
+		if b.FrozenTimer == 0 {
+			b.Circle.FillColor = b.kindFillColor() // Thawed
+			b.Circle.Refresh()
+		}
+	}
+
+	if b.BurnTimer == 0 {
+		return
+	}
+
+	b.BurnTimer--
+	b.burnTick--
+	if b.burnTick <= 0 {
+		b.burnTick = burnDamageFrames
+		b.triggerJiggle(0.2)
+		if b.TakeDamage(burnDamage) {
+			return // Burned up
+		}
+	}
+
+	if b.BurnTimer > 0 && b.BurnTimer%flameInterval == 0 {
+		b.emitFlame()
+	}
+}
+
+// emitFlame gives off a flame from a random spot across the top of the ball
+func (b *Ball) emitFlame() {
+	flame := burnFlame
+	flame.Size = b.Radius * (0.35 + rand.Float32()*0.15)
+	x := b.X + (rand.Float32()-0.5)*b.Radius*1.2
+	y := b.Y - b.Radius*0.8
+	b.Particles.Emit(&flame, x, y)
+}
@@ -0,0 +1,143 @@
+package physics
+
+import "math"
+
+// Steering tuning
+const (
+	steeringForce    = float32(0.04) // maximum velocity change per frame from steering
+	maxSteeringSpeed = float32(2.5)  // steered balls never go faster than this
+)
+
+// SteeringContext is the world information a steering behavior can react to
+type SteeringContext struct {
+	Humans                           []*Human  // every player (the first is player 1)
+	Bullets                          []*Bullet // bullets currently in flight, from every player
+	GalacticCenterX, GalacticCenterY float32   // center of the star field's galaxy
+}
+
+// Steering is a movement behavior that nudges a ball's velocity every frame
+type Steering interface {
+	// Steer returns the desired velocity change for the ball this frame
+	Steer(b *Ball, ctx *SteeringContext) (float32, float32)
+}
+
+// SeekHuman steers the ball toward the human
+type SeekHuman struct{}
+
+// Steer pulls the ball toward the nearest active human
+func (SeekHuman) Steer(b *Ball, ctx *SteeringContext) (float32, float32) {
+	target := NearestHuman(ctx.Humans, b.X, b.Y)
+	if target == nil {
+		return 0, 0
+	}
+	return towards(b.X, b.Y, target.X, target.Y, steeringForce)
+}
+
+// EvadeBullets steers the ball away from nearby bullets
+type EvadeBullets struct {
+	Range float32 // distance at which bullets are noticed
+}
+
+// Steer pushes the ball away from every bullet within range, harder for closer ones
+func (e EvadeBullets) Steer(b *Ball, ctx *SteeringContext) (float32, float32) {
+	var forceX, forceY float32
+	for _, bullet := range ctx.Bullets {
+		if !bullet.IsActive {
+			continue
+		}
+
+		dx := b.X - bullet.X
+		dy := b.Y - bullet.Y
+		distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+		if distance == 0 || distance > e.Range {
+			continue
+		}
+
+		strength := (e.Range - distance) / e.Range // 1 when touching, 0 at the edge of range
+		forceX += dx / distance * strength
+		forceY += dy / distance * strength
+	}
+	return clampForce(forceX*steeringForce*2, forceY*steeringForce*2, steeringForce*2)
+}
+
+// OrbitPoint steers the ball into a circular orbit around the galactic center
+type OrbitPoint struct {
+	Radius float32 // preferred orbit radius
+}
+
+// Steer combines a tangential push with a pull back toward the preferred radius
+func (o OrbitPoint) Steer(b *Ball, ctx *SteeringContext) (float32, float32) {
+	dx := b.X - ctx.GalacticCenterX
+	dy := b.Y - ctx.GalacticCenterY
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance == 0 {
+		return steeringForce, 0
+	}
+
+	// Tangent (counter-clockwise on screen)
+	tangentX := -dy / distance
+	tangentY := dx / distance
+
+	// Radial correction: positive pulls inward when too far out
+	radial := (distance - o.Radius) / o.Radius
+	if radial > 1 {
+		radial = 1
+	} else if radial < -1 {
+		radial = -1
+	}
+
+	forceX := tangentX*0.5 - dx/distance*radial
+	forceY := tangentY*0.5 - dy/distance*radial
+	return clampForce(forceX*steeringForce, forceY*steeringForce, steeringForce)
+}
+
+// steeringProfiles maps LLM names to their movement personalities.
+// Names without an entry keep the plain bouncing behavior.
+var steeringProfiles = map[string]func() Steering{
+	"Claude":  func() Steering { return SeekHuman{} },
+	"GPT-4":   func() Steering { return EvadeBullets{Range: 150} },
+	"ChatGPT": func() Steering { return EvadeBullets{Range: 100} },
+	"Mistral": func() Steering { return OrbitPoint{Radius: 150} },
+}
+
+// SteeringForLLM returns the steering behavior for an LLM name, or nil for plain bouncing
+func SteeringForLLM(llmName string) Steering {
+	if profile, ok := steeringProfiles[llmName]; ok {
+		return profile()
+	}
+	return nil
+}
+
+// ApplySteering nudges the ball's velocity using its steering behavior
+func (b *Ball) ApplySteering(ctx *SteeringContext) {
+	if b.Steering == nil || ctx == nil || !b.IsAnimated || b.StunTimer > 0 || b.IsDestroyed || b.IsAbsorbed {
+		return
+	}
+
+	forceX, forceY := b.Steering.Steer(b, ctx)
+	b.VX += forceX
+	b.VY += forceY
+
+	// Keep steered balls from accelerating without limit
+	b.limitSpeed(maxSteeringSpeed)
+}
+
+// towards returns a force of the given strength pointing from (x, y) to (targetX, targetY)
+func towards(x, y, targetX, targetY, strength float32) (float32, float32) {
+	dx := targetX - x
+	dy := targetY - y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance == 0 {
+		return 0, 0
+	}
+	return dx / distance * strength, dy / distance * strength
+}
+
+// clampForce limits a force vector to the given length
+func clampForce(forceX, forceY, limit float32) (float32, float32) {
+	length := float32(math.Sqrt(float64(forceX*forceX + forceY*forceY)))
+	if length <= limit || length == 0 {
+		return forceX, forceY
+	}
+	return forceX / length * limit, forceY / length * limit
+}
@@ -0,0 +1,212 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
+)
+
+// Space storm tuning
+const (
+	stormStrikes      = 8            // bolts in one storm
+	stormStrikeGap    = 40           // frames between one strike's warning and the next
+	stormTelegraph    = 60           // frames a strike's warning flickers before the bolt lands (1 second)
+	stormStrikeRadius = float32(28)  // half-width of the column a bolt strikes
+	stormStunFrames   = 120          // frames a struck ball stays stunned (2 seconds)
+	stormDodgeMargin  = float32(40)  // AI players sidestep a warned column from this far outside it
+	stormDodgeForce   = float32(1.5) // sidestep speed as a multiple of the player's walking speed
+)
+
+// StormStrike is one lightning strike of a space storm, flickering a warning over its column until it lands
+type StormStrike struct {
+	X       float32      // center of the struck column
+	Timer   int          // frames until the bolt lands
+	Warning *canvas.Line // flickering column marking where the bolt will land
+}
+
+// SpaceStorm is a random event that sends lightning bolts down from the top of the screen at random
+// columns. Each column flickers a warning first; balls caught under a bolt are stunned and players are
+// killed, so they have to get out of the way.
+type SpaceStorm struct {
+	Bounds       fyne.Size            // arena bounds
+	Events       *EventBus            // receives a lightning event for every ball a bolt stuns (may be nil)
+	Strikes      []*StormStrike       // strikes warned but not yet landed
+	IsFinished   bool                 // whether every bolt has landed
+	remaining    int                  // strikes still to be warned
+	nextStrike   int                  // frames until the next strike's warning
+	landed       []float32            // columns struck this frame
+	warnings     []*canvas.Line       // warning lines, one per strike, reused as strikes land
+	pendingBolts []*effects.Lightning // bolts not yet handed to the UI
+}
+
+// NewSpaceStorm creates a storm over the arena whose first warning appears straight away
+func NewSpaceStorm(bounds fyne.Size) *SpaceStorm {
+	storm := &SpaceStorm{
+		Bounds:    bounds,
+		remaining: stormStrikes,
+	}
+
+	// Enough warnings for every strike that can be flickering at once
+	storm.warnings = make([]*canvas.Line, stormTelegraph/stormStrikeGap+1)
+	for i := range storm.warnings {
+		warning := &canvas.Line{StrokeWidth: stormStrikeRadius * 2}
+		warning.Hide()
+		storm.warnings[i] = warning
+	}
+	return storm
+}
+
+// Update warns of new strikes, counts down the warned ones and lands the bolts that are due
+func (s *SpaceStorm) Update() {
+	s.landed = s.landed[:0]
+	if s.IsFinished {
+		return
+	}
+
+	// Warn of the next strike
+	if s.remaining > 0 {
+		s.nextStrike--
+		if s.nextStrike <= 0 {
+			s.warn()
+			s.nextStrike = stormStrikeGap
+		}
+	}
+
+	// Land the strikes whose warnings have run out
+	waiting := s.Strikes[:0]
+	for _, strike := range s.Strikes {
+		strike.Timer--
+		if strike.Timer > 0 {
+			s.flicker(strike)
+			waiting = append(waiting, strike)
+			continue
+		}
+
+		strike.Warning.Hide()
+		s.warnings = append(s.warnings, strike.Warning)
+		s.landed = append(s.landed, strike.X)
+		s.pendingBolts = append(s.pendingBolts, effects.NewLightning(strike.X, 0, strike.X, s.Bounds.Height))
+	}
+	s.Strikes = waiting
+
+	if s.remaining == 0 && len(s.Strikes) == 0 {
+		s.IsFinished = true
+	}
+}
+
+// warn picks a random column for the next strike and starts its warning flickering
+func (s *SpaceStorm) warn() {
+	if len(s.warnings) == 0 {
+		return
+	}
+	warning := s.warnings[len(s.warnings)-1]
+	s.warnings = s.warnings[:len(s.warnings)-1]
+
+	x := stormStrikeRadius + rand.Float32()*max(s.Bounds.Width-2*stormStrikeRadius, 0)
+	warning.Position1 = fyne.NewPos(x, 0)
+	warning.Position2 = fyne.NewPos(x, s.Bounds.Height)
+	warning.Show()
+
+	strike := &StormStrike{X: x, Timer: stormTelegraph, Warning: warning}
+	s.flicker(strike)
+	s.Strikes = append(s.Strikes, strike)
+	s.remaining--
+}
+
+// flicker brightens a strike's warning column as its bolt gets closer
+func (s *SpaceStorm) flicker(strike *StormStrike) {
+	closeness := 1 - float32(strike.Timer)/float32(stormTelegraph)
+	alpha := 20 + closeness*50
+	if (strike.Timer/6)%2 == 0 {
+		alpha += 25
+	}
+	strike.Warning.StrokeColor = color.RGBA{R: 180, G: 200, B: 255, A: uint8(alpha)}
+	strike.Warning.Refresh()
+}
+
+// hits reports whether a circle overlaps any column struck this frame
+func (s *SpaceStorm) hits(x, radius float32) bool {
+	for _, column := range s.landed {
+		if float32(math.Abs(float64(x-column))) < stormStrikeRadius+radius {
+			return true
+		}
+	}
+	return false
+}
+
+// StunBalls stuns every ball under a bolt that landed this frame
+func (s *SpaceStorm) StunBalls(balls []*Ball) {
+	if len(s.landed) == 0 {
+		return
+	}
+	for _, ball := range balls {
+		if !ball.IsAnimated || !s.hits(ball.X, ball.Radius) {
+			continue
+		}
+		ball.ApplyStatus(StatusStunned, stormStunFrames)
+		s.Events.Publish(Event{Type: EventLightning, X: ball.X, Y: ball.Y})
+	}
+}
+
+// CheckHuman reports whether a bolt that landed this frame hits the human
+func (s *SpaceStorm) CheckHuman(h *Human) bool {
+	if h == nil || !h.IsVulnerable() {
+		return false
+	}
+	return s.hits(h.X, h.Size*0.6) // Same hit radius as ball collisions
+}
+
+// DodgeHumans warns the AI players of the columns about to be struck so they sidestep out of them.
+// On a nil storm it calls off any sidestep.
+func (s *SpaceStorm) DodgeHumans(humans []*Human) {
+	for _, human := range humans {
+		human.stormDodge = 0
+		if s == nil {
+			continue
+		}
+		for _, strike := range s.Strikes {
+			offset := human.X - strike.X
+			reach := stormStrikeRadius + human.Size*0.6 + stormDodgeMargin
+			if float32(math.Abs(float64(offset))) >= reach {
+				continue
+			}
+
+			// Step out the near side, or the roomier side when dead center
+			direction := float32(1)
+			if offset < 0 || (offset == 0 && strike.X > s.Bounds.Width/2) {
+				direction = -1
+			}
+			human.stormDodge += direction * human.Speed * stormDodgeForce
+		}
+	}
+}
+
+// TakePendingBolts returns the bolts that landed since the last call so the UI can show them once
+func (s *SpaceStorm) TakePendingBolts() []*effects.Lightning {
+	bolts := s.pendingBolts
+	s.pendingBolts = nil
+	return bolts
+}
+
+// GetVisualComponents returns the storm's warning columns for UI management
+func (s *SpaceStorm) GetVisualComponents() []fyne.CanvasObject {
+	components := make([]fyne.CanvasObject, 0, len(s.warnings)+len(s.Strikes))
+	for _, warning := range s.warnings {
+		components = append(components, warning)
+	}
+	for _, strike := range s.Strikes {
+		components = append(components, strike.Warning)
+	}
+	return components
+}
+
+// Hide hides every warning column
+func (s *SpaceStorm) Hide() {
+	for _, component := range s.GetVisualComponents() {
+		component.Hide()
+	}
+}
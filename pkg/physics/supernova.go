@@ -0,0 +1,161 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Supernova tuning
+const (
+	supernovaChance      = float32(0.0004) // chance per frame a background star goes supernova (about every 40 seconds)
+	supernovaFrames      = 90              // frames the shock ring takes to expand and fade (1.5 seconds)
+	supernovaFlashFrames = 20              // frames the backdrop flash takes to fade
+	supernovaFlashAlpha  = 110             // opacity of the backdrop flash at its brightest
+	supernovaPush        = float32(5.0)    // speed a ball right at the star is pushed outward
+	supernovaMaxSpeed    = float32(8.0)    // pushed balls never go faster than this
+	SupernovaRange       = float32(260)    // how far the shock ring expands and the push reaches
+)
+
+// Supernova colors
+var (
+	supernovaRingColor = color.RGBA{R: 220, G: 235, B: 255, A: 255} // Blue-white shock ring
+	supernovaCoreColor = color.RGBA{R: 255, G: 255, B: 240, A: 255} // Blinding core
+)
+
+// Supernova is a background star exploding: a bright ring expanding from the star while the whole
+// backdrop flashes white. The star field publishes EventSupernova so the arena can feel the shock.
+type Supernova struct {
+	X, Y   float32   // where the star exploded
+	Timer  int       // frames left in the explosion (0 = none)
+	Events *EventBus // receives EventSupernova when a star explodes (may be nil)
+	// Visual components
+	Flash *canvas.Rectangle // White backdrop flash
+	Ring  *canvas.Circle    // Expanding shock ring
+	Core  *canvas.Circle    // Shrinking flash where the star was
+}
+
+// NewSupernova creates an idle supernova covering the given bounds with its flash
+func NewSupernova(bounds fyne.Size) *Supernova {
+	s := &Supernova{
+		Flash: canvas.NewRectangle(color.RGBA{R: 255, G: 255, B: 255, A: 0}),
+		Ring:  &canvas.Circle{StrokeColor: supernovaRingColor, StrokeWidth: 4},
+		Core:  &canvas.Circle{FillColor: supernovaCoreColor},
+	}
+	s.Flash.Resize(bounds)
+	s.hide()
+	return s
+}
+
+// IsActive reports whether a supernova is in progress
+func (s *Supernova) IsActive() bool {
+	return s.Timer > 0
+}
+
+// Explode sets off a supernova at (x, y) and announces it on the event bus
+func (s *Supernova) Explode(x, y float32) {
+	s.X, s.Y = x, y
+	s.Timer = supernovaFrames
+	for _, component := range s.GetVisualComponents() {
+		component.Show()
+	}
+	s.draw()
+	s.Events.Publish(Event{Type: EventSupernova, X: x, Y: y})
+}
+
+// Update expands the ring and fades the flash, hiding everything once the explosion is over
+func (s *Supernova) Update() {
+	if !s.IsActive() {
+		return
+	}
+	s.Timer--
+	if s.Timer == 0 {
+		s.hide()
+		return
+	}
+	s.draw()
+}
+
+// draw lays out the ring, core and flash for the current point in the explosion
+func (s *Supernova) draw() {
+	progress := 1 - float32(s.Timer)/supernovaFrames // 0 at the blast, 1 when it's over
+	fade := 1 - progress
+
+	radius := SupernovaRange * float32(math.Sqrt(float64(progress))) // Fast at first, slowing as it spreads
+	s.Ring.StrokeColor = color.RGBA{R: supernovaRingColor.R, G: supernovaRingColor.G, B: supernovaRingColor.B, A: uint8(255 * fade)}
+	s.Ring.StrokeWidth = 2 + 6*fade
+	s.Ring.Resize(fyne.NewSize(radius*2, radius*2))
+	s.Ring.Move(fyne.NewPos(s.X-radius, s.Y-radius))
+	s.Ring.Refresh()
+
+	core := 20 * fade * fade
+	s.Core.Resize(fyne.NewSize(core*2, core*2))
+	s.Core.Move(fyne.NewPos(s.X-core, s.Y-core))
+
+	flash := float32(0)
+	if elapsed := supernovaFrames - s.Timer; elapsed < supernovaFlashFrames {
+		flash = 1 - float32(elapsed)/supernovaFlashFrames
+	}
+	s.Flash.FillColor = color.RGBA{R: 255, G: 255, B: 255, A: uint8(supernovaFlashAlpha * flash)}
+	s.Flash.Refresh()
+}
+
+// hide hides every visual component and ends the explosion
+func (s *Supernova) hide() {
+	s.Timer = 0
+	for _, component := range s.GetVisualComponents() {
+		component.Hide()
+	}
+}
+
+// GetVisualComponents returns the supernova's visual components for UI management
+func (s *Supernova) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{s.Flash, s.Ring, s.Core}
+}
+
+// updateSupernova now and then makes an on-screen star go supernova. The star is used up, so it comes
+// back as a new star at the edge the ship is heading toward (dirX, dirY).
+func (sf *StarField) updateSupernova(dirX, dirY float32) {
+	sf.Supernova.Update()
+	if sf.Supernova.IsActive() || rand.Float32() >= supernovaChance || len(sf.Stars) == 0 {
+		return
+	}
+
+	star := sf.Stars[rand.Intn(len(sf.Stars))]
+	if star == nil || isOffscreen(star.X, star.Y, 0, sf.Bounds) {
+		return
+	}
+	sf.Supernova.Explode(star.X, star.Y)
+	star.X, star.Y = leadingEdgePosition(sf.Bounds, dirX, dirY, 50)
+	sf.regenerateStarProperties(star)
+}
+
+// ApplySupernovaPush pushes the balls near a supernova at (x, y) outward, harder the closer they are
+func ApplySupernovaPush(balls []*Ball, x, y float32) {
+	for _, ball := range balls {
+		if !ball.IsAnimated || ball.IsDestroyed || ball.IsAbsorbed || ball.CarriedBy != nil {
+			continue
+		}
+		dx := ball.X - x
+		dy := ball.Y - y
+		distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+		if distance >= SupernovaRange {
+			continue
+		}
+
+		// Straight on top of the star: push straight up
+		if distance > 0 {
+			dx /= distance
+			dy /= distance
+		} else {
+			dx, dy = 0, -1
+		}
+		push := supernovaPush * (1 - distance/SupernovaRange)
+		ball.VX += dx * push
+		ball.VY += dy * push
+		ball.limitSpeed(supernovaMaxSpeed)
+	}
+}
@@ -0,0 +1,173 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// TetherKind selects how a tether holds its two balls together
+type TetherKind int
+
+// Tether kinds
+const (
+	TetherSpring TetherKind = iota // stretchy: pulls harder the further it is stretched
+	TetherRod                      // rigid: keeps the balls at exactly its length
+)
+
+// Tether tuning
+const (
+	tetherStiffness = float32(0.02) // speed change per pixel of stretch (shared by inverse mass)
+	tetherDamping   = float32(0.05) // fraction of the relative stretch speed removed each frame
+	tetherCutRange  = float32(6)    // how close a bullet must pass to cut the tether
+)
+
+// Tether links two balls with a spring or a rigid rod so they swing around each other
+type Tether struct {
+	A, B      *Ball        // linked balls
+	Kind      TetherKind   // spring or rod
+	Length    float32      // rest length (spring) or fixed length (rod)
+	Line      *canvas.Line // drawn between the two balls
+	IsSevered bool         // whether the tether has been cut (or one of its balls is gone)
+}
+
+// NewTether links two balls at their current distance apart
+func NewTether(a, b *Ball, kind TetherKind) *Tether {
+	dx := b.X - a.X
+	dy := b.Y - a.Y
+
+	line := &canvas.Line{StrokeWidth: 2}
+	if kind == TetherRod {
+		line.StrokeColor = color.RGBA{R: 200, G: 200, B: 210, A: 220} // Steel rod
+		line.StrokeWidth = 3
+	} else {
+		line.StrokeColor = color.RGBA{R: 120, G: 255, B: 180, A: 200} // Glowing spring
+	}
+
+	t := &Tether{
+		A:      a,
+		B:      b,
+		Kind:   kind,
+		Length: float32(math.Sqrt(float64(dx*dx + dy*dy))),
+		Line:   line,
+	}
+	t.UpdatePosition()
+	return t
+}
+
+// Links reports whether the tether joins the given ball to another
+func (t *Tether) Links(ball *Ball) bool {
+	return t.A == ball || t.B == ball
+}
+
+// Solve applies the tether's constraint to its two balls for this frame
+func (t *Tether) Solve() {
+	if t.IsSevered {
+		return
+	}
+	if !t.A.isTetherable() || !t.B.isTetherable() {
+		t.Sever()
+		return
+	}
+
+	dx := t.B.X - t.A.X
+	dy := t.B.Y - t.A.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if distance == 0 {
+		return
+	}
+	nx := dx / distance
+	ny := dy / distance
+	stretch := distance - t.Length
+
+	// Heavier balls move less (share the correction by inverse mass)
+	invMassA := 1 / t.A.GetMass()
+	invMassB := 1 / t.B.GetMass()
+	shareA := invMassA / (invMassA + invMassB)
+	shareB := 1 - shareA
+
+	// Relative speed along the tether (positive = moving apart)
+	separatingSpeed := (t.B.VX-t.A.VX)*nx + (t.B.VY-t.A.VY)*ny
+
+	switch t.Kind {
+	case TetherRod:
+		// Move the balls back to exactly the rod length and cancel any stretching motion
+		t.A.X += nx * stretch * shareA
+		t.A.Y += ny * stretch * shareA
+		t.B.X -= nx * stretch * shareB
+		t.B.Y -= ny * stretch * shareB
+
+		t.A.VX += nx * separatingSpeed * shareA
+		t.A.VY += ny * separatingSpeed * shareA
+		t.B.VX -= nx * separatingSpeed * shareB
+		t.B.VY -= ny * separatingSpeed * shareB
+	default:
+		// Hooke's law with a little damping so the swinging settles down
+		impulse := stretch*tetherStiffness + separatingSpeed*tetherDamping
+		t.A.VX += nx * impulse * shareA
+		t.A.VY += ny * impulse * shareA
+		t.B.VX -= nx * impulse * shareB
+		t.B.VY -= ny * impulse * shareB
+	}
+}
+
+// CheckBullets severs the tether if any active bullet crosses it.
+// It returns true if the tether was cut.
+func (t *Tether) CheckBullets(bullets []*Bullet) bool {
+	if t.IsSevered {
+		return false
+	}
+
+	for _, bullet := range bullets {
+		if bullet.IsActive && distanceToSegment(bullet.X, bullet.Y, t.A.X, t.A.Y, t.B.X, t.B.Y) < tetherCutRange {
+			t.Sever()
+			return true
+		}
+	}
+	return false
+}
+
+// Sever cuts the tether
+func (t *Tether) Sever() {
+	t.IsSevered = true
+	t.Line.Hide()
+}
+
+// UpdatePosition redraws the tether between its balls
+func (t *Tether) UpdatePosition() {
+	if t.IsSevered {
+		return
+	}
+
+	t.Line.Position1 = fyne.NewPos(t.A.X, t.A.Y)
+	t.Line.Position2 = fyne.NewPos(t.B.X, t.B.Y)
+	t.Line.Refresh()
+}
+
+// isTetherable reports whether the ball can still hold a tether
+func (b *Ball) isTetherable() bool {
+	return !b.IsDestroyed && !b.IsAbsorbed
+}
+
+// distanceToSegment returns the distance from point (px, py) to the segment (x1, y1)-(x2, y2)
+func distanceToSegment(px, py, x1, y1, x2, y2 float32) float32 {
+	dx := x2 - x1
+	dy := y2 - y1
+	lengthSquared := dx*dx + dy*dy
+
+	t := float32(0)
+	if lengthSquared > 0 {
+		t = ((px-x1)*dx + (py-y1)*dy) / lengthSquared
+		if t < 0 {
+			t = 0
+		} else if t > 1 {
+			t = 1
+		}
+	}
+
+	cx := x1 + t*dx - px
+	cy := y1 + t*dy - py
+	return float32(math.Sqrt(float64(cx*cx + cy*cy)))
+}
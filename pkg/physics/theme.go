@@ -0,0 +1,227 @@
+package physics
+
+import (
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Theme selects how the balls, humans and dragon are drawn
+type Theme int
+
+// Themes
+const (
+	ThemeEyeball Theme = iota // white bloodshot eyeballs, stick figures and a purple dragon
+	ThemeNeon                 // dark bodies with glowing outlines
+	ThemeFlat                 // solid colors, no outlines, rounded corners
+	ThemeRetro                // green phosphor on black, like an old CRT
+)
+
+// Themes lists every theme in the order they are offered
+var Themes = []Theme{ThemeEyeball, ThemeNeon, ThemeFlat, ThemeRetro}
+
+// CurrentTheme is the theme new entities are drawn with (see the App's SetTheme to restyle existing ones)
+var CurrentTheme = ThemeEyeball
+
+// ThemeStyle maps every entity's parts to the colors, stroke widths and shapes of a theme
+type ThemeStyle struct {
+	// Balls
+	Sclera            color.RGBA // eyeball fill of standard balls
+	ScleraStroke      color.RGBA // eyeball border of standard balls
+	ScleraStrokeWidth float32
+	IrisStrokeWidth   float32
+	Pupil             color.RGBA
+	Veins             color.RGBA // bloodshot veins (transparent = none)
+
+	// Humans
+	HumanFill        color.RGBA // head, body and limbs
+	HumanStroke      color.RGBA // outline (co-op players replace it with their player color)
+	HumanEye         color.RGBA
+	HumanStrokeWidth float32
+
+	// Dragon (the rival dragon keeps its own colors)
+	DragonBody        color.RGBA
+	DragonWing        color.RGBA
+	DragonEye         color.RGBA
+	DragonStroke      color.RGBA
+	DragonStrokeWidth float32 // head and body; wings, tail and eyes get half
+
+	CornerRadius float32 // rounding of rectangular body parts (0 = square)
+}
+
+// themeStyles holds every theme's style
+var themeStyles = map[Theme]ThemeStyle{
+	ThemeEyeball: {
+		Sclera:            color.RGBA{R: 255, G: 255, B: 255, A: 255},
+		ScleraStroke:      color.RGBA{R: 200, G: 200, B: 200, A: 255},
+		ScleraStrokeWidth: 2,
+		IrisStrokeWidth:   1,
+		Pupil:             color.RGBA{R: 0, G: 0, B: 0, A: 255},
+		Veins:             color.RGBA{R: 200, G: 50, B: 50, A: 180},
+		HumanFill:         color.RGBA{R: 255, G: 255, B: 255, A: 255},
+		HumanStroke:       color.RGBA{R: 0, G: 0, B: 0, A: 255},
+		HumanEye:          color.RGBA{R: 0, G: 0, B: 0, A: 255},
+		HumanStrokeWidth:  2,
+		DragonBody:        color.RGBA{R: 150, G: 50, B: 200, A: 255},
+		DragonWing:        color.RGBA{R: 100, G: 30, B: 150, A: 255},
+		DragonEye:         color.RGBA{R: 255, G: 255, B: 0, A: 255},
+		DragonStroke:      color.RGBA{R: 0, G: 0, B: 0, A: 255},
+		DragonStrokeWidth: 2,
+	},
+	ThemeNeon: {
+		Sclera:            color.RGBA{R: 15, G: 15, B: 30, A: 255},
+		ScleraStroke:      color.RGBA{R: 0, G: 255, B: 255, A: 255},
+		ScleraStrokeWidth: 3,
+		IrisStrokeWidth:   2,
+		Pupil:             color.RGBA{R: 255, G: 0, B: 200, A: 255},
+		HumanFill:         color.RGBA{R: 10, G: 10, B: 25, A: 255},
+		HumanStroke:       color.RGBA{R: 255, G: 0, B: 200, A: 255},
+		HumanEye:          color.RGBA{R: 0, G: 255, B: 255, A: 255},
+		HumanStrokeWidth:  3,
+		DragonBody:        color.RGBA{R: 20, G: 0, B: 40, A: 255},
+		DragonWing:        color.RGBA{R: 40, G: 0, B: 60, A: 255},
+		DragonEye:         color.RGBA{R: 0, G: 255, B: 120, A: 255},
+		DragonStroke:      color.RGBA{R: 200, G: 80, B: 255, A: 255},
+		DragonStrokeWidth: 3,
+		CornerRadius:      4,
+	},
+	ThemeFlat: { // No outlines anywhere
+		Sclera:       color.RGBA{R: 240, G: 240, B: 235, A: 255},
+		Pupil:        color.RGBA{R: 40, G: 40, B: 50, A: 255},
+		HumanFill:    color.RGBA{R: 250, G: 200, B: 120, A: 255},
+		HumanEye:     color.RGBA{R: 40, G: 40, B: 50, A: 255},
+		DragonBody:   color.RGBA{R: 110, G: 90, B: 200, A: 255},
+		DragonWing:   color.RGBA{R: 80, G: 65, B: 160, A: 255},
+		DragonEye:    color.RGBA{R: 255, G: 210, B: 60, A: 255},
+		CornerRadius: 6,
+	},
+	ThemeRetro: {
+		Sclera:            color.RGBA{R: 0, G: 20, B: 0, A: 255},
+		ScleraStroke:      color.RGBA{R: 50, G: 255, B: 80, A: 255},
+		ScleraStrokeWidth: 2,
+		IrisStrokeWidth:   1,
+		Pupil:             color.RGBA{R: 50, G: 255, B: 80, A: 255},
+		Veins:             color.RGBA{R: 20, G: 120, B: 40, A: 160},
+		HumanFill:         color.RGBA{R: 0, G: 20, B: 0, A: 255},
+		HumanStroke:       color.RGBA{R: 50, G: 255, B: 80, A: 255},
+		HumanEye:          color.RGBA{R: 50, G: 255, B: 80, A: 255},
+		HumanStrokeWidth:  2,
+		DragonBody:        color.RGBA{R: 0, G: 40, B: 0, A: 255},
+		DragonWing:        color.RGBA{R: 0, G: 25, B: 0, A: 255},
+		DragonEye:         color.RGBA{R: 180, G: 255, B: 180, A: 255},
+		DragonStroke:      color.RGBA{R: 50, G: 255, B: 80, A: 255},
+		DragonStrokeWidth: 2,
+	},
+}
+
+// String returns the theme's display name
+func (t Theme) String() string {
+	switch t {
+	case ThemeNeon:
+		return "Neon"
+	case ThemeFlat:
+		return "Minimal Flat"
+	case ThemeRetro:
+		return "Retro CRT"
+	default:
+		return "Eyeball"
+	}
+}
+
+// Next returns the theme after this one, cycling back to ThemeEyeball
+func (t Theme) Next() Theme {
+	return (t + 1) % (ThemeRetro + 1)
+}
+
+// ThemeByName returns the theme with the given display name (any case), or ThemeEyeball for unknown names
+func ThemeByName(name string) Theme {
+	for _, theme := range Themes {
+		if strings.EqualFold(theme.String(), name) {
+			return theme
+		}
+	}
+	return ThemeEyeball
+}
+
+// Style returns how the theme draws each entity
+func (t Theme) Style() ThemeStyle {
+	return themeStyles[t]
+}
+
+// ApplyTheme restyles the ball's eyeball with the current theme, keeping its iris color and kind styling
+func (b *Ball) ApplyTheme() {
+	style := CurrentTheme.Style()
+	b.applyKindStyle()
+	b.Iris.StrokeWidth = style.IrisStrokeWidth
+	b.Pupil.FillColor = style.Pupil
+	b.Pupil.StrokeColor = style.Pupil
+	for _, vein := range b.BloodVeins {
+		vein.StrokeColor = style.Veins
+		vein.Refresh()
+	}
+	b.Iris.Refresh()
+	b.Pupil.Refresh()
+}
+
+// ApplyTheme restyles the human's figure with the current theme
+func (h *Human) ApplyTheme() {
+	style := CurrentTheme.Style()
+	for _, part := range []*canvas.Rectangle{h.Body, h.LeftArm, h.RightArm, h.LeftLeg, h.RightLeg} {
+		part.FillColor = style.HumanFill
+		part.StrokeColor = style.HumanStroke
+		part.StrokeWidth = style.HumanStrokeWidth
+		part.CornerRadius = style.CornerRadius
+		part.Refresh()
+	}
+	h.Head.FillColor = style.HumanFill
+	h.Head.StrokeColor = style.HumanStroke
+	h.Head.StrokeWidth = style.HumanStrokeWidth
+	h.Head.Refresh()
+
+	// Eyes in the eye color ringed by the body color, with pupils of the body color
+	for _, eye := range []*canvas.Circle{h.LeftEye, h.RightEye} {
+		eye.FillColor = style.HumanEye
+		eye.StrokeColor = style.HumanFill
+		eye.Refresh()
+	}
+	for _, pupil := range []*canvas.Circle{h.LeftPupil, h.RightPupil} {
+		pupil.FillColor = style.HumanFill
+		pupil.StrokeColor = style.HumanEye
+		pupil.Refresh()
+	}
+}
+
+// ApplyTheme restyles the dragon with the current theme. A rival dragon keeps its own colors and only
+// takes the theme's outlines and shapes.
+func (d *Dragon) ApplyTheme() {
+	style := CurrentTheme.Style()
+	if !d.Rival {
+		d.Head.FillColor = style.DragonBody
+		d.Body.FillColor = style.DragonBody
+		d.Tail.FillColor = style.DragonBody
+		d.LeftWing.FillColor = style.DragonWing
+		d.RightWing.FillColor = style.DragonWing
+		d.LeftEye.FillColor = style.DragonEye
+		d.RightEye.FillColor = style.DragonEye
+	}
+
+	d.Head.StrokeColor = style.DragonStroke
+	d.Head.StrokeWidth = style.DragonStrokeWidth
+	d.Head.Refresh()
+	d.Body.StrokeColor = style.DragonStroke
+	d.Body.StrokeWidth = style.DragonStrokeWidth
+	d.Body.CornerRadius = style.CornerRadius
+	d.Body.Refresh()
+	for _, part := range []*canvas.Rectangle{d.Tail, d.LeftWing, d.RightWing} {
+		part.StrokeColor = style.DragonStroke
+		part.StrokeWidth = style.DragonStrokeWidth / 2
+		part.CornerRadius = style.CornerRadius
+		part.Refresh()
+	}
+	for _, eye := range []*canvas.Circle{d.LeftEye, d.RightEye} {
+		eye.StrokeColor = style.DragonStroke
+		eye.StrokeWidth = style.DragonStrokeWidth / 2
+		eye.Refresh()
+	}
+}
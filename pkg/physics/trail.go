@@ -0,0 +1,216 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Ribbon trail tuning
+const (
+	trailSegments     = 12 // connected segments in a ball's ribbon
+	trailSampleFrames = 2  // frames between a ball's position samples
+)
+
+// Bullet streak and dragon smoke tuning
+const (
+	bulletTrailSegments = 6             // segments in a bullet's streak
+	bulletTrailWidth    = float32(2)    // streak width at the bullet
+	bulletTrailAlpha    = float32(200)  // streak opacity at the bullet
+	smokeTrailSegments  = 14            // segments in the dragon's smoke trail
+	smokeSampleFrames   = 3             // frames between smoke samples
+	smokeTrailWidth     = float32(0.5)  // smoke width at the dragon, as a fraction of its size
+	smokeTrailAlpha     = float32(70)   // smoke opacity at the dragon
+	smokeTrailFadeRate  = float32(0.08) // how quickly the smoke fades in and out each frame
+)
+
+// TrailsEnabled turns ball trails, bullet streaks and dragon smoke on or off globally
+// (off saves canvas updates on slow machines)
+var TrailsEnabled = true
+
+// TrailLength is the fraction of every ribbon's segments that are drawn (set by the effects quality)
+var TrailLength = float32(1)
+
+var (
+	bulletTrailColor = color.RGBA{R: 80, G: 240, B: 255, A: 255}  // Cyan
+	smokeTrailColor  = color.RGBA{R: 170, G: 170, B: 180, A: 255} // Pale gray
+)
+
+// Ribbon is a trail of connected line segments running from an entity back through its recent positions,
+// fading and narrowing toward the tail. Balls, bullets and the dragon each drag one behind them.
+// A nil Ribbon ignores every call, so entities without one simply show no trail.
+type Ribbon struct {
+	Segments     []*canvas.Line  // connected fading segments, newest first
+	SampleFrames int             // frames between position samples
+	points       []fyne.Position // sampled past positions, newest first
+	counter      int             // frames since the last sample
+}
+
+// NewRibbon creates a ribbon of the given number of segments, sampling the entity's position every few frames
+func NewRibbon(segments, sampleFrames int) *Ribbon {
+	r := &Ribbon{
+		Segments:     make([]*canvas.Line, segments),
+		SampleFrames: sampleFrames,
+		points:       make([]fyne.Position, 0, segments),
+	}
+	for i := range r.Segments {
+		segment := &canvas.Line{
+			StrokeColor: color.RGBA{R: 255, G: 255, B: 255, A: 0},
+			StrokeWidth: 0,
+		}
+		segment.Hide() // Shown once there is history to draw
+		r.Segments[i] = segment
+	}
+	return r
+}
+
+// Sample records the entity's position into the ribbon's history every few frames
+func (r *Ribbon) Sample(x, y float32) {
+	if r == nil {
+		return
+	}
+	r.counter++
+	if r.counter < r.SampleFrames {
+		return
+	}
+	r.counter = 0
+
+	// Shift history back and put the newest point first
+	if len(r.points) < len(r.Segments) {
+		r.points = append(r.points, fyne.Position{})
+	}
+	copy(r.points[1:], r.points[:len(r.points)-1])
+	r.points[0] = fyne.NewPos(x, y)
+}
+
+// Draw redraws the ribbon from the entity at (x, y) back through its history, starting width wide and
+// alpha opaque (0 to 255) at the entity and fading and narrowing toward the tail
+func (r *Ribbon) Draw(x, y, width float32, tint color.RGBA, alpha float32) {
+	if r == nil {
+		return
+	}
+	if !TrailsEnabled {
+		r.Clear()
+		return
+	}
+
+	// Lower effect qualities draw shorter ribbons
+	drawn := min(max(int(float32(len(r.Segments))*TrailLength+0.5), 1), len(r.Segments))
+
+	previous := fyne.NewPos(x, y)
+	for i, segment := range r.Segments {
+		if i >= len(r.points) || i >= drawn {
+			segment.Hide()
+			continue
+		}
+
+		// Segments fade and narrow toward the tail
+		fade := 1 - float32(i)/float32(drawn)
+		point := r.points[i]
+
+		segment.Position1 = previous
+		segment.Position2 = point
+		segment.StrokeWidth = width * fade
+		segment.StrokeColor = color.RGBA{R: tint.R, G: tint.G, B: tint.B, A: uint8(alpha * fade)}
+		segment.Show()
+		segment.Refresh()
+
+		previous = point
+	}
+}
+
+// Clear forgets the ribbon's history and hides it, so it doesn't stretch across a jump (wall wrap, wormhole)
+func (r *Ribbon) Clear() {
+	if r == nil {
+		return
+	}
+	r.points = r.points[:0]
+	r.counter = 0
+	r.Hide()
+}
+
+// Hide hides every segment (the history is kept)
+func (r *Ribbon) Hide() {
+	if r == nil {
+		return
+	}
+	for _, segment := range r.Segments {
+		segment.Hide()
+	}
+}
+
+// GetVisualComponents returns the ribbon's segments, to be drawn behind the entity
+func (r *Ribbon) GetVisualComponents() []fyne.CanvasObject {
+	if r == nil {
+		return nil
+	}
+	components := make([]fyne.CanvasObject, len(r.Segments))
+	for i, segment := range r.Segments {
+		components[i] = segment
+	}
+	return components
+}
+
+// initializeTrail creates the ribbon trail for the ball
+func (b *Ball) initializeTrail() {
+	// Clean up any existing ribbon first
+	b.Trail.Hide()
+	b.Trail = NewRibbon(trailSegments, trailSampleFrames)
+}
+
+// sampleTrail records the ball's position into the trail history every few frames
+func (b *Ball) sampleTrail() {
+	b.Trail.Sample(b.X, b.Y)
+}
+
+// updateTrail redraws the ribbon from the ball back through its position history.
+// Faster balls get a wider, brighter ribbon.
+func (b *Ball) updateTrail() {
+	if b.Trail == nil || b.IsDestroyed || b.IsAbsorbed {
+		return
+	}
+
+	speed := float32(math.Sqrt(float64(b.VX*b.VX + b.VY*b.VY)))
+	speedFactor := speed / 2
+	if speedFactor < 0.3 {
+		speedFactor = 0.3
+	} else if speedFactor > 1.5 {
+		speedFactor = 1.5
+	}
+	brightness := speedFactor
+	if brightness > 1 {
+		brightness = 1
+	}
+
+	ribbonColor := color.RGBAModel.Convert(b.Iris.FillColor).(color.RGBA)
+	b.Trail.Draw(b.X, b.Y, b.Radius*0.5*speedFactor, ribbonColor, 180*brightness)
+}
+
+// updateTrail streaks the bullet's path in thin cyan
+func (b *Bullet) updateTrail() {
+	b.Trail.Sample(b.X, b.Y)
+	b.Trail.Draw(b.X, b.Y, bulletTrailWidth, bulletTrailColor, bulletTrailAlpha)
+}
+
+// updateSmoke leaves a faint smoke trail behind the dragon while it intercepts, fading it in and out
+// rather than cutting it off
+func (d *Dragon) updateSmoke() {
+	if d.Smoke == nil {
+		return
+	}
+
+	if d.IsActive && d.State == DragonIntercept {
+		d.smokeFade = min(d.smokeFade+smokeTrailFadeRate, 1)
+	} else {
+		d.smokeFade = max(d.smokeFade-smokeTrailFadeRate, 0)
+	}
+	if d.smokeFade == 0 {
+		d.Smoke.Clear()
+		return
+	}
+
+	d.Smoke.Sample(d.X, d.Y)
+	d.Smoke.Draw(d.X, d.Y, d.Size*smokeTrailWidth, smokeTrailColor, smokeTrailAlpha*d.smokeFade)
+}
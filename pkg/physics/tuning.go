@@ -0,0 +1,258 @@
+package physics
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"sync/atomic"
+	"time"
+)
+
+// TuningValues holds gameplay numbers that designers can tweak live from a tuning file in dev mode
+type TuningValues struct {
+	// Human
+	HumanSpeed         float32 `json:"human_speed"`          // movement speed
+	HumanDangerMargin  float32 `json:"human_danger_margin"`  // clearance around predicted ball positions the human treats as dangerous
+	HumanShootCooldown int     `json:"human_shoot_cooldown"` // frames between shots
+	DangerHorizon      int     `json:"danger_horizon"`       // frames of ball movement the human's danger map predicts
+	DangerWallPenalty  float32 `json:"danger_wall_penalty"`  // extra danger the human sees in cells along a wall
+	DangerTravelCost   float32 `json:"danger_travel_cost"`   // danger added per cell travelled, so nearby safe spots win
+	MeleeCooldown      int     `json:"melee_cooldown"`       // frames between melee swipes
+	MeleeRangeFactor   float32 `json:"melee_range_factor"`   // swipe reach as a multiple of the human's size
+	MeleeKnockback     float32 `json:"melee_knockback"`      // speed a swipe adds to a ball, away from the human
+	MeleeMaxSpeed      float32 `json:"melee_max_speed"`      // fastest a swiped ball flies
+	BulletSpeed        float32 `json:"bullet_speed"`         // bullet speed in pixels per frame
+	BulletMaxBounces   int     `json:"bullet_max_bounces"`   // wall bounces before a bullet despawns
+	BulletTTL          int     `json:"bullet_ttl"`           // frames before a bullet despawns (0 = until it leaves the screen)
+	FiringOrbitSpeed   float32 `json:"firing_orbit_speed"`   // radians per frame the firing point orbits in the orbit pattern
+	FiringTangential   bool    `json:"firing_tangential"`    // orbit pattern fires along the orbit instead of at the target
+	FiringBurstCount   int     `json:"firing_burst_count"`   // bullet origins around the circle in the radial burst pattern
+	// Balls
+	JiggleDecay         float32 `json:"jiggle_decay"`          // how fast jiggle fades (closer to 1 = longer wobble)
+	BallGrowthRate      float32 `json:"ball_growth_rate"`      // radius regained per frame after shrinking
+	MergeSpeedThreshold float32 `json:"merge_speed_threshold"` // max relative speed at which same-colored balls merge
+	PupilMinRatio       float32 `json:"pupil_min_ratio"`       // pupil size (fraction of eyeball radius) when the human is far away
+	PupilMaxRatio       float32 `json:"pupil_max_ratio"`       // pupil size (fraction of eyeball radius) when the human is touching
+	PupilDilationRange  float32 `json:"pupil_dilation_range"`  // distance from the human at which the pupil is fully constricted
+	// Dragon
+	DragonSpeed          float32 `json:"dragon_speed"`           // movement speed
+	DragonFollowDistance float32 `json:"dragon_follow_distance"` // preferred distance from the human
+	DragonProtectRadius  float32 `json:"dragon_protect_radius"`  // radius around the human where balls are intercepted
+	DragonBurnFrames     int     `json:"dragon_burn_frames"`     // frames a ball burns after touching the dragon's flames
+	DragonInterceptScale float32 `json:"dragon_intercept_scale"` // speed multiplier while intercepting a ball
+	DragonMaxLead        float32 `json:"dragon_max_lead"`        // farthest ahead (in frames) the dragon predicts a ball's path
+	// Aliens
+	AlienCount int `json:"alien_count"` // aliens in the fleet drifting through the star field
+	// Star field
+	NebulaDensity  float32 `json:"nebula_density"`  // nebula clouds per 100,000 square pixels behind the stars
+	AmbiencePeriod float32 `json:"ambience_period"` // seconds for the backdrop to cycle from deep blue through purple and black (0 = off)
+	TwinkleStride  int     `json:"twinkle_stride"`  // stars twinkle in turns, 1/stride of them each frame (1 = all)
+	// Hazards
+	LaserSweepSpeed float32 `json:"laser_sweep_speed"` // laser sweep speed in pixels per frame
+	LaserStunFrames int     `json:"laser_stun_frames"` // frames a ball stays stunned after a laser hit
+	// Effects
+	EffectsQuality string `json:"effects_quality"` // "Low", "Medium" or "High": scales particles, trails, stars and twinkling
+	ScreenShake    bool   `json:"screen_shake"`    // jolt the game area on big impacts (false = off)
+	HitStop        bool   `json:"hit_stop"`        // freeze the action for a moment on player deaths and boss kills (false = off)
+	MotionBlur     bool   `json:"motion_blur"`     // fast balls and sprinting players leave fading ghosts (false = off; read when they are created)
+	Vignette       bool   `json:"vignette"`        // pulse the screen edges red on low health and flash white on explosions (false = off)
+}
+
+// DefaultTuning returns the built-in tuning values
+func DefaultTuning() TuningValues {
+	return TuningValues{
+		HumanSpeed:           4.5, // Increased from 2.0 to 4.5 for much faster movement
+		HumanDangerMargin:    120, // Increased from 50 to 120
+		HumanShootCooldown:   15,  // Shoot every 15 frames (4 times per second at 60 FPS)
+		DangerHorizon:        180, // 3 seconds
+		DangerWallPenalty:    0.4, // Corners pay it twice
+		DangerTravelCost:     0.05,
+		MeleeCooldown:        45, // Separate from the shooting cooldown
+		MeleeRangeFactor:     1.2,
+		MeleeKnockback:       6.0,
+		MeleeMaxSpeed:        10.0,
+		BulletSpeed:          8.0, // Fast bullet speed
+		BulletMaxBounces:     0,   // Bullets leave the screen at the first wall
+		BulletTTL:            0,
+		FiringOrbitSpeed:     0.05, // About one orbit every two seconds
+		FiringTangential:     false,
+		FiringBurstCount:     8,
+		JiggleDecay:          0.88,
+		BallGrowthRate:       0.02, // ~1 pixel per second
+		MergeSpeedThreshold:  1.5,
+		PupilMinRatio:        0.18,
+		PupilMaxRatio:        0.5, // Just short of the iris (60% of the eyeball)
+		PupilDilationRange:   400,
+		DragonSpeed:          2.0, // Slower, more controlled movement
+		DragonFollowDistance: 80.0,
+		DragonProtectRadius:  150.0,
+		DragonBurnFrames:     180, // 3 seconds of burning
+		DragonInterceptScale: 1.2,
+		DragonMaxLead:        90,
+		AlienCount:           3,
+		NebulaDensity:        0.8, // A handful of clouds in the default window
+		AmbiencePeriod:       180, // A slow three-minute cycle
+		TwinkleStride:        1,
+		LaserSweepSpeed:      3.0, // Slow enough to dodge
+		LaserStunFrames:      90,  // 1.5 seconds stun
+		EffectsQuality:       "High",
+		ScreenShake:          true,
+		HitStop:              true,
+		MotionBlur:           true,
+		Vignette:             true,
+	}
+}
+
+// tuning is the tuning registry read by gameplay code. Each set of values is published whole and never
+// changed afterwards, so the UI can read it while the game loop reloads it.
+var tuning atomic.Pointer[TuningValues]
+
+func init() {
+	SetTuning(DefaultTuning())
+}
+
+// Tuning returns the current tuning values
+func Tuning() TuningValues {
+	return *tuning.Load()
+}
+
+// SetTuning publishes new tuning values
+func SetTuning(values TuningValues) {
+	tuning.Store(&values)
+}
+
+// UpdateTuning publishes a copy of the current tuning values with the changes update makes
+func UpdateTuning(update func(*TuningValues)) {
+	values := Tuning()
+	update(&values)
+	SetTuning(values)
+}
+
+// LoadTuning reads a JSON tuning file into the registry. Keys missing from the file keep their default values.
+func LoadTuning(path string) error {
+	values, err := readTuning(path)
+	if err != nil {
+		return err
+	}
+	SetTuning(values)
+	return nil
+}
+
+// readTuning reads a JSON tuning file on top of the default values
+func readTuning(path string) (TuningValues, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return TuningValues{}, err
+	}
+
+	values := DefaultTuning()
+	if err := json.Unmarshal(data, &values); err != nil {
+		return TuningValues{}, err
+	}
+	return values, nil
+}
+
+// copyChangedTuning copies onto dst the values that differ between previous and next
+func copyChangedTuning(dst *TuningValues, previous, next TuningValues) {
+	d := reflect.ValueOf(dst).Elem()
+	p := reflect.ValueOf(previous)
+	n := reflect.ValueOf(next)
+	for i := 0; i < n.NumField(); i++ {
+		if !p.Field(i).Equal(n.Field(i)) {
+			d.Field(i).Set(n.Field(i))
+		}
+	}
+}
+
+// TuningWatcher reloads a tuning file whenever it changes on disk
+type TuningWatcher struct {
+	Path    string       // tuning file path
+	modTime time.Time    // modification time of the last load
+	loaded  TuningValues // the file's values at the last load (the defaults before the first)
+}
+
+// NewTuningWatcher creates a watcher for the given tuning file
+func NewTuningWatcher(path string) *TuningWatcher {
+	return &TuningWatcher{Path: path, loaded: DefaultTuning()}
+}
+
+// Poll reloads the tuning file if it changed since the last load, publishing only the values that
+// changed in the file, so settings the player changed in the meantime survive an unrelated edit.
+// It returns true when new values were loaded.
+func (w *TuningWatcher) Poll() (bool, error) {
+	info, err := os.Stat(w.Path)
+	if err != nil {
+		return false, err
+	}
+	if !info.ModTime().After(w.modTime) {
+		return false, nil
+	}
+
+	// Remember the time even on a bad file so a broken save is reported once, not every poll
+	w.modTime = info.ModTime()
+	values, err := readTuning(w.Path)
+	if err != nil {
+		return false, err
+	}
+	UpdateTuning(func(t *TuningValues) {
+		copyChangedTuning(t, w.loaded, values)
+	})
+	w.loaded = values
+	return true, nil
+}
+
+// ApplyTuning copies the tuning values that changed since previous onto an existing ball
+func (b *Ball) ApplyTuning(previous TuningValues) {
+	t := Tuning()
+	if t.JiggleDecay != previous.JiggleDecay {
+		b.JiggleDecay = t.JiggleDecay
+	}
+	if t.BallGrowthRate != previous.BallGrowthRate {
+		b.GrowthRate = t.BallGrowthRate
+	}
+}
+
+// ApplyTuning copies the tuning values that changed since previous onto the human. Values left alone
+// keep whatever the player changed them to (weapon upgrades, the settings' human speed).
+func (h *Human) ApplyTuning(previous TuningValues) {
+	t := Tuning()
+	if t.HumanSpeed != previous.HumanSpeed {
+		h.Speed = t.HumanSpeed
+	}
+	if t.HumanShootCooldown != previous.HumanShootCooldown {
+		h.ShootCooldown = t.HumanShootCooldown
+	}
+	if t.BulletMaxBounces != previous.BulletMaxBounces {
+		h.BulletConfig.MaxBounces = t.BulletMaxBounces
+	}
+	if t.BulletTTL != previous.BulletTTL {
+		h.BulletConfig.TTL = t.BulletTTL
+	}
+	if t.FiringOrbitSpeed != previous.FiringOrbitSpeed {
+		h.Firing.OrbitSpeed = t.FiringOrbitSpeed
+	}
+	if t.FiringTangential != previous.FiringTangential {
+		h.Firing.Tangential = t.FiringTangential
+	}
+	if t.FiringBurstCount != previous.FiringBurstCount {
+		h.Firing.BurstCount = t.FiringBurstCount
+	}
+}
+
+// ApplyTuning copies the tuning values that changed since previous onto the dragon, leaving the dragon
+// settings' follow distance and protect radius alone otherwise
+func (d *Dragon) ApplyTuning(previous TuningValues) {
+	t := Tuning()
+	if t.DragonSpeed != previous.DragonSpeed {
+		d.Speed = t.DragonSpeed
+	}
+	if t.DragonFollowDistance != previous.DragonFollowDistance {
+		d.FollowDistance = t.DragonFollowDistance
+	}
+	if t.DragonProtectRadius != previous.DragonProtectRadius {
+		d.SetProtectRadius(t.DragonProtectRadius)
+	}
+	if t.DragonBurnFrames != previous.DragonBurnFrames {
+		d.BurnFrames = t.DragonBurnFrames
+	}
+}
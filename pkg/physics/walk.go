@@ -0,0 +1,63 @@
+package physics
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// Walk animation tuning
+const (
+	walkStrideRate = float32(0.12) // gait phase (radians) advanced per pixel walked
+	walkStride     = float32(0.15) // forward/back leg swing relative to the human size
+	walkLift       = float32(0.15) // how much a swinging leg shortens (knee bend) relative to the human size
+	walkBlend      = float32(0.2)  // how quickly the gait eases in when starting and out when stopping
+	walkIdleSpeed  = float32(0.1)  // below this speed (pixels per frame) the human settles into the idle pose
+)
+
+// updateGait advances the walk cycle by the distance the human moved this frame.
+// The stride grows with speed and eases back to the idle pose when the human stops.
+func (h *Human) updateGait(moveX, moveY float32) {
+	speed := float32(math.Sqrt(float64(moveX*moveX + moveY*moveY)))
+
+	target := float32(0)
+	if speed > walkIdleSpeed && h.Speed > 0 {
+		target = speed / h.Speed
+		if target > 1 {
+			target = 1
+		}
+		h.walkPhase = float32(math.Mod(float64(h.walkPhase+speed*walkStrideRate), 2*math.Pi))
+		h.walkDirX = moveX / speed
+	}
+	h.gait += (target - h.gait) * walkBlend
+}
+
+// updateLegs places the legs for the current point in the walk cycle.
+// The legs swing in opposite directions along the horizontal walking direction and the
+// forward-swinging leg bends, so walking straight up or down still lifts the feet in turn.
+func (h *Human) updateLegs() {
+	legWidth := h.Size * 0.2
+	legHeight := h.Size * 0.5
+	legY := h.Y + h.Size*0.3
+	leftX := h.X - h.Size*0.25
+	rightX := h.X + h.Size*0.05
+	var leftLift, rightLift float32
+
+	if h.gait > 0.01 {
+		swing := float32(math.Sin(float64(h.walkPhase))) * h.gait
+		offset := swing * walkStride * h.Size * h.walkDirX
+		leftX += offset
+		rightX -= offset
+		if swing > 0 {
+			leftLift = swing * walkLift * h.Size
+		} else {
+			rightLift = -swing * walkLift * h.Size
+		}
+	}
+
+	h.LeftLeg.Move(fyne.NewPos(leftX, legY))
+	h.LeftLeg.Resize(fyne.NewSize(legWidth, legHeight-leftLift))
+
+	h.RightLeg.Move(fyne.NewPos(rightX, legY))
+	h.RightLeg.Resize(fyne.NewSize(legWidth, legHeight-rightLift))
+}
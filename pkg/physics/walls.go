@@ -0,0 +1,75 @@
+package physics
+
+// WallMode selects what happens when a ball reaches the edge of the arena
+type WallMode int
+
+// Wall modes
+const (
+	WallBounce WallMode = iota // balls bounce off the walls
+	WallWrap                   // balls leave one side and come back in on the opposite side
+	WallAbsorb                 // balls are removed once they leave the arena
+)
+
+// String returns the mode's display name
+func (m WallMode) String() string {
+	switch m {
+	case WallWrap:
+		return "Wrap"
+	case WallAbsorb:
+		return "Absorb"
+	default:
+		return "Bounce"
+	}
+}
+
+// Next returns the mode after this one, cycling back to WallBounce
+func (m WallMode) Next() WallMode {
+	return (m + 1) % (WallAbsorb + 1)
+}
+
+// wallMode returns the world's wall mode, or WallBounce for a ball outside any world
+func (w *World) wallMode() WallMode {
+	if w == nil {
+		return WallBounce
+	}
+	return w.WallMode
+}
+
+// wrapAroundWalls moves a ball that has completely left one side of the arena to just outside the opposite side
+func (b *Ball) wrapAroundWalls() {
+	wrapped := false
+
+	if b.X < -b.Radius {
+		b.X = b.Bounds.Width + b.Radius
+		wrapped = true
+	} else if b.X > b.Bounds.Width+b.Radius {
+		b.X = -b.Radius
+		wrapped = true
+	}
+
+	if b.Y < -b.Radius {
+		b.Y = b.Bounds.Height + b.Radius
+		wrapped = true
+	} else if b.Y > b.Bounds.Height+b.Radius {
+		b.Y = -b.Radius
+		wrapped = true
+	}
+
+	// Don't draw the trail ribbon across the whole arena
+	if wrapped {
+		b.Trail.Clear()
+	}
+}
+
+// isOutsideArena reports whether the ball has completely left the arena
+func (b *Ball) isOutsideArena() bool {
+	return b.X < -b.Radius || b.X > b.Bounds.Width+b.Radius ||
+		b.Y < -b.Radius || b.Y > b.Bounds.Height+b.Radius
+}
+
+// leaveArena removes a ball that flew out of the arena (no explosion, no score)
+func (b *Ball) leaveArena() {
+	b.IsDestroyed = true
+	b.IsAnimated = false
+	b.hideEyeball()
+}
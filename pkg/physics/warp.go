@@ -0,0 +1,48 @@
+package physics
+
+// MaxWarp is the highest warp level
+const MaxWarp = 3
+
+// Warp tuning
+const (
+	warpEase        = float32(0.03) // fraction of the way the speed eases toward the warp level's speed each frame
+	warpStreakScale = float32(4)    // streak length as a multiple of the distance a star moves per frame
+	warpStreakStart = float32(1.5)  // speed multiplier above which stars stretch into streaks
+)
+
+// warpSpeeds holds each warp level's travel speed multiplier
+var warpSpeeds = [MaxWarp + 1]float32{1, 4, 10, 20}
+
+// SetWarp sets the warp level (0 = normal travel, up to MaxWarp). Higher levels travel dramatically
+// faster, stretching the stars into motion-blur streaks; the speed eases in and out.
+func (sf *StarField) SetWarp(level int) {
+	if level < 0 {
+		level = 0
+	} else if level > MaxWarp {
+		level = MaxWarp
+	}
+	sf.Warp = level
+}
+
+// updateWarp eases the travel speed multiplier toward the warp level's speed
+func (sf *StarField) updateWarp() {
+	sf.warpFactor += (warpSpeeds[sf.Warp] - sf.warpFactor) * warpEase
+}
+
+// isStreaking reports whether the star field is going fast enough to draw stars as streaks
+func (sf *StarField) isStreaking() bool {
+	return sf.warpFactor > warpStreakStart
+}
+
+// updateStreak draws the star as a streak trailing back along its motion, step pixels per frame in the
+// direction opposite (dirX, dirY), or as a dot when not streaking
+func (s *Star) updateStreak(streaking bool, dirX, dirY, step float32) {
+	s.IsStreaking = streaking
+	if !streaking {
+		return
+	}
+
+	length := step * warpStreakScale
+	s.TailX = s.X + dirX*length
+	s.TailY = s.Y + dirY*length
+}
@@ -0,0 +1,170 @@
+package physics
+
+import "math/rand"
+
+// Wave tuning
+const (
+	waveBaseBalls    = 3             // balls in the first wave (each later wave adds one)
+	waveBaseSpeed    = float32(1.5)  // ball speed in the first wave
+	waveSpeedStep    = float32(0.15) // extra speed per wave, as a fraction of the base speed
+	waveMaxSpeed     = float32(5.0)  // balls never spawn faster than this
+	waveBaseRadius   = float32(25)   // ball radius in the first wave
+	waveRadiusStep   = float32(3)    // extra radius per wave
+	waveRadiusJitter = float32(5)    // random extra radius per ball
+	defaultWaveSpawn = 180           // frames between spawns (3 seconds)
+	defaultWaveBreak = 180           // frames between waves (3 seconds)
+	bossWaveInterval = 5             // every fifth wave ends with a boss
+)
+
+// WaveSpawn describes a ball the wave manager wants spawned
+type WaveSpawn struct {
+	Radius float32  // ball radius (the spawner keeps it within the world's ball size range)
+	Speed  float32  // ball speed (direction is up to the spawner)
+	Kind   BallKind // ball kind
+}
+
+// WaveDefinition sets up one wave of a level's wave schedule. Zero numbers keep the built-in escalation's value;
+// a scheduled wave only ends with a boss when Boss says so.
+type WaveDefinition struct {
+	Balls         int     `json:"balls"`          // balls in the wave
+	Speed         float32 `json:"speed"`          // ball speed
+	SpawnInterval int     `json:"spawn_interval"` // frames between spawns
+	Boss          bool    `json:"boss"`           // whether the wave ends with a boss
+}
+
+// WaveManager runs escalating waves of balls: each wave spawns more, faster and larger
+// balls one every SpawnInterval frames, and ends once all of them are destroyed
+type WaveManager struct {
+	Wave          int              // current wave number (0 before the first wave)
+	SpawnInterval int              // frames between ball spawns within a wave
+	BreakDuration int              // frames between a cleared wave and the next one
+	IsActive      bool             // whether wave mode is running
+	Events        *EventBus        // receives wave started/cleared events (may be nil)
+	Schedule      []WaveDefinition // waves set by a level, in order; later waves escalate as usual
+	remaining     int              // balls still to spawn this wave
+	spawnTimer    int              // frames until the next spawn
+	breakTimer    int              // frames until the next wave starts (0 = wave in progress)
+}
+
+// NewWaveManager creates a stopped wave manager that announces waves on the given event bus
+func NewWaveManager(events *EventBus) *WaveManager {
+	return &WaveManager{
+		SpawnInterval: defaultWaveSpawn,
+		BreakDuration: defaultWaveBreak,
+		Events:        events,
+	}
+}
+
+// Start begins wave 1
+func (w *WaveManager) Start() {
+	w.IsActive = true
+	w.breakTimer = 0
+	w.startWave(1)
+}
+
+// Stop ends wave mode
+func (w *WaveManager) Stop() {
+	w.IsActive = false
+	w.Wave = 0
+	w.remaining = 0
+}
+
+// Update advances the wave one frame and returns any balls to spawn.
+// liveBalls is the number of balls still in play.
+func (w *WaveManager) Update(liveBalls int) []WaveSpawn {
+	if !w.IsActive {
+		return nil
+	}
+
+	// Between waves
+	if w.breakTimer > 0 {
+		w.breakTimer--
+		if w.breakTimer == 0 {
+			w.startWave(w.Wave + 1)
+		}
+		return nil
+	}
+
+	// Spawn the wave's balls one at a time
+	if w.remaining > 0 {
+		w.spawnTimer--
+		if w.spawnTimer > 0 {
+			return nil
+		}
+		w.spawnTimer = max(int(float32(w.spawnInterval())/CurrentDifficulty.Config().SpawnRate), 1)
+		w.remaining--
+		return []WaveSpawn{w.nextSpawn()}
+	}
+
+	// Every ball spawned and destroyed: the wave is cleared
+	if liveBalls == 0 {
+		w.Events.Publish(Event{Type: EventWaveCleared, Value: w.Wave})
+		w.breakTimer = w.BreakDuration
+		if w.breakTimer <= 0 {
+			w.startWave(w.Wave + 1)
+		}
+	}
+	return nil
+}
+
+// startWave sets up the given wave and announces it
+func (w *WaveManager) startWave(wave int) {
+	w.Wave = wave
+	w.remaining = waveBaseBalls + wave - 1
+	if definition, ok := w.definition(); ok && definition.Balls > 0 {
+		w.remaining = definition.Balls
+	}
+	w.spawnTimer = 0 // First ball appears right away
+
+	w.Events.Publish(Event{Type: EventWaveStarted, Value: wave})
+}
+
+// nextSpawn returns the next ball for the current wave
+func (w *WaveManager) nextSpawn() WaveSpawn {
+	level := float32(w.Wave - 1)
+
+	speed := waveBaseSpeed * (1 + waveSpeedStep*level)
+	if speed > waveMaxSpeed {
+		speed = waveMaxSpeed
+	}
+	boss := w.Wave%bossWaveInterval == 0
+	if definition, ok := w.definition(); ok {
+		if definition.Speed > 0 {
+			speed = definition.Speed
+		}
+		boss = definition.Boss
+	}
+
+	// Every few waves (or as scheduled) the last ball is a boss
+	if w.remaining == 0 && boss {
+		return WaveSpawn{Radius: BossRadius, Speed: bossSpeed, Kind: KindBoss}
+	}
+
+	// The first wave is all standard balls; later waves mix in the other kinds
+	kind := KindStandard
+	if w.Wave > 1 {
+		kind = RandomBallKind()
+	}
+
+	return WaveSpawn{
+		Radius: waveBaseRadius + waveRadiusStep*level + rand.Float32()*waveRadiusJitter,
+		Speed:  speed,
+		Kind:   kind,
+	}
+}
+
+// definition returns the current wave's entry in the schedule, if it has one
+func (w *WaveManager) definition() (WaveDefinition, bool) {
+	if w.Wave < 1 || w.Wave > len(w.Schedule) {
+		return WaveDefinition{}, false
+	}
+	return w.Schedule[w.Wave-1], true
+}
+
+// spawnInterval returns the frames between spawns in the current wave
+func (w *WaveManager) spawnInterval() int {
+	if definition, ok := w.definition(); ok && definition.SpawnInterval > 0 {
+		return definition.SpawnInterval
+	}
+	return w.SpawnInterval
+}
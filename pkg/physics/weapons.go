@@ -0,0 +1,375 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
+)
+
+// Weapon creates the bullets for one shot of the human's firing eye
+type Weapon interface {
+	// Name returns the weapon's display name
+	Name() string
+	// Cooldown returns the frames between shots (0 = the human's tuned ShootCooldown)
+	Cooldown() int
+	// Ammo returns the shots left, or -1 for unlimited
+	Ammo() int
+	// Refill restores the weapon's full ammo
+	Refill()
+	// Fire uses one shot of ammo and returns the bullets leaving (x, y) toward angle (radians)
+	Fire(x, y, angle float32) []*Bullet
+}
+
+// Weapon tuning
+const (
+	spreadAngle        = float32(0.26) // ~15° between triple spread bullets
+	laserSpeedScale    = float32(2.0)  // piercing lasers fly twice as fast as bullets
+	missileSpeedScale  = float32(0.6)  // homing missiles fly slower than bullets
+	missileTurnRate    = float32(0.08) // maximum missile heading change per frame in radians
+	missileLifetime    = 240           // frames before a missile fizzles out (4 seconds)
+	missileDamage      = 2             // damage a missile deals on impact
+	chainLinks         = 4             // extra balls a chain lightning spark arcs to
+	chainReach         = float32(220)  // farthest a chain lightning arc jumps between balls
+	chainDamage        = 1             // damage each arc deals
+	chainStunFrames    = 60            // frames each ball in the chain stays stunned (1 second)
+	defaultBulletSpeed = 0             // use the tuned bullet speed
+)
+
+// ammoClip tracks a limited supply of shots
+type ammoClip struct {
+	left, max int
+}
+
+// Ammo returns the shots left
+func (c *ammoClip) Ammo() int {
+	return c.left
+}
+
+// Refill restores the clip to full
+func (c *ammoClip) Refill() {
+	c.left = c.max
+}
+
+// use takes one shot from the clip, returning false if it is empty
+func (c *ammoClip) use() bool {
+	if c.left <= 0 {
+		return false
+	}
+	c.left--
+	return true
+}
+
+// SingleShot fires one bullet at a time with unlimited ammo (the default weapon)
+type SingleShot struct{}
+
+// Name returns the weapon's display name
+func (SingleShot) Name() string { return "Single Shot" }
+
+// Cooldown uses the human's tuned shoot cooldown
+func (SingleShot) Cooldown() int { return 0 }
+
+// Ammo is unlimited
+func (SingleShot) Ammo() int { return -1 }
+
+// Refill does nothing; ammo is unlimited
+func (SingleShot) Refill() {}
+
+// Fire fires one bullet
+func (SingleShot) Fire(x, y, angle float32) []*Bullet {
+	return []*Bullet{newBulletAtAngle(x, y, angle, defaultBulletSpeed)}
+}
+
+// TripleSpread fires three bullets in a fan
+type TripleSpread struct{ ammoClip }
+
+// NewTripleSpread creates a triple spread with a full clip
+func NewTripleSpread() *TripleSpread {
+	return &TripleSpread{ammoClip{left: 60, max: 60}}
+}
+
+// Name returns the weapon's display name
+func (*TripleSpread) Name() string { return "Triple Spread" }
+
+// Cooldown returns the frames between shots
+func (*TripleSpread) Cooldown() int { return 25 }
+
+// Fire fires three bullets fanned around the aim
+func (w *TripleSpread) Fire(x, y, angle float32) []*Bullet {
+	if !w.use() {
+		return nil
+	}
+
+	bullets := make([]*Bullet, 0, 3)
+	for _, offset := range []float32{-spreadAngle, 0, spreadAngle} {
+		bullet := newBulletAtAngle(x, y, angle+offset, defaultBulletSpeed)
+		bullet.setIrisColor(color.RGBA{R: 120, G: 255, B: 120, A: 255}) // Green
+		bullets = append(bullets, bullet)
+	}
+	return bullets
+}
+
+// RapidFire fires single bullets very quickly
+type RapidFire struct{ ammoClip }
+
+// NewRapidFire creates a rapid fire with a full clip
+func NewRapidFire() *RapidFire {
+	return &RapidFire{ammoClip{left: 150, max: 150}}
+}
+
+// Name returns the weapon's display name
+func (*RapidFire) Name() string { return "Rapid Fire" }
+
+// Cooldown returns the frames between shots
+func (*RapidFire) Cooldown() int { return 5 }
+
+// Fire fires one bullet
+func (w *RapidFire) Fire(x, y, angle float32) []*Bullet {
+	if !w.use() {
+		return nil
+	}
+
+	bullet := newBulletAtAngle(x, y, angle, defaultBulletSpeed)
+	bullet.setIrisColor(color.RGBA{R: 255, G: 230, B: 0, A: 255}) // Yellow
+	return []*Bullet{bullet}
+}
+
+// PiercingLaser fires fast bolts that pass through every ball in their path
+type PiercingLaser struct{ ammoClip }
+
+// NewPiercingLaser creates a piercing laser with a full clip
+func NewPiercingLaser() *PiercingLaser {
+	return &PiercingLaser{ammoClip{left: 20, max: 20}}
+}
+
+// Name returns the weapon's display name
+func (*PiercingLaser) Name() string { return "Piercing Laser" }
+
+// Cooldown returns the frames between shots
+func (*PiercingLaser) Cooldown() int { return 30 }
+
+// Fire fires one piercing bolt
+func (w *PiercingLaser) Fire(x, y, angle float32) []*Bullet {
+	if !w.use() {
+		return nil
+	}
+
+	bullet := newBulletAtAngle(x, y, angle, Tuning().BulletSpeed*laserSpeedScale)
+	bullet.Piercing = true
+	bullet.setIrisColor(color.RGBA{R: 255, G: 40, B: 40, A: 255}) // Red
+	return []*Bullet{bullet}
+}
+
+// HomingMissiles fires slow eyeball missiles that steer toward the closest ball
+type HomingMissiles struct{ ammoClip }
+
+// NewHomingMissiles creates homing missiles with a full clip
+func NewHomingMissiles() *HomingMissiles {
+	return &HomingMissiles{ammoClip{left: 15, max: 15}}
+}
+
+// Name returns the weapon's display name
+func (*HomingMissiles) Name() string { return "Homing Missiles" }
+
+// Cooldown returns the frames between shots
+func (*HomingMissiles) Cooldown() int { return 40 }
+
+// Fire fires one homing missile
+func (w *HomingMissiles) Fire(x, y, angle float32) []*Bullet {
+	if !w.use() {
+		return nil
+	}
+
+	bullet := newBulletAtAngle(x, y, angle, Tuning().BulletSpeed*missileSpeedScale)
+	bullet.Homing = true
+	bullet.Damage = missileDamage
+	bullet.TTL = missileLifetime
+	bullet.setIrisColor(color.RGBA{R: 255, G: 140, B: 0, A: 255}) // Orange
+	return []*Bullet{bullet}
+}
+
+// ChainLightning fires a spark that arcs from the ball it hits through the nearest balls around it,
+// damaging and stunning every ball in the chain
+type ChainLightning struct{ ammoClip }
+
+// NewChainLightning creates a chain lightning gun with a full clip
+func NewChainLightning() *ChainLightning {
+	return &ChainLightning{ammoClip{left: 12, max: 12}}
+}
+
+// Name returns the weapon's display name
+func (*ChainLightning) Name() string { return "Chain Lightning" }
+
+// Cooldown returns the frames between shots
+func (*ChainLightning) Cooldown() int { return 45 }
+
+// Fire fires one chain lightning spark
+func (w *ChainLightning) Fire(x, y, angle float32) []*Bullet {
+	if !w.use() {
+		return nil
+	}
+
+	bullet := newBulletAtAngle(x, y, angle, defaultBulletSpeed)
+	bullet.Chain = chainLinks
+	bullet.setIrisColor(color.RGBA{R: 120, G: 200, B: 255, A: 255}) // Electric blue
+	return []*Bullet{bullet}
+}
+
+// DefaultWeapons returns the human's arsenal, in number-key order
+func DefaultWeapons() []Weapon {
+	return []Weapon{
+		SingleShot{},
+		NewTripleSpread(),
+		NewRapidFire(),
+		NewPiercingLaser(),
+		NewHomingMissiles(),
+		NewChainLightning(),
+	}
+}
+
+// CurrentWeapon returns the selected weapon
+func (h *Human) CurrentWeapon() Weapon {
+	if h.WeaponIndex < 0 || h.WeaponIndex >= len(h.Weapons) {
+		return SingleShot{}
+	}
+	return h.Weapons[h.WeaponIndex]
+}
+
+// SelectWeapon switches to the weapon at index. It returns false if there is no such weapon or it is out of ammo.
+func (h *Human) SelectWeapon(index int) bool {
+	if index < 0 || index >= len(h.Weapons) || h.Weapons[index].Ammo() == 0 {
+		return false
+	}
+	h.WeaponIndex = index
+	return true
+}
+
+// RefillWeapons restores every weapon's ammo
+func (h *Human) RefillWeapons() {
+	for _, weapon := range h.Weapons {
+		weapon.Refill()
+	}
+}
+
+// shotCooldown returns the frames to wait after a shot with the current weapon, shortened by cooldown upgrades
+// and scaled by the difficulty
+func (h *Human) shotCooldown() int {
+	cooldown := h.CurrentWeapon().Cooldown()
+	if cooldown <= 0 {
+		cooldown = h.ShootCooldown
+	}
+	if scaled := int(float32(cooldown) * h.cooldownScale() * CurrentDifficulty.Config().ShootCooldown); scaled > 1 {
+		return scaled
+	}
+	return 1
+}
+
+// steerHomingBullets turns each homing missile toward the closest live ball
+func (h *Human) steerHomingBullets(balls []*Ball) {
+	for _, bullet := range h.Bullets {
+		if !bullet.IsActive || !bullet.Homing {
+			continue
+		}
+
+		var target *Ball
+		closest := float32(math.MaxFloat32)
+		for _, ball := range balls {
+			if !ball.IsAnimated || ball.IsDestroyed {
+				continue
+			}
+			dx := ball.X - bullet.X
+			dy := ball.Y - bullet.Y
+			if distance := dx*dx + dy*dy; distance < closest {
+				closest = distance
+				target = ball
+			}
+		}
+		if target == nil {
+			continue
+		}
+
+		// Turn toward the target by at most the missile turn rate, keeping speed
+		speed := math.Sqrt(float64(bullet.VX*bullet.VX + bullet.VY*bullet.VY))
+		heading := math.Atan2(float64(bullet.VY), float64(bullet.VX))
+		desired := math.Atan2(float64(target.Y-bullet.Y), float64(target.X-bullet.X))
+		turn := math.Remainder(desired-heading, 2*math.Pi)
+		if turn > float64(missileTurnRate) {
+			turn = float64(missileTurnRate)
+		} else if turn < -float64(missileTurnRate) {
+			turn = -float64(missileTurnRate)
+		}
+		heading += turn
+		bullet.VX = float32(math.Cos(heading) * speed)
+		bullet.VY = float32(math.Sin(heading) * speed)
+	}
+}
+
+// chainLightning arcs from the ball a spark hit through up to links of the nearest balls, each arc jumping
+// to the closest ball not yet struck. The first ball has already taken the spark's damage; every ball in
+// the chain is stunned and the rest take the arc's damage. The bolt is queued for the UI.
+func (h *Human) chainLightning(first *Ball, balls []*Ball, links int) {
+	chained := []*Ball{first}
+	for _, ball := range balls {
+		if ball != first && ball.IsAnimated && !ball.IsDestroyed {
+			chained = append(chained, ball)
+		}
+	}
+	points := make([]fyne.Position, len(chained))
+	for i, ball := range chained {
+		points[i] = fyne.NewPos(ball.X, ball.Y)
+	}
+
+	path := effects.NearestNeighborPath(points, 0, links, chainReach)
+	bolt := make([]fyne.Position, 0, len(path))
+	for i, index := range path {
+		ball := chained[index]
+		bolt = append(bolt, points[index])
+		if i > 0 {
+			destroyed := ball.TakeDamage(chainDamage)
+			if destroyed {
+				h.Score += ball.ScoreValue()
+			}
+			h.awardHit(ball, destroyed)
+		}
+		ball.ApplyStatus(StatusStunned, chainStunFrames)
+	}
+	h.pendingBolts = append(h.pendingBolts, effects.NewChainLightning(bolt))
+}
+
+// TakePendingBolts returns the chain lightning bolts since the last call so the UI can show them once
+func (h *Human) TakePendingBolts() []*effects.Lightning {
+	bolts := h.pendingBolts
+	h.pendingBolts = nil
+	return bolts
+}
+
+// newBulletAtAngle creates a bullet at (x, y) flying toward angle at the given speed (0 = tuned bullet speed)
+func newBulletAtAngle(x, y, angle, speed float32) *Bullet {
+	targetX := x + float32(math.Cos(float64(angle)))*100
+	targetY := y + float32(math.Sin(float64(angle)))*100
+	bullet := NewBullet(x, y, targetX, targetY)
+
+	if speed > 0 {
+		scale := speed / Tuning().BulletSpeed
+		bullet.VX *= scale
+		bullet.VY *= scale
+	}
+	return bullet
+}
+
+// setIrisColor recolors the bullet's iris (each weapon has its own color)
+func (b *Bullet) setIrisColor(fill color.RGBA) {
+	b.Iris.FillColor = fill
+	b.Iris.StrokeColor = color.RGBA{R: fill.R / 2, G: fill.G / 2, B: fill.B / 2, A: 255}
+}
+
+// hasHit reports whether a piercing bullet already hit the ball
+func (b *Bullet) hasHit(ball *Ball) bool {
+	for _, hit := range b.hits {
+		if hit == ball {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package physics
+
+// World holds the rules shared by every ball in one game. Balls keep a pointer to the world they play in,
+// so changing a rule applies to all of them at once.
+type World struct {
+	MinBallRadius float32  // balls never shrink below this radius
+	MaxBallRadius float32  // balls never grow (by merging or regrowing) beyond this radius
+	WallMode      WallMode // what balls do at the edges of the arena
+}
+
+// NewWorld creates a world with the default rules
+func NewWorld() *World {
+	return &World{
+		MinBallRadius: defaultMinBallRadius,
+		MaxBallRadius: defaultMaxBallRadius,
+	}
+}
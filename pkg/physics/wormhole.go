@@ -0,0 +1,206 @@
+package physics
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Wormhole tuning
+const (
+	wormholeRadius     = float32(32)   // radius of a fully open wormhole
+	wormholeFrames     = 240           // frames a wormhole pair stays open (4 seconds)
+	wormholeGrowFrames = 20            // frames a wormhole takes to open or close
+	wormholeSwirlSpeed = float32(0.08) // radians per frame the sparks swirl
+	wormholeRingCount  = 3             // pulsing rings around the core
+	wormholeSparkCount = 6             // sparks spiraling into the core
+)
+
+// Wormhole colors
+var (
+	wormholeRingColor  = color.RGBA{R: 140, G: 80, B: 255, A: 200}  // Violet rings
+	wormholeCoreColor  = color.RGBA{R: 20, G: 0, B: 40, A: 200}     // Near-black center
+	wormholeSparkColor = color.RGBA{R: 200, G: 220, B: 255, A: 230} // Pale blue sparks spiraling in
+)
+
+// Wormhole is one end of a wormhole pair: a dark core with pulsing rings and sparks spiraling into it
+type Wormhole struct {
+	X, Y   float32          // center
+	Core   *canvas.Circle   // Dark center
+	Rings  []*canvas.Circle // Pulsing rings
+	Sparks []*canvas.Circle // Sparks spiraling toward the center
+}
+
+// WormholePair links two wormholes: the alien travels between them, and any ball that enters one
+// comes out of the other
+type WormholePair struct {
+	Entrance *Wormhole // where the alien went in
+	Exit     *Wormhole // where the alien came out
+	Timer    int       // frames until the pair closes (0 = closed)
+	phase    float32   // swirl phase
+}
+
+// newWormhole creates one hidden end of a wormhole pair
+func newWormhole() *Wormhole {
+	w := &Wormhole{
+		Core:   &canvas.Circle{FillColor: wormholeCoreColor},
+		Rings:  make([]*canvas.Circle, wormholeRingCount),
+		Sparks: make([]*canvas.Circle, wormholeSparkCount),
+	}
+	for i := range w.Rings {
+		w.Rings[i] = &canvas.Circle{StrokeColor: wormholeRingColor, StrokeWidth: 2}
+	}
+	for i := range w.Sparks {
+		w.Sparks[i] = &canvas.Circle{FillColor: wormholeSparkColor}
+	}
+	return w
+}
+
+// NewWormholePair creates a closed wormhole pair
+func NewWormholePair() *WormholePair {
+	p := &WormholePair{Entrance: newWormhole(), Exit: newWormhole()}
+	p.Close()
+	return p
+}
+
+// Open opens the pair between (x1, y1) and (x2, y2)
+func (p *WormholePair) Open(x1, y1, x2, y2 float32) {
+	p.Entrance.X, p.Entrance.Y = x1, y1
+	p.Exit.X, p.Exit.Y = x2, y2
+	p.Timer = wormholeFrames
+	p.phase = rand.Float32() * 2 * math.Pi
+	for _, component := range p.GetVisualComponents() {
+		component.Show()
+	}
+	p.draw()
+}
+
+// IsOpen reports whether the pair is open
+func (p *WormholePair) IsOpen() bool {
+	return p.Timer > 0
+}
+
+// Update swirls an open pair and closes it once its time is up
+func (p *WormholePair) Update() {
+	if !p.IsOpen() {
+		return
+	}
+	p.Timer--
+	if p.Timer == 0 {
+		p.Close()
+		return
+	}
+	p.phase += wormholeSwirlSpeed
+	p.draw()
+}
+
+// Close shuts both wormholes
+func (p *WormholePair) Close() {
+	p.Timer = 0
+	for _, component := range p.GetVisualComponents() {
+		component.Hide()
+	}
+}
+
+// WarpBalls sends any ball that enters one wormhole out of the other, keeping its velocity
+func (p *WormholePair) WarpBalls(balls []*Ball) {
+	if !p.IsOpen() {
+		return
+	}
+	radius := p.radius()
+	for _, ball := range balls {
+		if !ball.IsAnimated || ball.IsDestroyed || ball.IsAbsorbed || ball.CarriedBy != nil {
+			continue
+		}
+		for _, pair := range [][2]*Wormhole{{p.Entrance, p.Exit}, {p.Exit, p.Entrance}} {
+			from, to := pair[0], pair[1]
+			dx := ball.X - from.X
+			dy := ball.Y - from.Y
+			if dx*dx+dy*dy < radius*radius && dx*ball.VX+dy*ball.VY < 0 {
+				to.emit(ball, radius)
+				break
+			}
+		}
+	}
+}
+
+// emit puts the ball just outside the wormhole's rim on the side it is heading, so it flies away
+// instead of falling straight back in
+func (w *Wormhole) emit(ball *Ball, radius float32) {
+	speed := float32(math.Sqrt(float64(ball.VX*ball.VX + ball.VY*ball.VY)))
+	dirX, dirY := float32(1), float32(0)
+	if speed > 0 {
+		dirX, dirY = ball.VX/speed, ball.VY/speed
+	}
+	gap := radius + ball.Radius + 1
+	ball.X = w.X + dirX*gap
+	ball.Y = w.Y + dirY*gap
+
+	// Don't draw the trail ribbon across the arena
+	ball.Trail.Clear()
+}
+
+// radius returns the pair's current radius, growing as it opens and shrinking as it closes
+func (p *WormholePair) radius() float32 {
+	opened := wormholeFrames - p.Timer
+	switch {
+	case opened < wormholeGrowFrames:
+		return wormholeRadius * float32(opened) / wormholeGrowFrames
+	case p.Timer < wormholeGrowFrames:
+		return wormholeRadius * float32(p.Timer) / wormholeGrowFrames
+	}
+	return wormholeRadius
+}
+
+// draw lays out both wormholes at the current radius and swirl phase
+func (p *WormholePair) draw() {
+	radius := p.radius()
+	p.Entrance.draw(radius, p.phase)
+	p.Exit.draw(radius, -p.phase) // The exit swirls the other way
+}
+
+// draw lays out the wormhole's core, rings and sparks
+func (w *Wormhole) draw(radius, phase float32) {
+	placeCircle := func(circle *canvas.Circle, x, y, r float32) {
+		circle.Resize(fyne.NewSize(r*2, r*2))
+		circle.Move(fyne.NewPos(x-r, y-r))
+	}
+
+	placeCircle(w.Core, w.X, w.Y, radius*0.45)
+	for i, ring := range w.Rings {
+		pulse := 0.08 * float32(math.Sin(float64(phase*2+float32(i))))
+		placeCircle(ring, w.X, w.Y, radius*(0.5+0.25*float32(i)+pulse))
+		ring.Refresh()
+	}
+
+	// Each spark spirals in from the rim, then starts again at the rim
+	for i, spark := range w.Sparks {
+		cycle := phase/(2*math.Pi) + float32(i)/float32(len(w.Sparks))
+		progress := cycle - float32(math.Floor(float64(cycle)))
+		distance := radius * (1 - progress)
+		angle := float64(phase*3) + float64(i)*2*math.Pi/float64(len(w.Sparks)) + float64(progress)*math.Pi
+		x := w.X + float32(math.Cos(angle))*distance
+		y := w.Y + float32(math.Sin(angle))*distance
+		placeCircle(spark, x, y, 2)
+	}
+}
+
+// GetVisualComponents returns the wormhole's core, rings and sparks
+func (w *Wormhole) GetVisualComponents() []fyne.CanvasObject {
+	components := []fyne.CanvasObject{w.Core}
+	for _, ring := range w.Rings {
+		components = append(components, ring)
+	}
+	for _, spark := range w.Sparks {
+		components = append(components, spark)
+	}
+	return components
+}
+
+// GetVisualComponents returns both wormholes' visual components
+func (p *WormholePair) GetVisualComponents() []fyne.CanvasObject {
+	return append(p.Entrance.GetVisualComponents(), p.Exit.GetVisualComponents()...)
+}
@@ -0,0 +1,120 @@
+// Package scores keeps the high score leaderboard: the best scores of every game, saved as JSON so they
+// survive restarts.
+package scores
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// MaxEntries is how many scores the leaderboard keeps
+const MaxEntries = 10
+
+// fileName is the leaderboard file inside the user config directory
+const fileName = "bouncing-balls/scores.json"
+
+// Entry is one score on the leaderboard
+type Entry struct {
+	Name       string    `json:"name"`       // name the player entered
+	Score      int       `json:"score"`      // final score
+	Time       time.Time `json:"time"`       // when the game ended
+	Difficulty string    `json:"difficulty"` // difficulty the game was played at
+}
+
+// Leaderboard holds the best scores, highest first, and the file they are saved to
+type Leaderboard struct {
+	Path    string  // JSON file the scores are loaded from and saved to ("" = kept in memory only)
+	Entries []Entry // best scores, highest first, at most MaxEntries
+}
+
+// DefaultPath returns the leaderboard file under the user config directory, or "" if there isn't one
+func DefaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, fileName)
+}
+
+// Load reads the leaderboard saved at path. A missing file is an empty leaderboard, not an error;
+// a broken one returns an empty leaderboard along with the error.
+func Load(path string) (*Leaderboard, error) {
+	board := &Leaderboard{Path: path}
+	if path == "" {
+		return board, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return board, nil
+	}
+	if err != nil {
+		return board, err
+	}
+	if err := json.Unmarshal(data, &board.Entries); err != nil {
+		board.Entries = nil
+		return board, err
+	}
+	board.sort()
+	return board, nil
+}
+
+// Save writes the leaderboard to its file, creating the directory if needed
+func (l *Leaderboard) Save() error {
+	if l.Path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(l.Entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(l.Path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(l.Path, data, 0o644)
+}
+
+// Qualifies reports whether a score would make the leaderboard
+func (l *Leaderboard) Qualifies(score int) bool {
+	if score <= 0 {
+		return false
+	}
+	return len(l.Entries) < MaxEntries || score > l.Entries[len(l.Entries)-1].Score
+}
+
+// Add puts an entry on the leaderboard and returns its rank (1 = best), or 0 if it didn't make it.
+// Ties rank below the scores already there.
+func (l *Leaderboard) Add(entry Entry) int {
+	if !l.Qualifies(entry.Score) {
+		return 0
+	}
+	rank := sort.Search(len(l.Entries), func(i int) bool { return l.Entries[i].Score < entry.Score })
+	l.Entries = append(l.Entries, Entry{})
+	copy(l.Entries[rank+1:], l.Entries[rank:])
+	l.Entries[rank] = entry
+	if len(l.Entries) > MaxEntries {
+		l.Entries = l.Entries[:MaxEntries]
+	}
+	return rank + 1
+}
+
+// Best returns the top score, or 0 if the leaderboard is empty
+func (l *Leaderboard) Best() int {
+	if len(l.Entries) == 0 {
+		return 0
+	}
+	return l.Entries[0].Score
+}
+
+// sort orders the entries highest first and drops any beyond MaxEntries
+func (l *Leaderboard) sort() {
+	sort.SliceStable(l.Entries, func(i, j int) bool { return l.Entries[i].Score > l.Entries[j].Score })
+	if len(l.Entries) > MaxEntries {
+		l.Entries = l.Entries[:MaxEntries]
+	}
+}
@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"log"
+	"math/rand"
+
+	"github.com/atyronesmith/bouncing-balls/pkg/assets"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// Alien fleet layout
+const (
+	alienSize       = float32(60)
+	alienFleetX     = float32(600) // where the fleet gathers at launch
+	alienFleetY     = float32(150)
+	alienFleetWidth = float32(160) // spread of the fleet around its gathering point
+)
+
+// SetAlienCount grows or shrinks the alien fleet drifting through the star field (there is none in the
+// plain physics demo)
+func (a *App) SetAlienCount(count int) {
+	if count < 0 || a.plainDemo {
+		count = 0
+	}
+	for len(a.aliens) < count {
+		a.addAlien()
+	}
+	for len(a.aliens) > count {
+		a.removeAlien(a.aliens[len(a.aliens)-1])
+	}
+}
+
+// addAlien adds one alien near the fleet's gathering point (the first one right on it)
+func (a *App) addAlien() {
+	x, y := alienFleetX, alienFleetY
+	if len(a.aliens) > 0 {
+		x += (rand.Float32() - 0.5) * alienFleetWidth
+		y += (rand.Float32() - 0.5) * alienFleetWidth
+	}
+
+	face, err := a.assets.Resource(assets.AlienImage)
+	if err != nil {
+		log.Printf("assets: %v", err)
+	}
+	alien := physics.NewAlienFromResource(x, y, alienSize, face) // Mysterious alien face that drifts peacefully
+	alien.SetBounds(a.currentBounds)
+	alien.Hostile = a.hostileAliens
+	alien.WatchEvents(a.events)
+	a.aliens = append(a.aliens, alien)
+	if a.content != nil {
+		for _, component := range alien.GetVisualComponents() {
+			a.content.Add(component)
+		}
+	}
+}
+
+// removeAlien takes an alien out of the fleet and the game area
+func (a *App) removeAlien(alien *physics.Alien) {
+	for i, other := range a.aliens {
+		if other != alien {
+			continue
+		}
+		a.aliens = append(a.aliens[:i], a.aliens[i+1:]...)
+		alien.Hide() // Stops it reacting to events it is still subscribed to
+		alien.ClearOrbs()
+		if a.content != nil {
+			for _, component := range alien.GetVisualComponents() {
+				a.content.Remove(component)
+			}
+		}
+		return
+	}
+}
+
+// updateAliens flocks the drifting aliens together, then lets each drift and react to the human's firing
+func (a *App) updateAliens() {
+	if len(a.aliens) == 0 {
+		return
+	}
+	physics.ApplyFlocking(a.aliens)
+
+	ctx := a.alienContext()
+	var orbs []*physics.Bullet
+	for _, alien := range a.aliens {
+		if alien.IsActive {
+			orbsBeforeUpdate := alien.GetOrbVisuals()
+			alien.Update(ctx)
+			a.addNewVisuals(orbsBeforeUpdate, alien.GetOrbVisuals())
+		}
+
+		// Balls that fall into a wormhole come out of the other end
+		alien.Wormholes.WarpBalls(a.balls)
+
+		// Plasma orbs hit the humans like balls do
+		for _, human := range a.humans {
+			if human.IsActive && alien.OrbHits(human) {
+				a.explodeHuman(human)
+			}
+		}
+		orbs = append(orbs, alien.Orbs...)
+	}
+
+	// The dragon blocks orbs headed for the human
+	if a.dragon != nil {
+		a.dragon.Orbs = orbs
+	}
+}
+
+// SetHostileAliens turns hostile mode on or off: hostile aliens fire slow plasma orbs at the humans,
+// which the dragon tries to block
+func (a *App) SetHostileAliens(enabled bool) {
+	a.hostileAliens = enabled
+	for _, alien := range a.aliens {
+		alien.Hostile = enabled
+		if !enabled {
+			alien.ClearOrbs()
+		}
+	}
+}
+
+// resetAliens sends every alien back to a random screen edge for a fresh entrance
+func (a *App) resetAliens() {
+	for _, alien := range a.aliens {
+		alien.ClearOrbs()
+		alien.Wormholes.Close()
+		alien.Respawn()
+		alien.IsActive = true
+		alien.Show()
+	}
+}
@@ -1,14 +1,25 @@
 package ui
 
 import (
+	"fmt"
 	"image/color"
+	"log"
+	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
-	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/assets"
+	"github.com/atyronesmith/bouncing-balls/pkg/clip"
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
+	"github.com/atyronesmith/bouncing-balls/pkg/input"
 	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+	"github.com/atyronesmith/bouncing-balls/pkg/scores"
 )
 
 // App represents the main application
@@ -16,21 +27,175 @@ type App struct {
 	fyneApp         fyne.App
 	window          fyne.Window
 	balls           []*physics.Ball
+	world           *physics.World // rules shared by every ball (see SetBallSizeRange and SetWallMode)
 	human           *physics.Human
+	humans          []*physics.Human // every player (humans[0] is human)
 	dragon          *physics.Dragon
 	starField       *physics.StarField // Moving star field background
-	alien           *physics.Alien     // Mysterious alien that drifts through space
+	aliens          []*physics.Alien   // Fleet of mysterious aliens flocking through space
+	conversations   *physics.BallConversations // Speech bubbles between lingering balls
+	hud             *canvas.Text               // Score and deaths display
 	currentBounds   fyne.Size
 	animationTicker *time.Ticker
 	content         *fyne.Container // Main content container for dynamic elements
+	shadowLayer     *fyne.Container // Ground shadows, drawn above the stars and below all entities
+	input           *inputLayer     // Transparent layer catching mouse gestures over the game area
+	laser           *physics.LaserSweep // Current laser sweep hazard (nil between sweeps)
+	laserTimer      int                 // frames until the next laser sweep
+	storm           *physics.SpaceStorm   // Current space storm (nil between storms)
+	randomEvents    *physics.RandomEvents // Schedules random events such as space storms
+	aiScheduler     *physics.AIScheduler // Runs expensive AI searches every few physics frames
+	magnetism       bool                 // whether charged balls attract/repel each other
+	tuningWatcher   *physics.TuningWatcher // Reloads the tuning file in dev mode (nil otherwise)
+	tuningPollTimer int                    // frames until the tuning file is checked again
+	assets          *assets.Registry       // Image assets (embedded, or overridden from a user directory)
+	victoryEnabled  bool                   // whether clearing every ball wins the round
+	isVictory       bool                   // whether the victory screen is up
+	onVictory       func(VictoryStats)     // Called when a round is cleared (e.g. to play a fanfare)
+	round           int                    // current round, starting at 1
+	roundFrames     int                    // frames played in the current round
+	roundStart      roundSnapshot          // human's totals when the round started
+	bestScore       int                    // best score of the saved games and those since launch (beaten for confetti)
+	leaderboard     *scores.Leaderboard    // top scores, saved across launches (see leaderboard.go)
+	level           *physics.Level         // custom stage being played (nil = the standard game, see level.go)
+	obstacles       []*physics.Obstacle    // the level's blocks the balls bounce off
+	sandbox         sandbox                // drag, fling, spawn palette and frame stepping (see sandbox.go)
+	highScoreBeaten bool                   // whether a player has beaten bestScore this game
+	celebration     *physics.Celebration   // Confetti and fireworks shown on victory
+	particles       *effects.ParticleSystem // Explosion sparks and dragon flames, shared by every entity
+	screenShake     *effects.ScreenShake    // Jolts the game area on big impacts
+	lightning       []*effects.Lightning    // Chain lightning and storm bolts still flickering
+	timeScale       *effects.TimeScale      // Slows or freezes the simulation (hit-stop)
+	vignette        *effects.Vignette       // Red low-health pulse and white explosion flash over the game area
+	effectsQuality  effects.Quality         // Preset scaling particles, trails, stars and twinkling (see settings.go)
+	baseStarDensity float32                 // star field density at full effects quality
+	ballCount       int                     // balls spawned at launch and on reset (see settings.go)
+	starCount       int                     // stars in the field at full effects quality
+	fpsCap          int                     // animation frames per second
+	stepCarry       float32                 // fraction of a simulation step owed to the next frame
+	victoryScreen   *victoryPanel          // Round stats overlay with the next-round button
+	events          *physics.EventBus      // Game events (wave announcements, ...)
+	waves           *physics.WaveManager   // Escalating ball waves (wave mode)
+	waveBanner      *canvas.Text           // Wave number announcement
+	waveBannerTimer int                    // frames left on the wave announcement
+	tethers         []*physics.Tether      // Springs and rods linking pairs of balls
+	heatMap         bool                   // whether iris colors show ball speed (blue = slow, red = fast)
+	cloner          *physics.CloningPickup // Power-up that duplicates the ball that touches it
+	pickupTimer     int                    // frames until the next cloning pickup appears
+	keyboard        *input.Keyboard        // Arrow keys / WASD
+	mouse           *input.Mouse           // Aim at the pointer, fire while the button is held
+	controls        *input.Manager         // Combines keyboard, mouse and gamepads for manual control
+	staminaBar      *staminaBar            // Sprint stamina under the HUD
+	levelUpScreen   *levelUpPanel          // Upgrade choice shown on level-up
+	paused          bool                   // whether the game is frozen behind the level-up screen
+	levelUpHuman    *physics.Human         // player choosing an upgrade on the level-up screen
+	keyboard2       *input.Keyboard        // Player 2's arrow keys in co-op
+	player2HUD      *canvas.Text           // Player 2's score display in co-op
+	refocusGame     bool                   // Tab moved Fyne's focus onto a control; take it back next frame
+	dragonEnergyBar *dragonEnergyBar       // Dragon energy floating above the dragon
+	dragonDebug     *canvas.Text           // Dragon state debug overlay (toggled with F3)
+	dragonOff       bool                   // Dragon turned off from the dragon settings (stays off across resets)
+	rivalDragon     *physics.Dragon        // Enemy dragon in duel mode (built on the first duel)
+	duel            *physics.DragonDuel    // Dragon duel in progress (nil = duel mode off)
+	dominanceMeter  *dominanceMeter        // Which dragon is winning the duel
+	hostileAliens   bool                   // whether the aliens fire plasma orbs at the humans
+
+	toolbar       *toolbar           // control bar with its overflow menu (see toolbar.go)
+	gameMode      physics.GameMode   // rules the game is played by (see modes.go)
+	modeFrames    int                // frames played this game, for the game mode's clock
+	gameOver      bool               // whether the game mode has ended the game
+	bossFight     *physics.BossFight // boss fight in progress (nil outside the boss fight mode, see bossfight.go)
+	bossBar       *bossBar           // the boss's HP bar across the top
+	tutorial      *tutorial          // guided tutorial in progress (nil = none, see tutorial.go)
+	clipRecorder  *clip.Recorder     // the last few seconds of the game, for saving as a GIF (see clip.go)
+	clipCapturing atomic.Bool        // whether a window capture for the clip is in flight
+	uiMode        UIMode             // light or dark control bar and dialogs (see backdrop.go)
+	backdrop      *canvas.Rectangle  // solid color behind the game area
+	backdropColor color.RGBA         // the backdrop's color
+	spaceLayer    *fyne.Container    // star field, black holes and supernovae (hidden in the plain physics demo)
+	plainDemo     bool               // plain physics demo: the balls and players on the backdrop color, nothing else
+	gameArea      fyne.Size          // logical game area size, which every entity works in
+	baseScale     float32            // interface scale the app was started with (see display.go)
+	uiScale       float32            // interface scale the window is drawn at, raised in fullscreen
+	pendingMu     sync.Mutex         // guards pending
+	pending       []func()           // Fyne callbacks' work waiting for the next frame (see gameloop.go)
 }
 
+// laserSweepInterval is the number of frames between laser sweeps (~20 seconds at 60 FPS)
+const laserSweepInterval = 1200
+
+// tuningFileEnv names the environment variable that enables dev mode with a live-reloaded tuning file
+const tuningFileEnv = "BOUNCING_BALLS_TUNING"
+
+// assetDirEnv names the environment variable pointing at a directory of images that override the embedded ones
+const assetDirEnv = "BOUNCING_BALLS_ASSETS"
+
+// tuningPollInterval is how many frames pass between tuning file checks (~0.5 seconds)
+const tuningPollInterval = 30
+
+// defaultAITickInterval is how many physics frames pass between AI ticks
+const defaultAITickInterval = 3
+
+// Screen shake strength (pixels) and length (frames) for the big impacts
+const (
+	humanExplosionShake       = float32(10)
+	humanExplosionShakeFrames = 30
+	bossSlamShake             = float32(6)
+	bossSlamShakeFrames       = 18
+)
+
+// Vignette flash strengths (0 to 1) for explosions
+const (
+	humanExplosionFlash = float32(1.0)
+	ballDestroyedFlash  = float32(0.25)
+)
+
+// Hit-stop freeze and ease-back lengths (simulation steps) for player deaths and boss kills
+const (
+	hitStopHoldFrames = 9  // ~150ms frozen
+	hitStopRampFrames = 12 // then back up to speed over ~200ms
+)
+
 // NewApp creates a new application instance
 func NewApp() *App {
-	return &App{
-		fyneApp:       app.New(),
+	a := &App{
+		fyneApp:       app.NewWithID(appID),
 		currentBounds: fyne.NewSize(800, 600), // Game area size, not window size
+		world:         physics.NewWorld(),
+		laserTimer:    laserSweepInterval,
+		randomEvents:  physics.NewRandomEvents(),
+		aiScheduler:   physics.NewAIScheduler(defaultAITickInterval),
+		victoryEnabled: true,
+		round:          1,
+		events:         physics.NewEventBus(),
+		pickupTimer:    cloningPickupInterval,
+		keyboard:       input.NewKeyboard(),
+		keyboard2:      input.NewKeyboardWithKeys(input.ArrowKeys),
+		mouse:          input.NewMouse(),
+		assets:         assets.NewRegistry(os.Getenv(assetDirEnv)),
+		ballCount:      len(initialBalls),
+		starCount:      defaultStarCount,
+		fpsCap:         defaultFPSCap,
+		backdropColor:  backdropPresets[0].color,
+		toolbar:        newToolbar(),
 	}
+	a.controls = input.NewManager(a.keyboard, a.mouse)
+	a.loadLeaderboard()
+	a.clipRecorder = clip.NewRecorder(clip.DefaultSeconds, clip.DefaultFPS, clip.DefaultScale)
+	a.waves = physics.NewWaveManager(a.events)
+
+	// Dev mode: load tuning values from a file and keep watching it for changes
+	if path := os.Getenv(tuningFileEnv); path != "" {
+		a.tuningWatcher = physics.NewTuningWatcher(path)
+		if _, err := a.tuningWatcher.Poll(); err != nil {
+			log.Printf("tuning: %v", err)
+		}
+	}
+
+	// Play a custom level if one is named
+	a.loadLevelFromEnv()
+
+	return a
 }
 
 // updateBounds updates the bounds for all physics objects
@@ -47,24 +212,27 @@ func (a *App) updateBounds(newSize fyne.Size) {
 		ball.Bounds = gameArea
 	}
 
-	// Update bounds for human
-	if a.human != nil {
-		a.human.Bounds = gameArea
+	// Update bounds for every player
+	for _, human := range a.humans {
+		human.Bounds = gameArea
 	}
 
 	// Update bounds for dragon
 	if a.dragon != nil {
 		a.dragon.Bounds = gameArea
 	}
+	if a.rivalDragon != nil {
+		a.rivalDragon.Bounds = gameArea
+	}
 
 	// Update bounds for star field
 	if a.starField != nil {
 		a.starField.UpdateBounds(gameArea)
 	}
 
-	// Update bounds for alien
-	if a.alien != nil {
-		a.alien.SetBounds(gameArea)
+	// Update bounds for every alien
+	for _, alien := range a.aliens {
+		alien.SetBounds(gameArea)
 	}
 }
 
@@ -76,141 +244,557 @@ func (a *App) startAnimation() {
 	}
 
 	// Simple animation timer - updates all balls 60 times per second
-	a.animationTicker = time.NewTicker(time.Millisecond * 16) // ~60 FPS
+	a.animationTicker = time.NewTicker(frameInterval(a.fpsCap)) // ~60 FPS unless capped in the settings
 	go func() {
 		defer a.animationTicker.Stop()
 		for {
 			select {
 			case <-a.animationTicker.C:
-				// Update star field (background animation)
-				if a.starField != nil {
-					a.starField.Update()
-				}
+				// Run what the buttons, menus, dialogs, keys and pointer asked for since the last frame
+				a.runPending()
 
-				// Update all ball positions (wall bouncing)
-				for _, ball := range a.balls {
-					ball.Update()
+				// Tab also moves Fyne's focus onto a control; take it back so the keys keep reaching the game
+				if a.refocusGame {
+					a.refocusGame = false
+					a.window.Canvas().Unfocus()
 				}
 
-				// Update eyeball positions with human tracking (if human is active)
-				if a.human != nil && a.human.IsActive {
-					for _, ball := range a.balls {
-						ball.UpdatePositionWithHuman(a.human.X, a.human.Y)
-					}
-				} else {
-					// If no human, use default positioning
-					for _, ball := range a.balls {
-						ball.UpdatePosition()
-					}
+				// Everything waits while the player picks an upgrade or looks at a game mode's results
+				if a.paused {
+					continue
 				}
 
-				// Check for ball-to-ball collisions
-				for i := 0; i < len(a.balls); i++ {
-					for j := i + 1; j < len(a.balls); j++ {
-						if a.balls[i].CheckCollision(a.balls[j]) {
-							// Store explosion state before collision
-							wasExploding1 := a.balls[i].IsExploding
-							wasExploding2 := a.balls[j].IsExploding
-
-							a.balls[i].HandleCollision(a.balls[j])
-
-							// Add explosion particles to UI if explosion just started
-							if !wasExploding1 && a.balls[i].IsExploding {
-								for _, particle := range a.balls[i].GetExplosionParticles() {
-									if particle != nil {
-										a.content.Add(particle)
-									}
-								}
-							}
-							if !wasExploding2 && a.balls[j].IsExploding {
-								for _, particle := range a.balls[j].GetExplosionParticles() {
-									if particle != nil {
-										a.content.Add(particle)
-									}
-								}
-							}
-						}
+				// Sandbox mode can pause the simulation and step it a frame at a time; dragged balls follow
+				// the pointer either way
+				a.updateSandboxDrag()
+
+				// The simulation runs a fixed 60 steps a second, so a lower FPS cap runs more than one a frame
+				for steps := a.simulationSteps(); steps > 0; steps-- {
+					if a.paused || !a.sandboxAdvances() { // A step can pause the game for an upgrade pick
+						break
 					}
+					a.stepSimulation()
 				}
+			}
+		}
+	}()
+}
 
-				// Update human
-				if a.human != nil {
-					if a.human.IsActive {
-						// Store bullets before update for UI management
-						bulletsBeforeUpdate := a.human.GetBulletVisuals()
-
-						a.human.Update(a.balls)
-
-						// Add new bullets to UI
-						bulletsAfterUpdate := a.human.GetBulletVisuals()
-						for _, bullet := range bulletsAfterUpdate {
-							found := false
-							for _, oldBullet := range bulletsBeforeUpdate {
-								if bullet == oldBullet {
-									found = true
-									break
-								}
-							}
-							if !found {
-								a.content.Add(bullet)
-								// Bring bullet to front so it's visible above other elements
-								bullet.Show()
-							}
-						}
-
-						// Check ball-human collisions
-						if a.human.CheckCollisionWithBalls(a.balls) {
-							// Store previous explosion state
-							wasExploding := a.human.IsExploding
-							a.human.Explode()
-
-							// If explosion just started, add particles to UI
-							if !wasExploding && a.human.IsExploding {
-								for _, particle := range a.human.ExplosionParticles {
-									if particle != nil {
-										a.content.Add(particle)
-									}
-								}
-							}
-						}
-					}
+// stepSimulation advances the game by one fixed step
+func (a *App) stepSimulation() {
+	// A hit-stop freezes the action for a moment, then eases it back up to speed
+	if !a.timeScale.Tick() {
+		return
+	}
 
-													// Always update explosion state (handles respawn timer and animation)
-				if a.human.IsExploding {
-					// Store explosion particles before update (for cleanup)
-					particlesBeforeUpdate := a.human.ExplosionParticles
-					wasExploding := a.human.IsExploding
-
-					a.human.UpdateExplosion()
-
-					// If explosion just ended (respawn happened), use strategic respawn and clean up particles
-					if wasExploding && !a.human.IsExploding {
-						// Use strategic respawn with ball positions
-						a.human.RespawnWithBalls(a.balls)
-
-						// Remove explosion particles from UI
-						for _, particle := range particlesBeforeUpdate {
-							if particle != nil {
-								a.content.Remove(particle)
-							}
-						}
-					}
-				}
-				}
+	// Advance the AI scheduler (AI searches run on some frames, physics on all)
+	a.aiScheduler.Advance()
 
-				// Update dragon if active (now protects the human)
-				if a.dragon != nil && a.dragon.IsActive {
-					a.dragon.Update(a.balls, a.human)
-					a.dragon.UpdatePosition()
-				}
+	// Pick up tuning file edits in dev mode
+	a.pollTuning()
 
-				// Update alien (drifts peacefully through the star field)
-				if a.alien != nil && a.alien.IsActive {
-					a.alien.Update()
+	// Update star field (background animation), speeding up in tense moments
+	if a.starField != nil && !a.plainDemo {
+		a.starField.SetIntensity(a.maxDangerLevel())
+		a.starField.Update()
+	}
+
+	// Let each ball's LLM personality steer it, then update positions (wall bouncing)
+	steeringContext := a.steeringContext()
+	if a.magnetism {
+		physics.ApplyMagneticForces(a.balls)
+	}
+	if a.starField != nil && !a.plainDemo {
+		a.starField.BlackHole.ApplyGravity(a.balls, a.humans)
+	}
+	for _, ball := range a.balls {
+		ball.ApplySteering(steeringContext)
+		ball.Update()
+	}
+	physics.BounceBalls(a.obstacles, a.balls)
+
+	// Pull tethered balls back together (bullets can cut tethers)
+	a.updateTethers()
+
+	// Clone any ball that touches the cloning pickup
+	a.updatePickups()
+
+	// Recolor irises by speed in heat map mode
+	if a.heatMap {
+		for _, ball := range a.balls {
+			ball.ShowSpeedColor()
+		}
+	}
+
+	// Update eyeball positions, each tracking the nearest active player
+	for _, ball := range a.balls {
+		if human := physics.NearestHuman(a.humans, ball.X, ball.Y); human != nil {
+			ball.UpdatePositionWithHuman(human.X, human.Y)
+		} else {
+			// If no human, use default positioning
+			ball.UpdatePosition()
+		}
+	}
+
+	// Check for ball-to-ball collisions
+	for i := 0; i < len(a.balls); i++ {
+		for j := i + 1; j < len(a.balls); j++ {
+			if a.balls[i].CheckCollision(a.balls[j]) {
+				// Same-colored balls touching gently merge instead of bouncing
+				if a.balls[i].CanMergeWith(a.balls[j]) {
+					a.mergeBalls(a.balls[i], a.balls[j])
+					continue
 				}
+
+				a.balls[i].HandleCollision(a.balls[j])
 			}
 		}
-	}()
+	}
+
+	// Let balls that linger near each other chat
+	if a.conversations != nil {
+		a.conversations.Update(a.balls)
+	}
+
+	// Feed keyboard, mouse and gamepad input to the players in manual control
+	a.applyPlayerInput()
+
+	// Update every player
+	for _, human := range a.humans {
+		a.updateHuman(human)
+	}
+
+	// Update dragon if active (protects whichever player is in the most danger)
+	if a.dragon != nil && a.dragon.IsActive {
+		a.dragon.Update(a.balls, a.dragonTarget())
+		a.dragon.UpdatePosition()
+		a.updateDragonEnergyBar()
+		a.updateDragonDebug()
+	}
+
+	// Update the rival dragon and its duel with the dragon (duel mode)
+	a.updateDuel()
+
+	// Update the aliens (flock through the star field, curious about the firing eye)
+	a.updateAliens()
+
+	// Run the boss's attacks (boss fight mode)
+	a.updateBossFight()
+
+	// Update laser sweep hazard (telegraph, sweep, hits)
+	a.updateLaserSweep()
+
+	// Start random events and run the space storm (warnings, bolts, hits)
+	a.updateRandomEvents()
+
+	// Announce explosions and clear out destroyed balls
+	a.updateBallLifecycle()
+
+	// Move and fade explosion sparks and dragon flames
+	a.updateParticles()
+	a.updateLightning()
+	a.screenShake.Update()
+	a.updateVignette()
+
+	// Spawn wave balls and check for a cleared round
+	a.updateWaves()
+	a.checkVictory()
+	a.updateGameMode()
+	a.updateTutorial()
+	a.celebration.Update()
+
+	// Offer an upgrade after a level-up and celebrate a new high score
+	a.checkLevelUp()
+	a.checkHighScore()
+
+	// Refresh the score display
+	a.updateHUD()
+	a.updatePlayer2HUD()
+	a.updateStaminaBar()
+
+	// Keep the last few seconds for a shareable clip
+	a.recordClipFrame()
+}
+
+// updateParticles advances the shared particle system and adds any circles it made this frame to the UI
+func (a *App) updateParticles() {
+	a.particles.Update()
+	for _, visual := range a.particles.TakeNewVisuals() {
+		a.content.Add(visual)
+	}
+}
+
+// addLightning adds lightning bolts to the UI, where they flicker until they fade
+func (a *App) addLightning(bolts []*effects.Lightning) {
+	for _, bolt := range bolts {
+		for _, line := range bolt.Lines {
+			a.content.Add(line)
+		}
+		a.lightning = append(a.lightning, bolt)
+	}
+}
+
+// updateLightning flickers the chain lightning bolts and removes the ones that have faded from the UI
+func (a *App) updateLightning() {
+	active := a.lightning[:0]
+	for _, bolt := range a.lightning {
+		if bolt.Update() {
+			active = append(active, bolt)
+			continue
+		}
+		for _, line := range bolt.Lines {
+			a.content.Remove(line)
+		}
+	}
+	a.lightning = active
+}
+
+// updateVignette pulses the screen edges while any player is down to their last HP and fades any flash
+func (a *App) updateVignette() {
+	inDanger := false
+	for _, human := range a.humans {
+		if human.LowHealth() {
+			inDanger = true
+			break
+		}
+	}
+	a.vignette.SetDanger(inDanger)
+	a.vignette.Update()
+}
+
+// addNewVisuals adds the projectile visuals that weren't there before an update to the UI
+func (a *App) addNewVisuals(before, after []fyne.CanvasObject) {
+	for _, visual := range after {
+		found := false
+		for _, old := range before {
+			if visual == old {
+				found = true
+				break
+			}
+		}
+		if !found {
+			a.content.Add(visual)
+			// Bring it to front so it's visible above other elements
+			visual.Show()
+		}
+	}
+}
+
+// updateHuman updates one player, adds its new bullets to the UI and runs its explosion and respawn
+func (a *App) updateHuman(human *physics.Human) {
+	if human.IsActive {
+		// Store bullets before update for UI management
+		bulletsBeforeUpdate := human.GetBulletVisuals()
+
+		human.Update(a.balls)
+
+		// Add new bullets to UI
+		a.addNewVisuals(bulletsBeforeUpdate, human.GetBulletVisuals())
+
+		// Add chain lightning bolts to UI
+		a.addLightning(human.TakePendingBolts())
+
+		// Check ball-human collisions
+		if human.CheckCollisionWithBalls(a.balls) {
+			a.explodeHuman(human)
+		}
+	}
+
+	// Always update explosion state (handles respawn timer and animation)
+	if human.IsExploding {
+		wasExploding := human.IsExploding
+
+		human.PlanRespawn(a.balls)
+		human.UpdateExplosion()
+
+		// If explosion just ended (respawn happened), use strategic respawn
+		if wasExploding && !human.IsExploding {
+			// Use strategic respawn with ball positions
+			human.RespawnWithBalls(a.balls)
+		}
+	}
+}
+
+// updateHUD refreshes the score display when the numbers change
+func (a *App) updateHUD() {
+	if a.hud == nil || a.human == nil {
+		return
+	}
+
+	text := fmt.Sprintf("Round: %d   ", a.round)
+	if a.waves.IsActive {
+		text = fmt.Sprintf("Wave: %d   ", a.waves.Wave)
+	}
+	text += a.gameModeSummary()
+	if a.isCoop() {
+		text += "P1  "
+	}
+	text += playerSummary(a.human)
+	if a.human.Control == physics.ControlManual {
+		text += "   [Manual]"
+	}
+	if text != a.hud.Text {
+		a.hud.Text = text
+		a.hud.Resize(a.hud.MinSize())
+		a.hud.Refresh()
+	}
+}
+
+// SetMagnetism turns magnetic attraction/repulsion between charged balls on or off
+func (a *App) SetMagnetism(enabled bool) {
+	a.magnetism = enabled
+	for _, ball := range a.balls {
+		ball.ShowCharge(enabled)
+	}
+}
+
+// SetHeatMap turns the speed heat map (iris colored blue when slow, red when fast) on or off
+func (a *App) SetHeatMap(enabled bool) {
+	a.heatMap = enabled
+	if !enabled {
+		for _, ball := range a.balls {
+			ball.RestoreIrisColor()
+		}
+	}
+}
+
+// SetHumanBrain switches the human's AI movement strategy by name. It returns false for unknown names.
+func (a *App) SetHumanBrain(name string) bool {
+	brain := physics.HumanBrainByName(name)
+	if brain == nil {
+		return false
+	}
+	a.human.Brain = brain
+	a.toolbar.SetLabel(actionBrain, brainLabel(brain.Name()))
+	return true
+}
+
+// SetBulletConfig sets how many times the human's bullets ricochet off walls and how long they live
+func (a *App) SetBulletConfig(config physics.BulletConfig) {
+	a.human.BulletConfig = config
+}
+
+// SetFiringPattern sets how every player's firing circle releases bullets
+func (a *App) SetFiringPattern(pattern physics.FiringPattern) {
+	for _, human := range a.humans {
+		human.SetFiringPattern(pattern)
+	}
+}
+
+// SetWallMode sets what balls do at the edges of the arena: bounce, wrap around or leave
+func (a *App) SetWallMode(mode physics.WallMode) {
+	a.world.WallMode = mode
+	a.toolbar.SetLabel(actionWalls, wallModeLabel(mode))
+}
+
+// Control bar actions relabeled as their setting changes
+const (
+	actionWalls      = "walls"
+	actionDifficulty = "difficulty"
+	actionBrain      = "brain"
+	actionWarp       = "warp"
+)
+
+// brainLabel returns the controls bar label for the human's AI strategy
+func brainLabel(name string) string {
+	return "🧠 " + name
+}
+
+// warpLabel returns the controls bar label for a warp level
+func warpLabel(level int) string {
+	if level == 0 {
+		return "🚀 Warp"
+	}
+	return fmt.Sprintf("🚀 Warp %d", level)
+}
+
+// wallModeLabel returns the controls bar label for a wall mode
+func wallModeLabel(mode physics.WallMode) string {
+	switch mode {
+	case physics.WallWrap:
+		return "🌀 Wrap"
+	case physics.WallAbsorb:
+		return "🕳️ Absorb"
+	default:
+		return "🧱 Bounce"
+	}
+}
+
+// difficultyLabel returns the controls bar label for a difficulty
+func difficultyLabel(difficulty physics.Difficulty) string {
+	switch difficulty {
+	case physics.DifficultyEasy:
+		return "🙂 Easy"
+	case physics.DifficultyHard:
+		return "😠 Hard"
+	case physics.DifficultyNightmare:
+		return "💀 Nightmare"
+	default:
+		return "😐 Normal"
+	}
+}
+
+// SetBallSizeRange sets the radius range balls shrink and grow within and resizes existing balls to fit
+func (a *App) SetBallSizeRange(min, max float32) error {
+	if err := a.world.SetBallSizeRange(min, max); err != nil {
+		return err
+	}
+	for _, ball := range a.balls {
+		ball.ConstrainSize()
+	}
+	return nil
+}
+
+// pollTuning checks the tuning file every few frames and applies new values to existing entities
+func (a *App) pollTuning() {
+	if a.tuningWatcher == nil {
+		return
+	}
+	a.tuningPollTimer--
+	if a.tuningPollTimer > 0 {
+		return
+	}
+	a.tuningPollTimer = tuningPollInterval
+
+	previous := physics.Tuning()
+	changed, err := a.tuningWatcher.Poll()
+	if err != nil {
+		log.Printf("tuning: %v", err)
+		return
+	}
+	if !changed {
+		return
+	}
+
+	// New entities read the registry when created; existing ones need the changed values copied over
+	for _, ball := range a.balls {
+		ball.ApplyTuning(previous)
+	}
+	for _, human := range a.humans {
+		human.ApplyTuning(previous)
+	}
+	if a.dragon != nil {
+		a.dragon.ApplyTuning(previous)
+	}
+	a.SetAlienCount(physics.Tuning().AlienCount)
+	if a.starField != nil {
+		a.starField.Nebula.SetDensity(physics.Tuning().NebulaDensity)
+		a.starField.Ambience.SetPeriod(physics.Tuning().AmbiencePeriod)
+	}
+	a.SetEffectsQuality(effects.QualityByName(physics.Tuning().EffectsQuality))
+	a.screenShake.SetEnabled(physics.Tuning().ScreenShake)
+	a.timeScale.SetEnabled(physics.Tuning().HitStop)
+	a.vignette.SetEnabled(physics.Tuning().Vignette)
+	log.Printf("tuning: reloaded %s", a.tuningWatcher.Path)
+}
+
+// alienContext gathers the world state the aliens react to
+func (a *App) alienContext() *physics.AlienContext {
+	if a.human == nil {
+		return nil
+	}
+	firingX, firingY := a.human.FiringPosition()
+	return &physics.AlienContext{
+		HumanX:      a.human.X,
+		HumanY:      a.human.Y,
+		HumanSize:   a.human.Size,
+		HumanActive: a.human.IsActive,
+		FiringX:     firingX,
+		FiringY:     firingY,
+		ShotsFired:  a.human.ShotsFired,
+	}
+}
+
+// SetAITickRate sets how many physics frames pass between expensive AI computations (1 = every frame)
+func (a *App) SetAITickRate(interval int) {
+	a.aiScheduler.SetInterval(interval)
+}
+
+// steeringContext gathers the world state ball personalities react to
+func (a *App) steeringContext() *physics.SteeringContext {
+	ctx := &physics.SteeringContext{}
+	if a.human != nil {
+		ctx.Humans = a.humans
+		ctx.Bullets = a.allBullets()
+	}
+	if a.starField != nil {
+		ctx.GalacticCenterX = a.starField.GalacticCenterX
+		ctx.GalacticCenterY = a.starField.GalacticCenterY
+	}
+	return ctx
+}
+
+// explodeHuman hits a player, blowing it up (and adding the explosion particles to the UI)
+// once it has no extra HP left
+func (a *App) explodeHuman(human *physics.Human) {
+	// A dragon rider is safe from direct hits; the dragon takes chip damage instead
+	if human.IsRiding() {
+		human.Mount.TakeChipDamage()
+		return
+	}
+
+	// Extra HP from upgrades soaks up the hit
+	if !human.TakeHit() {
+		return
+	}
+
+	// Store previous explosion state
+	wasExploding := human.IsExploding
+	human.Explode()
+
+	// Announce the explosion if it just started
+	if !wasExploding && human.IsExploding {
+		a.events.Publish(physics.Event{Type: physics.EventExplosion, X: human.X, Y: human.Y})
+		a.screenShake.Shake(humanExplosionShake, humanExplosionShakeFrames)
+		a.timeScale.HitStop(hitStopHoldFrames, hitStopRampFrames)
+		a.vignette.Flash(humanExplosionFlash)
+	}
+}
+
+// updateLaserSweep schedules laser sweeps and applies their hits to the human and balls
+func (a *App) updateLaserSweep() {
+	if a.laser == nil {
+		// Count down to the next sweep
+		a.laserTimer--
+		if a.laserTimer > 0 {
+			return
+		}
+
+		a.laser = physics.NewLaserSweep(a.currentBounds)
+		a.laser.Events = a.events
+		for _, component := range a.laser.GetVisualComponents() {
+			a.content.Add(component)
+		}
+		return
+	}
+
+	a.laser.Update()
+
+	// Stun any balls caught in the beam
+	a.laser.StunBalls(a.balls)
+
+	// The beam is deadly to the players
+	for _, human := range a.humans {
+		if a.laser.CheckHuman(human) {
+			a.explodeHuman(human)
+		}
+	}
+
+	// Remove the laser once it has crossed the arena and schedule the next one
+	if a.laser.IsFinished {
+		a.removeLaserSweep()
+	}
+}
+
+// removeLaserSweep removes the current laser from the UI and restarts the sweep timer
+func (a *App) removeLaserSweep() {
+	if a.laser != nil {
+		for _, component := range a.laser.GetVisualComponents() {
+			a.content.Remove(component)
+		}
+		a.laser = nil
+	}
+	a.laserTimer = laserSweepInterval
 }
 
 // Run starts the application
@@ -222,6 +806,10 @@ func (a *App) Run() {
 	gameAreaHeight := float32(600)
 	buttonHeight := float32(50)
 
+	a.gameArea = fyne.NewSize(gameAreaWidth, gameAreaHeight)
+	a.baseScale = a.startUIScale()
+	a.uiScale = a.baseScale
+
 	// Window size should exactly match game area + button area
 	windowWidth := gameAreaWidth
 	windowHeight := gameAreaHeight + buttonHeight
@@ -232,45 +820,34 @@ func (a *App) Run() {
 	a.window.CenterOnScreen()
 	a.window.SetFixedSize(true) // Make window non-resizable
 
-	// Create three bouncing balls with slower, more controlled velocities
-	ball1 := physics.NewCustomBall(
-		100, 100, // position
-		1.5, 1.2, // slower velocity (was 3.5, 2.8)
-		30, // radius
-		color.RGBA{R: 100, G: 150, B: 255, A: 255}, // Light blue fill
-		color.RGBA{R: 255, G: 50, B: 50, A: 255},   // Red stroke
-	)
-
-	ball2 := physics.NewCustomBall(
-		300, 200, // different starting position
-		-1.2, 1.8, // slower velocity (was -2.8, 4.2)
-		25, // smaller radius
-		color.RGBA{R: 255, G: 100, B: 100, A: 255}, // Light red fill
-		color.RGBA{R: 50, G: 255, B: 50, A: 255},   // Green stroke
-	)
+	// Restore the settings saved last time before anything reads them
+	a.loadSettings()
 
-	ball3 := physics.NewCustomBall(
-		500, 150, // different starting position
-		-1.8, -1.4, // slower velocity (was -4.1, -3.3)
-		35, // larger radius
-		color.RGBA{R: 100, G: 255, B: 100, A: 255}, // Light green fill
-		color.RGBA{R: 100, G: 50, B: 255, A: 255},  // Blue stroke
-	)
-
-	// Store all balls in a slice for easier management
-	a.balls = []*physics.Ball{ball1, ball2, ball3}
+	// Create the particle system every explosion and flame is emitted through
+	a.particles = effects.NewParticleSystem()
 
 	// Create the human figure
-	a.human = physics.NewHuman(400, 300, 35)
+	a.human = physics.NewHuman(player1StartX, player1StartY, 35)
+	a.human.AI = a.aiScheduler
+	a.human.Particles = a.particles
+	if physics.Tuning().MotionBlur {
+		a.human.EnableMotionGhosts()
+	}
+	a.humans = []*physics.Human{a.human}
 
 	// Create the dragon
 	a.dragon = physics.NewDragon(200, 200, 40)
+	a.dragon.AI = a.aiScheduler
+	a.dragon.Events = a.events
+	a.dragon.Particles = a.particles
 
-	// Create the mysterious alien that drifts through space
-	a.alien = physics.NewAlienFromFile(600, 150, 60, "alien.png") // Mysterious alien face that drifts peacefully
+	// Create the conversation manager (up to two chats at once)
+	a.conversations = physics.NewBallConversations(2)
 
 	// Create realistic star field background with galactic distribution
-	a.starField = physics.NewStarField(400, fyne.NewSize(gameAreaWidth, gameAreaHeight)) // 400 stars for better realistic distribution
+	a.starField = physics.NewStarField(defaultStarCount, fyne.NewSize(gameAreaWidth, gameAreaHeight)) // 400 stars for better realistic distribution
+
+	a.baseStarDensity = a.starField.Density
 
 	// Update bounds for all objects
 	a.updateBounds(fyne.NewSize(windowWidth, windowHeight))
@@ -281,65 +858,51 @@ func (a *App) Run() {
 	// Create the main game content container with proper sizing
 	a.content = container.NewWithoutLayout()
 	a.content.Resize(fyne.NewSize(gameAreaWidth, gameAreaHeight)) // Use the exact game area size
-
-	// Add star field to background (first layer)
-	for _, star := range a.starField.GetVisuals() {
-		a.content.Add(star)
+	a.screenShake = effects.NewScreenShake(a.content)
+	a.screenShake.SetEnabled(physics.Tuning().ScreenShake)
+	a.timeScale = effects.NewTimeScale()
+	a.timeScale.SetEnabled(physics.Tuning().HitStop)
+
+	// Add the backdrop color first, behind everything
+	a.backdrop = canvas.NewRectangle(a.backdropColor)
+	a.backdrop.Resize(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	a.content.Add(a.backdrop)
+
+	// Add the background layers (nebulae, planets, stars and debris) over it, hidden in the plain physics demo
+	a.spaceLayer = container.NewWithoutLayout(a.starField.Background.Container, a.starField.Constellations.Container)
+	a.spaceLayer.Resize(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	for _, component := range a.starField.Supernova.GetVisualComponents() {
+		a.spaceLayer.Add(component)
+	}
+	for _, component := range a.starField.BlackHole.GetVisualComponents() {
+		a.spaceLayer.Add(component)
+	}
+	a.content.Add(a.spaceLayer)
+	a.SetPlainDemo(a.plainDemo)
+
+	// Add the shadow layer between the stars and the entities
+	a.shadowLayer = container.NewWithoutLayout()
+	a.shadowLayer.Resize(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	a.content.Add(a.shadowLayer)
+	for _, component := range a.dragon.Shadow.GetVisualComponents() {
+		a.shadowLayer.Add(component)
 	}
 
-	// Add ball trails to container
-	for _, ball := range a.balls {
-		for _, trail := range ball.Trail {
-			a.content.Add(trail)
-		}
+	// Set up the custom level's obstacles, waves, walls and background, if there is one
+	a.applyLevel()
+
+	// Add the starter balls (eyeball, veins, iris, pupil, trail and label)
+	a.spawnInitialBalls()
+
+	// Add the cloning pickup (hidden until it spawns)
+	a.cloner = physics.NewCloningPickup()
+	for _, component := range a.cloner.GetVisualComponents() {
+		a.content.Add(component)
 	}
 
-	// Add balls (eyeball background, iris, pupils, and bloodshot veins)
-	a.content.Add(ball1.Circle) // White eyeball background
-	// Add bloodshot veins for ball1
-	for _, vein := range ball1.BloodVeins {
-		a.content.Add(vein)
-	}
-	a.content.Add(ball1.Iris)   // Colored iris
-	a.content.Add(ball1.Pupil)  // Black pupil
-
-	a.content.Add(ball2.Circle) // White eyeball background
-	// Add bloodshot veins for ball2
-	for _, vein := range ball2.BloodVeins {
-		a.content.Add(vein)
-	}
-	a.content.Add(ball2.Iris)   // Colored iris
-	a.content.Add(ball2.Pupil)  // Black pupil
-
-	a.content.Add(ball3.Circle) // White eyeball background
-	// Add bloodshot veins for ball3
-	for _, vein := range ball3.BloodVeins {
-		a.content.Add(vein)
-	}
-	a.content.Add(ball3.Iris)   // Colored iris
-	a.content.Add(ball3.Pupil)  // Black pupil
-
-	// Add ball text labels
-	a.content.Add(ball1.Text)
-	a.content.Add(ball2.Text)
-	a.content.Add(ball3.Text)
-
-	// Add human figure components (drawn programmatically with ball-tracking eyes)
-	a.content.Add(a.human.FiringCircle)   // Add firing circle first (behind human)
-	a.content.Add(a.human.Head)
-	a.content.Add(a.human.Body)
-	a.content.Add(a.human.LeftEye)
-	a.content.Add(a.human.RightEye)
-	a.content.Add(a.human.LeftPupil)
-	a.content.Add(a.human.RightPupil)
-	a.content.Add(a.human.LeftArm)
-	a.content.Add(a.human.RightArm)
-	a.content.Add(a.human.LeftLeg)
-	a.content.Add(a.human.RightLeg)
-	// Add firing components
-	a.content.Add(a.human.FiringEye)
-	a.content.Add(a.human.FiringIris)
-	a.content.Add(a.human.FiringPupil)
+	// Add human figure components (drawn programmatically with ball-tracking eyes),
+	// firing circle, respawn shield and minigame ring, plus the shadow
+	a.addHumanVisuals(a.human)
 
 	// Add dragon figure components
 	dragonComponents := a.dragon.GetVisualComponents()
@@ -347,24 +910,124 @@ func (a *App) Run() {
 		a.content.Add(component)
 	}
 
-	// Add alien figure components (drifts peacefully through space)
-	alienComponents := a.alien.GetVisualComponents()
-	for _, component := range alienComponents {
+	// Add the dragon's energy bar above it
+	a.dragonEnergyBar = a.createDragonEnergyBar()
+	for _, component := range a.dragonEnergyBar.GetVisualComponents() {
+		a.content.Add(component)
+	}
+	a.updateDragonEnergyBar()
+	a.SetDragonEnabled(!a.dragonOff) // Stays off if it was switched off last time
+
+	// Add the dragon state debug overlay (hidden until F3)
+	a.createDragonDebug()
+	a.content.Add(a.dragonDebug)
+
+	// Add the alien fleet (drifts peacefully through space in loose formations)
+	a.SetAlienCount(physics.Tuning().AlienCount)
+
+	// Add the victory confetti and fireworks
+	a.celebration = physics.NewCelebration(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	a.celebration.Particles = a.particles
+	for _, component := range a.celebration.GetVisualComponents() {
+		a.content.Add(component)
+	}
+
+	// Add the wave announcement
+	a.createWaveBanner(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	a.content.Add(a.waveBanner)
+	a.announceDragonGrowth()
+	a.announceRetreats()
+	a.announceBlackHoles()
+	a.watchSupernovae()
+
+	// Add the duel's dominance meter (shown in duel mode)
+	a.dominanceMeter = a.createDominanceMeter(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	for _, component := range a.dominanceMeter.GetVisualComponents() {
 		a.content.Add(component)
 	}
 
+	// Add the score display in the top-left corner
+	a.hud = &canvas.Text{
+		Color:     color.RGBA{R: 255, G: 255, B: 255, A: 200},
+		TextStyle: fyne.TextStyle{Bold: true, Monospace: true},
+		TextSize:  14,
+	}
+	a.hud.Move(fyne.NewPos(10, 8))
+	a.updateHUD()
+	a.content.Add(a.hud)
+
+	// Add player 2's score display (shown in co-op)
+	a.createPlayer2HUD()
+	a.content.Add(a.player2HUD)
+
+	// Add the sprint stamina bar under the score
+	a.staminaBar = a.createStaminaBar()
+	for _, component := range a.staminaBar.GetVisualComponents() {
+		a.content.Add(component)
+	}
+
+	// Add speech bubbles on top of the entities
+	for _, component := range a.conversations.GetVisualComponents() {
+		a.content.Add(component)
+	}
+
+	// Add the vignette over everything in play (clear until a player is low on HP or something explodes)
+	a.vignette = effects.NewVignette(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	a.vignette.SetEnabled(physics.Tuning().Vignette)
+	a.content.Add(a.vignette.Gradient)
+
+	// Scale the effects to the quality preset from the tuning file
+	a.SetEffectsQuality(effects.QualityByName(physics.Tuning().EffectsQuality))
+
+	// Add the input layer over the whole game area (double-click a ball to rename it, right-click for its menu)
+	a.input = newInputLayer()
+	a.input.run = a.onGameLoop
+	a.input.onDoubleTap = a.renameBallAt
+	a.input.onTap = a.sandboxTap
+	a.input.onSecondaryTap = a.showBallMenu
+	a.input.onDrag = a.sandboxDrag
+	a.input.onDragEnd = a.sandboxDragEnd
+	a.input.mouse = a.mouse
+	a.input.Resize(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	a.content.Add(a.input)
+
+	// Add the victory screen above the input layer so its button can be clicked
+	a.victoryScreen = a.createVictoryPanel(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	a.content.Add(a.victoryScreen.container)
+
+	// Add the level-up screen above the input layer so its buttons can be clicked
+	a.levelUpScreen = a.createLevelUpPanel(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	a.content.Add(a.levelUpScreen.container)
+
+	// Add the control bar's tooltips on top of everything
+	a.toolbar.tipLayer.Resize(fyne.NewSize(gameAreaWidth, gameAreaHeight))
+	a.content.Add(a.toolbar.tipLayer)
+
 	// Create the full layout with controls at top and game content filling the rest
 	fullContent := container.NewBorder(
 		controls,   // top
 		nil,        // bottom
 		nil,        // left
 		nil,        // right
-		a.content,  // center (game content container)
+		newLetterbox(a.content, a.gameArea, a.fitGameArea), // center (game area, scaled and letterboxed in fullscreen)
 	)
 
 	// Set the content
 	a.window.SetContent(fullContent)
 
+	// Keyboard: respawn minigame, control mode and manual movement
+	a.setupKeyboard()
+
+	// Gamepads: plugged in and unplugged through GLFW once the driver is running
+	a.watchGamepads()
+
+	// Set the world up for the saved game mode
+	a.applyGameMode()
+	a.resetGameMode()
+
+	// Walk first-time players through the basics
+	a.startTutorialOnFirstLaunch()
+
 	// Start the animation
 	a.startAnimation()
 
@@ -372,105 +1035,219 @@ func (a *App) Run() {
 	a.window.ShowAndRun()
 }
 
-// createControls creates the UI control buttons
-func (a *App) createControls() *fyne.Container {
-	// Create animation control buttons
-	startButton := widget.NewButton("▶️ Start All", func() {
-		for _, ball := range a.balls {
-			ball.IsAnimated = true
-		}
+// createControls builds the control bar from the core actions and those the subsystems register. Actions
+// that don't fit, or that the player moved off the bar, are in its ⋯ overflow menu.
+func (a *App) createControls() fyne.CanvasObject {
+	a.toolbar.attach(a.window)
+	a.toolbar.onChange = a.gameLoopFunc(a.saveSettings)
+
+	a.RegisterAction(ToolbarAction{
+		ID: "start", Label: "▶️ Start All", Group: "Balls", Tooltip: "Set every ball moving",
+		Run: func() {
+			for _, ball := range a.balls {
+				ball.Frozen = false
+				ball.IsAnimated = ball.CarriedBy == nil // A ball in the dragon's claws stays out of play
+			}
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "stop", Label: "⏸️ Stop All", Group: "Balls", Tooltip: "Stop every ball where it is",
+		Run: func() {
+			for _, ball := range a.balls {
+				ball.IsAnimated = false
+			}
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "ball", Label: "➕ Ball", Group: "Balls", Tooltip: "Add a ball of a random kind", Shortcut: fyne.KeyB,
+		Run: func() {
+			a.SpawnBall(BallOptions{Kind: physics.RandomBallKind()})
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "colors", Label: "🎨 Change Colors", Group: "Balls", Tooltip: "Give every ball a new iris color", Overflow: true,
+		Run: func() {
+			for _, ball := range a.balls {
+				ball.ChangeColor()
+			}
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "magnets", Label: "🧲 Magnets", Group: "Balls", Tooltip: "Toggle magnetic attraction between the balls", Shortcut: fyne.KeyG, Overflow: true,
+		Run: func() {
+			a.SetMagnetism(!a.magnetism)
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "tether", Label: "🔗 Tether", Group: "Balls", Tooltip: "Tie the two closest balls together", Overflow: true,
+		Run: func() {
+			a.linkNearestBalls()
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "heat", Label: "🌡️ Heat", Group: "Balls", Tooltip: "Toggle coloring the balls by speed", Shortcut: fyne.KeyH, Overflow: true,
+		Run: func() {
+			a.SetHeatMap(!a.heatMap)
+		},
 	})
 
-	stopButton := widget.NewButton("⏸️ Stop All", func() {
-		for _, ball := range a.balls {
-			ball.IsAnimated = false
-		}
+	a.RegisterAction(ToolbarAction{
+		ID: "waves", Label: "🌊 Waves", Group: "Game", Tooltip: "Toggle endless waves of balls",
+		Run: func() {
+			a.SetWaveMode(!a.waves.IsActive)
+		},
+	})
+	// Cycles the wall mode; the label shows the current mode
+	a.RegisterAction(ToolbarAction{
+		ID: actionWalls, Label: wallModeLabel(a.world.WallMode), Group: "Game", Tooltip: "Cycle what balls do at the edges: bounce, wrap or absorb", Shortcut: fyne.KeyL,
+		Run: func() {
+			a.SetWallMode(a.world.WallMode.Next())
+		},
+	})
+	// Cycles the difficulty; the label shows the current difficulty
+	a.RegisterAction(ToolbarAction{
+		ID: actionDifficulty, Label: difficultyLabel(physics.CurrentDifficulty), Group: "Game", Tooltip: "Cycle the difficulty",
+		Run: func() {
+			a.SetDifficulty(physics.CurrentDifficulty.Next())
+			a.saveSettings()
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "coop", Label: "👥 Co-op", Group: "Game", Tooltip: "Add or remove player 2", Shortcut: fyne.Key2, Overflow: true,
+		Run: func() {
+			a.SetCoop(!a.isCoop())
+		},
+	})
+	// Cycles the human's AI strategy; the label shows the current one
+	a.RegisterAction(ToolbarAction{
+		ID: actionBrain, Label: brainLabel(a.human.Brain.Name()), Group: "Game", Tooltip: "Cycle the strategy the eye moves by under AI control", Overflow: true,
+		Run: func() {
+			names := physics.HumanBrainNames()
+			next := names[(slices.Index(names, a.human.Brain.Name())+1)%len(names)]
+			a.SetHumanBrain(next)
+		},
 	})
 
-	colorButton := widget.NewButton("🎨 Change Colors", func() {
-		for _, ball := range a.balls {
-			ball.ChangeColor()
-		}
+	a.RegisterAction(ToolbarAction{
+		ID: "dragon", Label: "🐉 Dragon", Group: "Dragon", Tooltip: "Dragon settings", Shortcut: fyne.KeyD,
+		Run: func() {
+			a.showDragonSettings()
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "duel", Label: "⚔️ Duel", Group: "Dragon", Tooltip: "Toggle a duel with a rival dragon", Overflow: true,
+		Run: func() {
+			a.SetDuelMode(a.duel == nil)
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "hostile", Label: "👽 Hostile", Group: "Dragon", Tooltip: "Toggle the aliens firing plasma orbs at you", Overflow: true,
+		Run: func() {
+			a.SetHostileAliens(!a.hostileAliens)
+		},
 	})
 
-	resetButton := widget.NewButton("🔄 Reset All", func() {
-		a.resetAll()
+	a.RegisterAction(ToolbarAction{
+		ID: "nebula", Label: "🌌 Nebula", Group: "Space", Tooltip: "Toggle the nebula clouds", Overflow: true,
+		Run: func() {
+			a.starField.Nebula.SetVisible(!a.starField.Nebula.IsVisible)
+		},
+	})
+	// Cycles the warp level; the label shows the current level
+	a.RegisterAction(ToolbarAction{
+		ID: actionWarp, Label: warpLabel(0), Group: "Space", Tooltip: "Cycle the star field's warp speed", Overflow: true,
+		Run: func() {
+			a.starField.SetWarp((a.starField.Warp + 1) % (physics.MaxWarp + 1))
+			a.toolbar.SetLabel(actionWarp, warpLabel(a.starField.Warp))
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "constellations", Label: "✨ Constellations", Group: "Space", Tooltip: "Toggle the constellation lines", Overflow: true,
+		Run: func() {
+			a.starField.Constellations.SetVisible(!a.starField.Constellations.IsVisible)
+		},
 	})
+	a.RegisterAction(ToolbarAction{
+		ID: "static", Label: "🔋 Static Stars", Group: "Space", Tooltip: "Toggle still stars (saves power)", Overflow: true,
+		Run: func() {
+			a.starField.SetStatic(!a.starField.Static)
+		},
+	})
+
+	// The sandbox, clip recorder and leaderboard bring their own actions
+	a.registerSandboxActions()
+	a.registerClipActions()
+	a.registerLeaderboardActions()
 
-	quitButton := widget.NewButton("❌ Quit", func() {
-		a.fyneApp.Quit()
+	a.RegisterAction(ToolbarAction{
+		ID: "settings", Label: "⚙️ Settings", Group: "App", Tooltip: "Game mode, difficulty, looks and more", Shortcut: fyne.KeyComma,
+		Run: func() {
+			a.showSettings()
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "reset", Label: "🔄 Reset All", Group: "App", Tooltip: "Start a new game", Shortcut: fyne.KeyR,
+		Run: func() {
+			a.resetAll()
+		},
+	})
+	a.RegisterAction(ToolbarAction{
+		ID: "quit", Label: "❌ Quit", Group: "App", Tooltip: "Quit the game", Shortcut: fyne.KeyQ, Overflow: true,
+		Run: func() {
+			a.quit()
+		},
 	})
 
-	// Create a horizontal container for buttons with even spacing
-	return container.NewGridWithColumns(5,
-		startButton,
-		stopButton,
-		colorButton,
-		resetButton,
-		quitButton,
-	)
+	return a.toolbar.bar
 }
 
 // resetAll resets all objects to their initial state
 func (a *App) resetAll() {
-	// Reset ball positions and velocities (slower speeds)
-	a.balls[0].X = 100
-	a.balls[0].Y = 100
-	a.balls[0].VX = 1.5
-	a.balls[0].VY = 1.2
-
-	a.balls[1].X = 300
-	a.balls[1].Y = 200
-	a.balls[1].VX = -1.2
-	a.balls[1].VY = 1.8
-
-	a.balls[2].X = 500
-	a.balls[2].Y = 150
-	a.balls[2].VX = -1.8
-	a.balls[2].VY = -1.4
-
-	// Update ball visual positions
-	for _, ball := range a.balls {
-		ball.Circle.Move(fyne.NewPos(ball.X-ball.Radius, ball.Y-ball.Radius))
-	}
-
-	// Reset human
-	a.human.X = 400
-	a.human.Y = 300
-	a.human.IsExploding = false
-	a.human.IsActive = true
-	a.human.RespawnTimer = 0
-	a.human.Rotation = 0 // Reset rotation
-	// Show human components
-	a.human.Head.Show()
-	a.human.Body.Show()
-	a.human.LeftEye.Show()
-	a.human.RightEye.Show()
-	a.human.LeftPupil.Show()
-	a.human.RightPupil.Show()
-	a.human.LeftArm.Show()
-	a.human.RightArm.Show()
-	a.human.LeftLeg.Show()
-	a.human.RightLeg.Show()
-	a.human.FiringCircle.Show()
-	a.human.FiringEye.Show()
-	a.human.FiringIris.Show()
-	a.human.FiringPupil.Show()
-	a.human.UpdatePosition()
+	// Keep the best score for the next game to beat, then reset the players
+	a.recordHighScore()
+	a.human.Reset(player1StartX, player1StartY)
+	if a.isCoop() {
+		a.humans[1].Reset(player2StartX, player2StartY)
+	}
+
+	// Back to round 1: replace all balls (including any spawned at runtime) with the starter set
+	a.round = 1
+	a.startRound()
+	if a.levelUpScreen != nil {
+		a.levelUpScreen.hide()
+	}
+	a.levelUpHuman = nil
+	a.paused = false
+	a.resetGameMode()
+	if a.waves.IsActive {
+		a.startWaves()
+	}
 
 	// Reset dragon
 	a.dragon.X = 200
 	a.dragon.Y = 200
 	a.dragon.VX = 0
 	a.dragon.VY = 0
-	a.dragon.IsActive = true
-	a.dragon.Show()
-	a.dragon.UpdatePosition()
-
-	// Reset alien to a new random position at screen edge
-	if a.alien != nil {
-		a.alien.Respawn()
-		a.alien.IsActive = true
-		a.alien.Show()
-	}
+	a.dragon.HP = a.dragon.MaxHP
+	a.dragon.RestoreEnergy()
+	a.dragon.SetState(physics.DragonFollow)
+	a.dragon.ResetProgression()
+	a.SetDragonEnabled(!a.dragonOff)
+	a.updateDragonEnergyBar()
+	a.resetDuel()
+
+	// End any conversations in progress
+	a.conversations.Reset()
+
+	// Clear any laser sweep or storm in progress
+	a.removeLaserSweep()
+	a.removeStorm()
+	a.randomEvents.Reset()
+
+	// Clear the cloning pickup
+	a.cloner.Hide()
+	a.pickupTimer = cloningPickupInterval
+
+	// Reset the aliens to new random positions at the screen edges
+	a.resetAliens()
 }
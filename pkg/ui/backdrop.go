@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// UIMode selects the light or dark look of the window's widgets (the control bar and dialogs)
+type UIMode int
+
+// UI modes
+const (
+	UIModeSystem UIMode = iota // follow the operating system
+	UIModeDark
+	UIModeLight
+)
+
+// UIModes lists every UI mode in the order they are offered
+var UIModes = []UIMode{UIModeSystem, UIModeDark, UIModeLight}
+
+// String returns the UI mode's display name
+func (m UIMode) String() string {
+	switch m {
+	case UIModeDark:
+		return "Dark"
+	case UIModeLight:
+		return "Light"
+	default:
+		return "System"
+	}
+}
+
+// UIModeByName returns the UI mode with the given display name (any case), or UIModeSystem for unknown names
+func UIModeByName(name string) UIMode {
+	for _, mode := range UIModes {
+		if strings.EqualFold(mode.String(), name) {
+			return mode
+		}
+	}
+	return UIModeSystem
+}
+
+// variantTheme is Fyne's default theme pinned to one variant, whatever the operating system prefers
+type variantTheme struct {
+	fyne.Theme
+	variant fyne.ThemeVariant
+}
+
+// Color returns the default theme's color for the pinned variant
+func (t *variantTheme) Color(name fyne.ThemeColorName, _ fyne.ThemeVariant) color.Color {
+	return t.Theme.Color(name, t.variant)
+}
+
+// backdropPreset is a named backdrop color offered in the settings
+type backdropPreset struct {
+	name  string
+	color color.RGBA
+}
+
+// backdropPresets lists the backdrop colors offered in the settings; the first is the default
+var backdropPresets = []backdropPreset{
+	{"Deep space", color.RGBA{R: 23, G: 23, B: 24, A: 255}},
+	{"Black", color.RGBA{R: 0, G: 0, B: 0, A: 255}},
+	{"Midnight blue", color.RGBA{R: 10, G: 16, B: 44, A: 255}},
+	{"Charcoal", color.RGBA{R: 48, G: 48, B: 52, A: 255}},
+	{"Slate", color.RGBA{R: 70, G: 82, B: 96, A: 255}},
+	{"Paper white", color.RGBA{R: 240, G: 240, B: 232, A: 255}},
+}
+
+// backdropPresetName returns the name of the preset with the given color, or "Custom"
+func backdropPresetName(c color.RGBA) string {
+	for _, preset := range backdropPresets {
+		if preset.color == c {
+			return preset.name
+		}
+	}
+	return "Custom"
+}
+
+// SetUIMode switches the control bar and dialogs between the system's look, dark and light
+func (a *App) SetUIMode(mode UIMode) {
+	a.uiMode = mode
+	if a.fyneApp == nil {
+		return
+	}
+	switch mode {
+	case UIModeDark:
+		a.fyneApp.Settings().SetTheme(&variantTheme{Theme: theme.DefaultTheme(), variant: theme.VariantDark})
+	case UIModeLight:
+		a.fyneApp.Settings().SetTheme(&variantTheme{Theme: theme.DefaultTheme(), variant: theme.VariantLight})
+	default:
+		a.fyneApp.Settings().SetTheme(theme.DefaultTheme())
+	}
+}
+
+// SetBackdropColor sets the solid color behind the game area. It shows between the stars, and on its own
+// in the plain physics demo.
+func (a *App) SetBackdropColor(c color.RGBA) {
+	a.backdropColor = c
+	if a.backdrop == nil {
+		return
+	}
+	a.backdrop.FillColor = c
+	a.backdrop.Refresh()
+}
+
+// SetPlainDemo switches the plain physics demo on or off: just the balls and players bouncing around on
+// the backdrop color, without the star field, black holes, supernovae, aliens or space storms
+func (a *App) SetPlainDemo(plain bool) {
+	a.plainDemo = plain
+	if a.spaceLayer != nil {
+		if plain {
+			a.spaceLayer.Hide()
+		} else {
+			a.spaceLayer.Show()
+		}
+	}
+	if plain {
+		a.removeStorm()
+	}
+	a.SetAlienCount(physics.Tuning().AlienCount) // None while the demo is on
+}
@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// showBallMenu opens the context menu of the ball under a right-click. In manual control the right button
+// swipes instead, so the menu stays shut.
+func (a *App) showBallMenu(pos, absolute fyne.Position) {
+	if a.window == nil || a.human.Control == physics.ControlManual {
+		return
+	}
+	ball := a.ballAt(pos)
+	if ball == nil {
+		return
+	}
+
+	// The ball may be destroyed or removed while the menu is open; its actions then do nothing
+	onBall := func(action func(*physics.Ball)) func() {
+		return a.gameLoopFunc(func() {
+			if a.ballInPlay(ball) {
+				action(ball)
+			}
+		})
+	}
+
+	freezeLabel := "🧊 Freeze"
+	if ball.Frozen {
+		freezeLabel = "▶️ Unfreeze"
+	}
+	makeBoss := fyne.NewMenuItem("👑 Make boss", onBall(a.makeBoss))
+	makeBoss.Disabled = ball.Kind == physics.KindBoss
+
+	menu := fyne.NewMenu(ball.LLMName,
+		fyne.NewMenuItem("🎨 Change color", onBall(func(ball *physics.Ball) {
+			ball.ChangeColor()
+		})),
+		fyne.NewMenuItem("✏️ Rename…", onBall(a.renameBall)),
+		fyne.NewMenuItem(freezeLabel, onBall(a.toggleFreeze)),
+		fyne.NewMenuItem("🔍 Inspect…", onBall(a.inspectBall)),
+		makeBoss,
+		fyne.NewMenuItemSeparator(),
+		fyne.NewMenuItem("🗑️ Delete", onBall(func(ball *physics.Ball) {
+			a.RemoveBall(ball.ID)
+		})),
+	)
+	widget.ShowPopUpMenuAtPosition(menu, a.window.Canvas(), absolute)
+}
+
+// ballInPlay reports whether the ball is still in the game and not on its way out
+func (a *App) ballInPlay(ball *physics.Ball) bool {
+	return slices.Contains(a.balls, ball) && !ball.IsDestroyed && !ball.IsAbsorbed
+}
+
+// makeBoss turns a ball into a boss, adding the HP bar it grows to the game area
+func (a *App) makeBoss(ball *physics.Ball) {
+	if ball.Kind == physics.KindBoss {
+		return
+	}
+	hadHPBar := ball.HPBar != nil
+	ball.SetKind(physics.KindBoss)
+	if a.content != nil && !hadHPBar {
+		for _, component := range ball.HPBar.GetVisualComponents() {
+			a.content.Add(component)
+		}
+	}
+}
+
+// toggleFreeze stops the ball where it is, or sets it moving again. A ball in the dragon's claws or held by
+// the sandbox pointer stays out of play until it is let go, and then stays frozen if it was frozen meanwhile.
+func (a *App) toggleFreeze(ball *physics.Ball) {
+	ball.Frozen = !ball.Frozen
+	ball.IsAnimated = !ball.Frozen && ball.CarriedBy == nil && a.sandbox.held != ball
+}
+
+// inspectBall shows a snapshot of the ball's state
+func (a *App) inspectBall(ball *physics.Ball) {
+	if a.window == nil {
+		return
+	}
+
+	var status []string
+	if ball.Frozen {
+		status = append(status, "frozen in place")
+	}
+	if ball.CarriedBy != nil {
+		status = append(status, "carried by the dragon")
+	}
+	if a.sandbox.held == ball {
+		status = append(status, "held by the pointer")
+	}
+	if !ball.IsAnimated && !ball.Frozen && ball.CarriedBy == nil && a.sandbox.held != ball {
+		status = append(status, "stopped")
+	}
+	if ball.StunTimer > 0 {
+		status = append(status, "stunned")
+	}
+	if ball.FrozenTimer > 0 {
+		status = append(status, "chilled")
+	}
+	if ball.BurnTimer > 0 {
+		status = append(status, "burning")
+	}
+	if len(status) == 0 {
+		status = append(status, "moving")
+	}
+
+	charge := "neutral"
+	if ball.Charge > 0 {
+		charge = "+"
+	} else if ball.Charge < 0 {
+		charge = "−"
+	}
+
+	speed := math.Hypot(float64(ball.VX), float64(ball.VY))
+	label := func(text string) *widget.Label {
+		return widget.NewLabelWithStyle(text, fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+	}
+	form := widget.NewForm(
+		widget.NewFormItem("ID", label(fmt.Sprintf("%d", ball.ID))),
+		widget.NewFormItem("Name", label(ball.LLMName)),
+		widget.NewFormItem("Kind", label(ball.Kind.String())),
+		widget.NewFormItem("Radius", label(fmt.Sprintf("%.1f px", ball.Radius))),
+		widget.NewFormItem("Position", label(fmt.Sprintf("(%.0f, %.0f)", ball.X, ball.Y))),
+		widget.NewFormItem("Velocity", label(fmt.Sprintf("(%.2f, %.2f)  %.2f px/frame", ball.VX, ball.VY, speed))),
+		widget.NewFormItem("HP", label(fmt.Sprintf("%d / %d", ball.HP, ball.MaxHP))),
+		widget.NewFormItem("Charge", label(charge)),
+		widget.NewFormItem("Status", label(strings.Join(status, ", "))),
+	)
+	inspect := dialog.NewCustom("🔍 "+ball.LLMName, "Close", form, a.window)
+	inspect.Resize(fyne.NewSize(420, 0))
+	inspect.Show()
+}
@@ -0,0 +1,303 @@
+package ui
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// BallOptions describes a ball to spawn at runtime. Zero values are randomized.
+type BallOptions struct {
+	X, Y        float32          // spawn position (0,0 = random safe position)
+	VX, VY      float32          // initial velocity (0,0 = random direction)
+	Radius      float32          // ball radius (0 = random 25-35)
+	FillColor   color.RGBA       // iris color (zero = random)
+	StrokeColor color.RGBA       // iris border color (zero = darker shade of FillColor)
+	Name        string           // label shown under the ball (empty = random LLM name)
+	Kind        physics.BallKind // collision behavior (zero = standard)
+}
+
+// irisPalette holds the iris colors used for randomly spawned balls
+var irisPalette = []color.RGBA{
+	{R: 100, G: 150, B: 255, A: 255}, // Light blue
+	{R: 255, G: 100, B: 100, A: 255}, // Light red
+	{R: 100, G: 255, B: 100, A: 255}, // Light green
+	{R: 255, G: 140, B: 0, A: 255},   // Orange
+	{R: 200, G: 100, B: 255, A: 255}, // Violet
+}
+
+// initialBalls are the three starter balls created on launch and reset
+var initialBalls = []BallOptions{
+	{
+		X: 100, Y: 100, // position
+		VX: 1.5, VY: 1.2, // slower velocity (was 3.5, 2.8)
+		Radius:      30,
+		FillColor:   color.RGBA{R: 100, G: 150, B: 255, A: 255}, // Light blue fill
+		StrokeColor: color.RGBA{R: 255, G: 50, B: 50, A: 255},   // Red stroke
+	},
+	{
+		X: 300, Y: 200, // different starting position
+		VX: -1.2, VY: 1.8, // slower velocity (was -2.8, 4.2)
+		// smaller radius
+		Radius:      25,
+		FillColor:   color.RGBA{R: 255, G: 100, B: 100, A: 255}, // Light red fill
+		StrokeColor: color.RGBA{R: 50, G: 255, B: 50, A: 255},   // Green stroke
+	},
+	{
+		X: 500, Y: 150, // different starting position
+		VX: -1.8, VY: -1.4, // slower velocity (was -4.1, -3.3)
+		// larger radius
+		Radius:      35,
+		FillColor:   color.RGBA{R: 100, G: 255, B: 100, A: 255}, // Light green fill
+		StrokeColor: color.RGBA{R: 100, G: 50, B: 255, A: 255},  // Blue stroke
+	},
+}
+
+// SpawnBall creates a new ball at runtime and adds all of its canvas components to the game area
+func (a *App) SpawnBall(opts BallOptions) *physics.Ball {
+	opts = a.fillBallDefaults(opts)
+
+	ball := physics.NewCustomBall(opts.X, opts.Y, opts.VX, opts.VY, opts.Radius, opts.FillColor, opts.StrokeColor)
+	ball.Bounds = a.currentBounds
+	ball.World = a.world
+	ball.IsAnimated = true
+	ball.ConstrainSize()
+	if opts.Name != "" {
+		ball.SetName(opts.Name)
+	}
+	if opts.Kind != physics.KindStandard {
+		ball.SetKind(opts.Kind)
+	}
+
+	a.addBall(ball)
+	return ball
+}
+
+// addBall puts an already created ball into play and registers all of its canvas components
+func (a *App) addBall(ball *physics.Ball) {
+	ball.ShowCharge(a.magnetism)
+	ball.Particles = a.particles
+	if physics.Tuning().MotionBlur && ball.Ghosts == nil {
+		ball.EnableMotionGhosts()
+	}
+	a.balls = append(a.balls, ball)
+
+	// Register the eyeball, veins, trail, ghosts and label with the canvas
+	if a.content != nil {
+		for _, component := range ball.GetVisualComponents() {
+			a.content.Add(component)
+		}
+	}
+	if a.shadowLayer != nil {
+		for _, component := range ball.Shadow.GetVisualComponents() {
+			a.shadowLayer.Add(component)
+		}
+	}
+}
+
+// RemoveBall removes the ball with the given ID and all of its canvas components.
+// It returns false if no ball has that ID.
+func (a *App) RemoveBall(id int) bool {
+	for i, ball := range a.balls {
+		if ball.ID != id {
+			continue
+		}
+
+		a.severTethers(ball)
+		if a.sandbox.held == ball {
+			a.sandbox.held = nil
+		}
+		if carrier := ball.CarriedBy; carrier != nil {
+			carrier.DropBall()
+		}
+		for _, human := range a.humans {
+			if human.LockedTarget == ball {
+				human.ReleaseLock()
+			}
+		}
+
+		if a.content != nil {
+			for _, component := range ball.GetVisualComponents() {
+				a.content.Remove(component)
+			}
+		}
+		if a.shadowLayer != nil {
+			for _, component := range ball.Shadow.GetVisualComponents() {
+				a.shadowLayer.Remove(component)
+			}
+		}
+
+		a.balls = append(a.balls[:i], a.balls[i+1:]...)
+		return true
+	}
+	return false
+}
+
+// updateBallLifecycle announces new explosions, flashes the screen when balls are destroyed, holds a hit-stop on boss kills, knocks the players back from
+// explosions and boss slams, and removes balls that were destroyed or absorbed once their animations finish
+func (a *App) updateBallLifecycle() {
+	for _, ball := range a.balls {
+		if ball.TakePendingExplosion() {
+			a.events.Publish(physics.Event{Type: physics.EventExplosion, X: ball.X, Y: ball.Y})
+			if ball.IsDestroyed {
+				a.vignette.Flash(ballDestroyedFlash)
+			}
+			if ball.Kind == physics.KindBoss && ball.IsDestroyed {
+				a.timeScale.HitStop(hitStopHoldFrames, hitStopRampFrames)
+			}
+		}
+		for _, blast := range ball.TakePendingBlasts() {
+			if blast.Slam {
+				a.screenShake.Shake(bossSlamShake, bossSlamShakeFrames)
+			}
+			for _, human := range a.humans {
+				human.ApplyBlast(blast)
+			}
+		}
+	}
+
+	for i := len(a.balls) - 1; i >= 0; i-- {
+		if a.balls[i].DestructionComplete() || a.balls[i].AbsorptionComplete() {
+			a.RemoveBall(a.balls[i].ID)
+		}
+	}
+}
+
+// ballAt returns the topmost live ball under the given game-area position, or nil
+func (a *App) ballAt(pos fyne.Position) *physics.Ball {
+	for i := len(a.balls) - 1; i >= 0; i-- {
+		ball := a.balls[i]
+		if ball.IsDestroyed || ball.IsAbsorbed {
+			continue
+		}
+		dx := pos.X - ball.X
+		dy := pos.Y - ball.Y
+		if dx*dx+dy*dy <= ball.Radius*ball.Radius {
+			return ball
+		}
+	}
+	return nil
+}
+
+// renameBallAt opens a rename dialog for the ball under the given position
+func (a *App) renameBallAt(pos fyne.Position) {
+	if ball := a.ballAt(pos); ball != nil {
+		a.renameBall(ball)
+	}
+}
+
+// renameBall opens a rename dialog for the ball
+func (a *App) renameBall(ball *physics.Ball) {
+	if a.window == nil {
+		return
+	}
+
+	entry := widget.NewEntry()
+	entry.SetText(ball.LLMName)
+	items := []*widget.FormItem{widget.NewFormItem("Name", entry)}
+
+	dialog.ShowForm("Rename Eyeball", "Rename", "Cancel", items, func(confirmed bool) {
+		name := strings.TrimSpace(entry.Text)
+		if !confirmed || name == "" {
+			return
+		}
+		a.onGameLoop(func() {
+			ball.SetName(name)
+			ball.ShowCharge(a.magnetism) // Keep the charge sign when magnetism is on
+		})
+	}, a.window)
+}
+
+// mergeBalls merges two balls, keeping the larger one as the survivor
+func (a *App) mergeBalls(first, second *physics.Ball) {
+	if second.Radius > first.Radius {
+		first, second = second, first
+	}
+	first.MergeWith(second)
+}
+
+// removeAllBalls removes every ball from the game area
+func (a *App) removeAllBalls() {
+	for len(a.balls) > 0 {
+		a.RemoveBall(a.balls[0].ID)
+	}
+}
+
+// spawnInitialBalls creates the custom level's balls, or else the starter set of balls, topped up with
+// random ones (or cut short) to the ball count from the settings
+func (a *App) spawnInitialBalls() {
+	if a.spawnLevelBalls() {
+		return
+	}
+	for i := 0; i < a.ballCount; i++ {
+		if i < len(initialBalls) {
+			a.SpawnBall(initialBalls[i])
+		} else {
+			a.SpawnBall(BallOptions{})
+		}
+	}
+}
+
+// fillBallDefaults replaces zero-valued options with random values
+func (a *App) fillBallDefaults(opts BallOptions) BallOptions {
+	if opts.Radius <= 0 {
+		opts.Radius = 25 + rand.Float32()*10
+	}
+
+	if opts.X == 0 && opts.Y == 0 {
+		opts.X, opts.Y = a.randomSpawnPosition(opts.Radius)
+	}
+
+	if opts.VX == 0 && opts.VY == 0 {
+		// Same gentle speed range as the starter balls
+		angle := rand.Float64() * 2 * math.Pi
+		speed := 1.2 + rand.Float32()*0.8
+		opts.VX = float32(math.Cos(angle)) * speed
+		opts.VY = float32(math.Sin(angle)) * speed
+	}
+
+	if opts.FillColor.A == 0 {
+		opts.FillColor = irisPalette[rand.Intn(len(irisPalette))]
+	}
+
+	if opts.StrokeColor.A == 0 {
+		opts.StrokeColor = color.RGBA{
+			R: uint8(float32(opts.FillColor.R) * 0.7),
+			G: uint8(float32(opts.FillColor.G) * 0.7),
+			B: uint8(float32(opts.FillColor.B) * 0.7),
+			A: 255,
+		}
+	}
+
+	return opts
+}
+
+// randomSpawnPosition picks a random position inside the game area, preferring spots away from the players
+func (a *App) randomSpawnPosition(radius float32) (float32, float32) {
+	minHumanDistance := float32(150)
+
+	var x, y float32
+	for attempts := 0; attempts < 20; attempts++ {
+		x = radius + rand.Float32()*(a.currentBounds.Width-2*radius)
+		y = radius + rand.Float32()*(a.currentBounds.Height-2*radius)
+
+		human := physics.NearestHuman(a.humans, x, y)
+		if human == nil {
+			break
+		}
+
+		dx := x - human.X
+		dy := y - human.Y
+		if float32(math.Sqrt(float64(dx*dx+dy*dy))) > minHumanDistance {
+			break // Far enough from the human
+		}
+	}
+
+	return x, y
+}
@@ -0,0 +1,120 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// Boss fight layout
+const (
+	bossName         = "The Overseer"
+	bossBarTop       = float32(44)  // the boss's HP bar sits just above this line and its name just below
+	bossBarWidth     = float32(0.6) // share of the game area's width the boss's HP bar spans
+	bossMinionRadius = float32(22)
+)
+
+// bossBar is the boss fight's HP bar across the top of the game area, with the boss's name and phase
+type bossBar struct {
+	title *canvas.Text
+	bar   *physics.HPBar
+}
+
+// startBossFight clears the arena and brings on the giant boss eyeball
+func (a *App) startBossFight() {
+	a.stopBossFight()
+	a.removeAllBalls()
+
+	boss := a.SpawnBall(BallOptions{
+		X: a.currentBounds.Width / 2, Y: a.currentBounds.Height / 3,
+		Name: bossName,
+		Kind: physics.KindBoss,
+	})
+	a.bossFight = physics.NewBossFight(boss, a.currentBounds)
+	if a.bossBar == nil {
+		a.createBossBar()
+	}
+	a.updateBossBar()
+	a.bossBar.title.Show()
+	a.bossBar.bar.Show()
+	a.announceWave("👁️ " + bossName)
+}
+
+// stopBossFight ends any boss fight, clearing its orbs and HP bar
+func (a *App) stopBossFight() {
+	if a.bossFight == nil {
+		return
+	}
+	a.bossFight.ClearOrbs()
+	a.bossFight = nil
+	a.bossBar.title.Hide()
+	a.bossBar.bar.Hide()
+}
+
+// createBossBar builds the boss's HP bar across the top of the game area
+func (a *App) createBossBar() {
+	a.bossBar = &bossBar{
+		title: &canvas.Text{
+			Color:     color.RGBA{R: 255, G: 120, B: 140, A: 255},
+			TextSize:  14,
+			TextStyle: fyne.TextStyle{Bold: true},
+			Alignment: fyne.TextAlignCenter,
+		},
+		bar: physics.NewHPBar(),
+	}
+	a.bossBar.title.Resize(fyne.NewSize(a.currentBounds.Width, 18))
+	a.bossBar.title.Move(fyne.NewPos(0, bossBarTop))
+	a.content.Add(a.bossBar.title)
+	for _, component := range a.bossBar.bar.GetVisualComponents() {
+		a.content.Add(component)
+	}
+}
+
+// updateBossBar shows the boss's remaining HP and attack phase
+func (a *App) updateBossBar() {
+	boss := a.bossFight.Boss
+	a.bossBar.bar.Update(a.currentBounds.Width/2, bossBarTop, a.currentBounds.Width*bossBarWidth, boss.HP, boss.MaxHP)
+	title := fmt.Sprintf("👁️ %s — %s", bossName, a.bossFight.Phase())
+	if title != a.bossBar.title.Text {
+		a.bossBar.title.Text = title
+		a.bossBar.title.Refresh()
+	}
+}
+
+// updateBossFight runs the boss's attacks: its orbs hit the humans like balls do, and its minions join the arena
+func (a *App) updateBossFight() {
+	if a.bossFight == nil {
+		return
+	}
+
+	orbsBeforeUpdate := a.bossFight.GetOrbVisuals()
+	a.bossFight.Update(a.humans)
+	a.addNewVisuals(orbsBeforeUpdate, a.bossFight.GetOrbVisuals())
+
+	for _, human := range a.humans {
+		if human.IsActive && a.bossFight.OrbHits(human) {
+			a.explodeHuman(human)
+		}
+	}
+
+	// Minions appear just outside the boss, flying outward
+	boss := a.bossFight.Boss
+	for i := a.bossFight.TakeSummons(); i > 0; i-- {
+		angle := rand.Float64() * 2 * math.Pi
+		dx, dy := float32(math.Cos(angle)), float32(math.Sin(angle))
+		distance := boss.Radius + bossMinionRadius + 4
+		a.SpawnBall(BallOptions{
+			X: boss.X + dx*distance, Y: boss.Y + dy*distance,
+			VX: dx * 2, VY: dy * 2,
+			Radius: bossMinionRadius,
+			Kind:   physics.RandomBallKind(),
+		})
+	}
+
+	a.updateBossBar()
+}
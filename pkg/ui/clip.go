@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"github.com/atyronesmith/bouncing-balls/pkg/clip"
+)
+
+// registerClipActions adds saving a clip to the control bar
+func (a *App) registerClipActions() {
+	a.RegisterAction(ToolbarAction{
+		ID: "clip", Label: "🎬 Clip", Group: "Share", Tooltip: "Save the last 10 seconds as an animated GIF (F9)", Overflow: true,
+		Run: func() {
+			a.SaveClip()
+		},
+	})
+}
+
+// recordClipFrame captures the window into the clip recorder every few frames. Reading back the framebuffer
+// waits on the draw thread, so it happens off the game loop; a capture still in flight skips the next one.
+func (a *App) recordClipFrame() {
+	if a.window == nil || !a.clipRecorder.Due() || !a.clipCapturing.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer a.clipCapturing.Store(false)
+		a.clipRecorder.Add(a.window.Canvas().Capture())
+	}()
+}
+
+// SaveClip asks where to save the last few seconds of the game and exports them there as an animated GIF
+func (a *App) SaveClip() {
+	if a.window == nil {
+		return
+	}
+
+	// Freeze the clip now; the game keeps recording while the user picks a file
+	frames := a.clipRecorder.Frames()
+	if len(frames) == 0 {
+		dialog.ShowError(clip.ErrEmpty, a.window)
+		return
+	}
+
+	saver := dialog.NewFileSave(func(writer fyne.URIWriteCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if writer == nil {
+			return // Cancelled
+		}
+
+		// Dithering every frame takes a moment; don't hold up the UI
+		go func() {
+			err := clip.WriteGIF(writer, frames, a.clipRecorder.FPS)
+			err = errors.Join(err, writer.Close())
+			if err != nil {
+				dialog.ShowError(err, a.window)
+				return
+			}
+			dialog.ShowInformation("🎬 Clip saved", writer.URI().Name(), a.window)
+		}()
+	}, a.window)
+	saver.SetFileName(fmt.Sprintf("bouncing-balls-%s.gif", time.Now().Format("20060102-150405")))
+	saver.SetFilter(storage.NewExtensionFileFilter([]string{".gif"}))
+	saver.Show()
+}
@@ -0,0 +1,39 @@
+package ui
+
+import "github.com/atyronesmith/bouncing-balls/pkg/effects"
+
+// confettiBursts is how many confetti bursts shoot up across the bottom of the game area per celebration
+const confettiBursts = 3
+
+// burstConfetti shoots confetti up from evenly spaced points along the bottom of the game area
+func (a *App) burstConfetti() {
+	for i := 1; i <= confettiBursts; i++ {
+		x := a.currentBounds.Width * float32(i) / float32(confettiBursts+1)
+		a.particles.Emit(&effects.Confetti, x, a.currentBounds.Height)
+	}
+}
+
+// checkHighScore celebrates the first time in a game that a player beats the best score of the games before it
+func (a *App) checkHighScore() {
+	if a.highScoreBeaten || a.bestScore == 0 {
+		return
+	}
+	for _, human := range a.humans {
+		if human.Score > a.bestScore {
+			a.highScoreBeaten = true
+			a.announceWave("🏆 NEW HIGH SCORE")
+			a.burstConfetti()
+			return
+		}
+	}
+}
+
+// recordHighScore keeps the best score of the game that is ending, ready for the next game to beat,
+// and asks for a name for every score that makes the leaderboard
+func (a *App) recordHighScore() {
+	a.submitHighScores(a.finishedScores(), nil)
+	for _, human := range a.humans {
+		a.bestScore = max(a.bestScore, human.Score)
+	}
+	a.highScoreBeaten = false
+}
@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"log"
+
+	"github.com/atyronesmith/bouncing-balls/pkg/input"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// applyPlayerInput copies the combined keyboard, mouse and gamepad state onto player 1 in manual control,
+// and player 2's arrow keys onto player 2 in co-op
+func (a *App) applyPlayerInput() {
+	if a.human != nil && a.human.Control == physics.ControlManual {
+		applyInputState(a.human, a.controls.Poll())
+	}
+	if a.isCoop() {
+		applyInputState(a.humans[1], a.keyboard2.Poll())
+	}
+}
+
+// applyInputState drives a human from a control state
+func applyInputState(human *physics.Human, state input.State) {
+	human.MoveX = state.MoveX
+	human.MoveY = state.MoveY
+	human.ManualAim = state.Aiming()
+	if human.ManualAim {
+		human.AimAngle = state.AimAngle(human.X, human.Y)
+	}
+	human.FireHeld = state.Fire
+	human.SprintHeld = state.Sprint
+	human.MeleeHeld = state.Melee
+}
+
+// watchGamepads tracks controllers plugged in and unplugged from the moment the driver is running, and stops
+// reading them before the app quits, because the driver shuts GLFW down with its main loop
+func (a *App) watchGamepads() {
+	a.fyneApp.Lifecycle().SetOnStarted(func() {
+		a.controls.WatchGamepads(func(pad input.Device) {
+			log.Printf("input: gamepad connected: %s", pad.Name())
+		}, func(pad input.Device) {
+			log.Printf("input: gamepad disconnected: %s", pad.Name())
+		})
+	})
+	a.window.SetCloseIntercept(func() {
+		a.controls.StopGamepads()
+		a.window.Close()
+	})
+}
+
+// quit stops reading the gamepads and quits the app
+func (a *App) quit() {
+	a.controls.StopGamepads()
+	a.fyneApp.Quit()
+}
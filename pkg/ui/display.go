@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"image/color"
+	"math"
+	"os"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+)
+
+// letterboxColor fills the bars around the game area when the window is bigger than it
+var letterboxColor = color.Black
+
+// scaleEnv names the environment variable Fyne reads the interface scale from whenever it rescales a window
+const scaleEnv = "FYNE_SCALE"
+
+// scaleStep is the precision Fyne rounds a window's scale to
+const scaleStep = 0.1
+
+// letterboxLayout keeps the game area at its logical size and centers it in whatever space the window
+// gives it, filling the bars around it. Every entity works in game area coordinates, so nothing else has
+// to know where on screen the game area ended up.
+type letterboxLayout struct {
+	area     fyne.Size       // logical game area size
+	onResize func(fyne.Size) // called with the space the window gives the game area (nil = ignored)
+}
+
+// Layout stretches the bars (the first object) over the whole space and centers the game area in it
+func (l *letterboxLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	if len(objects) == 0 {
+		return
+	}
+	objects[0].Move(fyne.NewPos(0, 0))
+	objects[0].Resize(size)
+
+	offset := l.Offset(size)
+	for _, object := range objects[1:] {
+		object.Move(offset)
+		object.Resize(l.area)
+	}
+
+	if l.onResize != nil {
+		l.onResize(size)
+	}
+}
+
+// MinSize is the logical game area size
+func (l *letterboxLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	return l.area
+}
+
+// Offset returns where the game area's top-left corner sits within space of the given size
+func (l *letterboxLayout) Offset(size fyne.Size) fyne.Position {
+	return fyne.NewPos(max((size.Width-l.area.Width)/2, 0), max((size.Height-l.area.Height)/2, 0))
+}
+
+// newLetterbox wraps the game area so it stays its logical size, centered with bars around it.
+// onResize is told the space it has whenever the window lays it out again.
+func newLetterbox(gameArea *fyne.Container, area fyne.Size, onResize func(fyne.Size)) *fyne.Container {
+	bars := canvas.NewRectangle(letterboxColor)
+	return container.New(&letterboxLayout{area: area, onResize: onResize}, bars, gameArea)
+}
+
+// startUIScale returns the interface scale the app was started with: FYNE_SCALE, else Fyne's settings, else 1
+func (a *App) startUIScale() float32 {
+	if scale, err := strconv.ParseFloat(os.Getenv(scaleEnv), 32); err == nil && scale > 0 {
+		return float32(scale)
+	}
+	if scale := a.fyneApp.Settings().Scale(); scale > 0 {
+		return scale
+	}
+	return 1
+}
+
+// fitGameArea scales the game area up to fill the screen in fullscreen, by the largest uniform factor that
+// keeps all of it (and the control bar) on screen; the rest is letterboxed. Fyne can only scale a whole
+// window, so the window is drawn at that scale: entities keep working in 800x600 game area coordinates and
+// Fyne maps pointer positions back through the same scale before the input layer sees them. Back in a
+// window, the scale the app started with returns.
+func (a *App) fitGameArea(space fyne.Size) {
+	if a.window == nil || a.baseScale == 0 {
+		return
+	}
+
+	userScale := a.baseScale
+	if a.window.FullScreen() {
+		canvas := a.window.Canvas()
+		current := canvas.Scale()
+		system := current / a.uiScale // the display's own scale, which Fyne multiplies the user's by
+		bar := canvas.Size().Height - space.Height
+		screenWidth := canvas.Size().Width * current
+		screenHeight := canvas.Size().Height * current
+		fit := min(screenWidth/a.gameArea.Width, screenHeight/(a.gameArea.Height+bar))
+		fit = float32(math.Floor(float64(fit)/scaleStep)) * scaleStep // Rounding up would push the edges off screen
+		if fit <= 0 {
+			return
+		}
+		userScale = fit / system
+	}
+
+	if math.Abs(float64(userScale-a.uiScale)) < 0.01 {
+		return
+	}
+	a.uiScale = userScale
+	os.Setenv(scaleEnv, strconv.FormatFloat(float64(userScale), 'f', 3, 32))
+	a.fyneApp.Settings().SetTheme(a.fyneApp.Settings().Theme()) // Fyne rescales its windows when the settings change
+}
+
+// ToggleFullScreen switches the window between windowed and fullscreen
+func (a *App) ToggleFullScreen() {
+	a.SetFullScreen(!a.window.FullScreen())
+}
+
+// SetFullScreen puts the window in or out of fullscreen. The game area keeps its logical size, scaled
+// up to fill the screen and letterboxed in the middle of it.
+func (a *App) SetFullScreen(fullScreen bool) {
+	if a.window == nil {
+		return
+	}
+	a.screenShake.Stop() // A shake would put the game area back where it was before the switch
+	a.window.SetFullScreen(fullScreen)
+}
@@ -0,0 +1,224 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// Dragon energy bar layout (floating above the dragon)
+const (
+	dragonEnergyBarWidth  = float32(50)
+	dragonEnergyBarHeight = float32(5)
+	dragonEnergyBarGap    = float32(0.9) // distance above the dragon's center, relative to the dragon size
+)
+
+// Dragon energy bar colors
+var (
+	dragonEnergyReadyColor     = color.RGBA{R: 255, G: 200, B: 60, A: 230} // Gold while the dragon can protect
+	dragonEnergyExhaustedColor = color.RGBA{R: 230, G: 90, B: 60, A: 230}  // Red while it retreats to recover
+)
+
+// dragonEnergyBar shows how much energy the dragon has left for intercepting balls
+type dragonEnergyBar struct {
+	background *canvas.Rectangle
+	fill       *canvas.Rectangle
+}
+
+// createDragonEnergyBar builds the dragon energy bar
+func (a *App) createDragonEnergyBar() *dragonEnergyBar {
+	bar := &dragonEnergyBar{
+		background: &canvas.Rectangle{
+			FillColor:    color.RGBA{R: 255, G: 255, B: 255, A: 40},
+			StrokeColor:  color.RGBA{R: 255, G: 255, B: 255, A: 120},
+			StrokeWidth:  1,
+			CornerRadius: 2,
+		},
+		fill: &canvas.Rectangle{
+			FillColor:    dragonEnergyReadyColor,
+			CornerRadius: 2,
+		},
+	}
+	bar.background.Resize(fyne.NewSize(dragonEnergyBarWidth, dragonEnergyBarHeight))
+	bar.fill.Resize(fyne.NewSize(dragonEnergyBarWidth, dragonEnergyBarHeight))
+	return bar
+}
+
+// GetVisualComponents returns the bar's canvas objects
+func (b *dragonEnergyBar) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{b.background, b.fill}
+}
+
+// updateDragonEnergyBar keeps the energy bar above the dragon, sized and colored to match its energy
+func (a *App) updateDragonEnergyBar() {
+	bar := a.dragonEnergyBar
+	if bar == nil || a.dragon == nil {
+		return
+	}
+
+	x := a.dragon.X - dragonEnergyBarWidth/2
+	y := a.dragon.Y - a.dragon.Size*dragonEnergyBarGap
+	bar.background.Move(fyne.NewPos(x, y))
+	bar.fill.Move(fyne.NewPos(x, y))
+
+	bar.fill.FillColor = dragonEnergyReadyColor
+	if a.dragon.IsExhausted() {
+		bar.fill.FillColor = dragonEnergyExhaustedColor
+	}
+	bar.fill.Resize(fyne.NewSize(dragonEnergyBarWidth*a.dragon.EnergyFraction(), dragonEnergyBarHeight))
+	bar.fill.Refresh()
+}
+
+// createDragonDebug builds the hidden debug overlay that names the dragon's current state
+func (a *App) createDragonDebug() {
+	a.dragonDebug = &canvas.Text{
+		Color:    color.RGBA{R: 255, G: 255, B: 120, A: 255},
+		TextSize: 11,
+		TextStyle: fyne.TextStyle{
+			Monospace: true,
+		},
+	}
+	a.dragonDebug.Hide()
+}
+
+// ToggleDragonDebug shows or hides the dragon state debug overlay
+func (a *App) ToggleDragonDebug() {
+	if a.dragonDebug == nil {
+		return
+	}
+	if a.dragonDebug.Visible() {
+		a.dragonDebug.Hide()
+		return
+	}
+	a.dragonDebug.Show()
+	a.updateDragonDebug()
+}
+
+// updateDragonDebug keeps the debug overlay under the dragon, showing its state and how long it has been in it
+func (a *App) updateDragonDebug() {
+	if a.dragonDebug == nil || !a.dragonDebug.Visible() || a.dragon == nil {
+		return
+	}
+
+	progression := a.dragon.Progression
+	text := fmt.Sprintf("%s %ds  %s (%d deflections)", a.dragon.State, a.dragon.StateFrames/60, progression.Tier, progression.Deflections)
+	if text != a.dragonDebug.Text {
+		a.dragonDebug.Text = text
+		a.dragonDebug.Resize(a.dragonDebug.MinSize())
+	}
+	size := a.dragonDebug.Size()
+	a.dragonDebug.Move(fyne.NewPos(a.dragon.X-size.Width/2, a.dragon.Y+a.dragon.Size*0.8))
+	a.dragonDebug.Refresh()
+}
+
+// announceDragonGrowth shows a banner (using the wave banner) whenever the dragon grows a tier
+func (a *App) announceDragonGrowth() {
+	a.events.Subscribe(physics.EventDragonGrew, func(event physics.Event) {
+		a.announceWave(fmt.Sprintf("🐉 DRAGON GREW: %s", strings.ToUpper(physics.DragonTier(event.Value).String())))
+	})
+}
+
+// Dragon settings slider ranges
+const (
+	minDragonFollowDistance = 40
+	maxDragonFollowDistance = 200
+	minDragonProtectRadius  = 60
+	maxDragonProtectRadius  = 300
+)
+
+// SetDragonEnabled brings the dragon into the game or takes it out (throwing off any rider)
+func (a *App) SetDragonEnabled(enabled bool) {
+	if a.dragon == nil {
+		return
+	}
+	a.dragonOff = !enabled
+
+	if !enabled {
+		if rider := a.dragon.Rider; rider != nil {
+			rider.Dismount()
+		}
+		a.dragon.DropBall()
+	}
+	a.dragon.IsActive = enabled
+	if enabled {
+		a.dragon.Show()
+		a.dragon.UpdatePosition()
+	} else {
+		a.dragon.Hide()
+	}
+
+	if bar := a.dragonEnergyBar; bar != nil {
+		for _, component := range bar.GetVisualComponents() {
+			setVisible(component, enabled)
+		}
+	}
+	if a.dragonDebug != nil && !enabled {
+		a.dragonDebug.Hide()
+	}
+}
+
+// showDragonSettings opens the dragon settings: on/off, follow distance, protect radius and stance
+func (a *App) showDragonSettings() {
+	if a.dragon == nil || a.window == nil {
+		return
+	}
+
+	enabledCheck := widget.NewCheck("Enabled", func(enabled bool) {
+		a.onGameLoop(func() {
+			a.SetDragonEnabled(enabled)
+			a.saveSettings()
+		})
+	})
+	enabledCheck.SetChecked(a.dragon.IsActive)
+
+	followLabel := widget.NewLabel("")
+	followSlider := widget.NewSlider(minDragonFollowDistance, maxDragonFollowDistance)
+	followSlider.OnChanged = func(value float64) {
+		followLabel.SetText(fmt.Sprintf("%.0f px", value))
+		a.onGameLoop(func() { a.dragon.FollowDistance = float32(value) })
+	}
+	followSlider.SetValue(float64(a.dragon.FollowDistance))
+
+	protectLabel := widget.NewLabel("")
+	protectSlider := widget.NewSlider(minDragonProtectRadius, maxDragonProtectRadius)
+	protectSlider.OnChanged = func(value float64) {
+		protectLabel.SetText(fmt.Sprintf("%.0f px", value))
+		a.onGameLoop(func() { a.dragon.SetProtectRadius(float32(value)) })
+	}
+	protectSlider.SetValue(float64(a.dragon.BaseProtectRadius()))
+
+	var stanceNames []string
+	for _, stance := range physics.DragonStances {
+		stanceNames = append(stanceNames, stance.String())
+	}
+	stanceSelect := widget.NewSelect(stanceNames, func(name string) {
+		a.onGameLoop(func() { a.dragon.Stance = physics.DragonStanceByName(name) })
+	})
+	stanceSelect.SetSelected(a.dragon.Stance.String())
+
+	form := widget.NewForm(
+		widget.NewFormItem("Dragon", enabledCheck),
+		widget.NewFormItem("Follow distance", container.NewBorder(nil, nil, nil, followLabel, followSlider)),
+		widget.NewFormItem("Protect radius", container.NewBorder(nil, nil, nil, protectLabel, protectSlider)),
+		widget.NewFormItem("Stance", stanceSelect),
+	)
+	settings := dialog.NewCustom("🐉 Dragon", "Close", form, a.window)
+	settings.Resize(fyne.NewSize(420, 0))
+	settings.Show()
+}
+
+// setVisible shows or hides a canvas object
+func setVisible(object fyne.CanvasObject, visible bool) {
+	if visible {
+		object.Show()
+	} else {
+		object.Hide()
+	}
+}
@@ -0,0 +1,154 @@
+package ui
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// Dominance meter layout (centered at the top of the game area)
+const (
+	dominanceMeterWidth  = float32(160)
+	dominanceMeterHeight = float32(8)
+	dominanceMeterTop    = float32(12)
+)
+
+// Dominance meter colors
+var (
+	dominanceFriendColor = color.RGBA{R: 150, G: 50, B: 200, A: 230} // Purple while the friendly dragon leads
+	dominanceRivalColor  = color.RGBA{R: 200, G: 30, B: 40, A: 230}  // Crimson while the rival leads
+)
+
+// dominanceMeter shows which dragon is winning the duel: the fill grows from the center
+// toward the friendly side (left) or the rival side (right)
+type dominanceMeter struct {
+	background *canvas.Rectangle
+	fill       *canvas.Rectangle
+	center     *canvas.Line
+	left       float32 // x of the meter's left edge
+}
+
+// createDominanceMeter builds the (hidden) dominance meter centered across the game area
+func (a *App) createDominanceMeter(gameArea fyne.Size) *dominanceMeter {
+	meter := &dominanceMeter{
+		background: &canvas.Rectangle{
+			FillColor:    color.RGBA{R: 255, G: 255, B: 255, A: 40},
+			StrokeColor:  color.RGBA{R: 255, G: 255, B: 255, A: 120},
+			StrokeWidth:  1,
+			CornerRadius: 3,
+		},
+		fill:   &canvas.Rectangle{CornerRadius: 3},
+		center: &canvas.Line{StrokeColor: color.RGBA{R: 255, G: 255, B: 255, A: 200}, StrokeWidth: 1},
+		left:   (gameArea.Width - dominanceMeterWidth) / 2,
+	}
+	meter.background.Resize(fyne.NewSize(dominanceMeterWidth, dominanceMeterHeight))
+	meter.background.Move(fyne.NewPos(meter.left, dominanceMeterTop))
+	meter.center.Position1 = fyne.NewPos(meter.left+dominanceMeterWidth/2, dominanceMeterTop-2)
+	meter.center.Position2 = fyne.NewPos(meter.left+dominanceMeterWidth/2, dominanceMeterTop+dominanceMeterHeight+2)
+	for _, component := range meter.GetVisualComponents() {
+		component.Hide()
+	}
+	return meter
+}
+
+// GetVisualComponents returns the meter's canvas objects
+func (m *dominanceMeter) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{m.background, m.fill, m.center}
+}
+
+// update sizes the fill for the dominance (-1 = rival wins, +1 = friend wins)
+func (m *dominanceMeter) update(dominance float32) {
+	half := dominanceMeterWidth / 2
+	width := half * dominance
+	x := m.left + half - width
+	m.fill.FillColor = dominanceFriendColor
+	if dominance < 0 {
+		width = -width
+		x = m.left + half
+		m.fill.FillColor = dominanceRivalColor
+	}
+	m.fill.Move(fyne.NewPos(x, dominanceMeterTop))
+	m.fill.Resize(fyne.NewSize(width, dominanceMeterHeight))
+	m.fill.Refresh()
+}
+
+// SetDuelMode starts or stops a duel with a rival dragon that herds balls toward the human
+func (a *App) SetDuelMode(enabled bool) {
+	if a.dragon == nil || enabled == (a.duel != nil) {
+		return
+	}
+
+	if !enabled {
+		a.duel.End()
+		a.duel = nil
+		a.rivalDragon.IsActive = false
+		a.rivalDragon.Hide()
+		for _, component := range a.dominanceMeter.GetVisualComponents() {
+			component.Hide()
+		}
+		return
+	}
+
+	// The rival keeps its figure between duels; it is only built the first time
+	x, y := a.randomSpawnPosition(40)
+	if a.rivalDragon == nil {
+		a.rivalDragon = physics.NewRivalDragon(x, y, 40)
+		a.rivalDragon.AI = a.aiScheduler
+		a.rivalDragon.Particles = a.particles
+		for _, component := range a.rivalDragon.Shadow.GetVisualComponents() {
+			a.shadowLayer.Add(component)
+		}
+		for _, component := range a.rivalDragon.GetVisualComponents() {
+			a.content.Add(component)
+		}
+	}
+	a.rivalDragon.Bounds = a.currentBounds
+	a.rivalDragon.Obstacles = a.obstacles
+	a.rivalDragon.X, a.rivalDragon.Y = x, y
+	a.rivalDragon.VX, a.rivalDragon.VY = 0, 0
+	a.rivalDragon.IsActive = true
+	a.rivalDragon.Show()
+	a.rivalDragon.UpdatePosition()
+
+	a.duel = physics.NewDragonDuel(a.dragon, a.rivalDragon, a.events)
+	for _, component := range a.dominanceMeter.GetVisualComponents() {
+		component.Show()
+	}
+	a.dominanceMeter.update(0)
+}
+
+// updateDuel moves the rival, resolves clashes and refreshes the dominance meter
+func (a *App) updateDuel() {
+	if a.duel == nil {
+		return
+	}
+	a.rivalDragon.Update(a.balls, a.dragonTarget())
+	a.rivalDragon.UpdatePosition()
+	a.duel.Update()
+	a.dominanceMeter.update(a.duel.Dominance)
+}
+
+// resetDuel puts the rival back at a safe spot and evens out the dominance meter
+func (a *App) resetDuel() {
+	if a.duel == nil {
+		return
+	}
+	a.duel.Reset()
+	a.rivalDragon.X, a.rivalDragon.Y = a.randomSpawnPosition(40)
+	a.rivalDragon.VX, a.rivalDragon.VY = 0, 0
+	a.rivalDragon.SetState(physics.DragonFollow)
+	a.rivalDragon.UpdatePosition()
+	a.dominanceMeter.update(0)
+}
+
+// announceRetreats shows a banner (using the wave banner) whenever a dragon loses the dominance meter
+func (a *App) announceRetreats() {
+	a.events.Subscribe(physics.EventRivalRetreated, func(physics.Event) {
+		a.announceWave("⚔️ THE RIVAL DRAGON RETREATS")
+	})
+	a.events.Subscribe(physics.EventDragonRetreated, func(physics.Event) {
+		a.announceWave("⚠️ YOUR DRAGON RETREATS")
+	})
+}
@@ -0,0 +1,31 @@
+package ui
+
+// Fyne calls button, menu, dialog, keyboard and pointer handlers on its own event goroutine, while the
+// animation goroutine reads and writes the game state every frame. Handlers hand their work to the game
+// loop instead of touching the balls, players or sandbox themselves; it runs at the start of the next frame.
+
+// onGameLoop queues work to run on the animation goroutine at the start of the next frame (even a paused one)
+func (a *App) onGameLoop(work func()) {
+	a.pendingMu.Lock()
+	a.pending = append(a.pending, work)
+	a.pendingMu.Unlock()
+}
+
+// gameLoopFunc wraps work as a Fyne callback that queues it for the game loop
+func (a *App) gameLoopFunc(work func()) func() {
+	return func() {
+		a.onGameLoop(work)
+	}
+}
+
+// runPending runs the work queued by the Fyne callbacks since the last frame, in the order it was queued
+func (a *App) runPending() {
+	a.pendingMu.Lock()
+	pending := a.pending
+	a.pending = nil
+	a.pendingMu.Unlock()
+
+	for _, work := range pending {
+		work()
+	}
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/input"
+)
+
+// inputLayer is a transparent widget covering the game area that turns mouse gestures into game actions.
+// Canvas shapes can't receive pointer events themselves, so this layer catches them for the whole arena.
+type inputLayer struct {
+	widget.BaseWidget
+	onDoubleTap func(pos fyne.Position) // called with the position of a double-click
+	onTap       func(pos fyne.Position) // called with the position of a click (nil = ignored)
+	onDrag      func(pos fyne.Position) // called with the pointer position while dragging (nil = ignored)
+	onDragEnd   func()                  // called when a drag is released (nil = ignored)
+	mouse       *input.Mouse            // receives pointer moves and button presses (nil = ignored)
+	run         func(func())            // runs the handlers and mouse updates on the game loop (nil = right away)
+
+	onSecondaryTap func(pos, absolute fyne.Position) // called with the position of a right-click, in the game area and in the window (nil = ignored)
+}
+
+// newInputLayer creates an input layer with no handlers
+func newInputLayer() *inputLayer {
+	layer := &inputLayer{}
+	layer.ExtendBaseWidget(layer)
+	return layer
+}
+
+// CreateRenderer draws nothing; the layer only exists to receive events
+func (l *inputLayer) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
+// DoubleTapped forwards double-clicks to the handler
+func (l *inputLayer) DoubleTapped(event *fyne.PointEvent) {
+	if l.onDoubleTap != nil {
+		l.dispatch(func() { l.onDoubleTap(event.Position) })
+	}
+}
+
+// Tapped forwards clicks to the handler
+func (l *inputLayer) Tapped(event *fyne.PointEvent) {
+	if l.onTap != nil {
+		l.dispatch(func() { l.onTap(event.Position) })
+	}
+}
+
+// TappedSecondary forwards right-clicks to the handler
+func (l *inputLayer) TappedSecondary(event *fyne.PointEvent) {
+	if l.onSecondaryTap != nil {
+		l.dispatch(func() { l.onSecondaryTap(event.Position, event.AbsolutePosition) })
+	}
+}
+
+// Dragged forwards the pointer position while dragging to the handler
+func (l *inputLayer) Dragged(event *fyne.DragEvent) {
+	if l.onDrag != nil {
+		l.dispatch(func() { l.onDrag(event.Position) })
+	}
+}
+
+// DragEnd forwards the end of a drag to the handler
+func (l *inputLayer) DragEnd() {
+	if l.onDragEnd != nil {
+		l.dispatch(l.onDragEnd)
+	}
+}
+
+// MouseIn tracks the pointer entering the game area
+func (l *inputLayer) MouseIn(event *desktop.MouseEvent) {
+	l.MouseMoved(event)
+}
+
+// MouseMoved tracks the pointer over the game area
+func (l *inputLayer) MouseMoved(event *desktop.MouseEvent) {
+	if l.mouse != nil {
+		l.dispatch(func() { l.mouse.Move(event.Position.X, event.Position.Y) })
+	}
+}
+
+// MouseOut tracks the pointer leaving the game area
+func (l *inputLayer) MouseOut() {
+	if l.mouse != nil {
+		l.dispatch(l.mouse.Leave)
+	}
+}
+
+// MouseDown tracks the primary (fire) and secondary (melee) buttons being pressed
+func (l *inputLayer) MouseDown(event *desktop.MouseEvent) {
+	l.setMouseButton(event.Button, true)
+}
+
+// MouseUp tracks the primary (fire) and secondary (melee) buttons being released
+func (l *inputLayer) MouseUp(event *desktop.MouseEvent) {
+	l.setMouseButton(event.Button, false)
+}
+
+// setMouseButton passes a button press or release on to the mouse device
+func (l *inputLayer) setMouseButton(button desktop.MouseButton, pressed bool) {
+	if l.mouse == nil {
+		return
+	}
+	switch button {
+	case desktop.MouseButtonPrimary:
+		l.dispatch(func() { l.mouse.SetButton(pressed) })
+	case desktop.MouseButtonSecondary:
+		l.dispatch(func() { l.mouse.SetMelee(pressed) })
+	}
+}
+
+// dispatch hands an event's work to the game loop, or runs it straight away without one
+func (l *inputLayer) dispatch(work func()) {
+	if l.run == nil {
+		work()
+		return
+	}
+	l.run(work)
+}
@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// weaponKeys maps the number keys to weapon slots
+var weaponKeys = map[fyne.KeyName]int{
+	fyne.Key1: 0,
+	fyne.Key2: 1,
+	fyne.Key3: 2,
+	fyne.Key4: 3,
+	fyne.Key5: 4,
+	fyne.Key6: 5,
+}
+
+// setupKeyboard registers the game's keyboard handlers on the window canvas:
+//   - Space plays the respawn minigame while the human is dead (Enter for player 2 in co-op)
+//   - M switches the human between AI and manual control
+//   - 1-6 select the human's weapon
+//   - P cycles the firing circle's pattern (at target, orbit, radial burst)
+//   - E mounts the dragon when player 1 is next to it, and dismounts again
+//   - F3 toggles the dragon state debug overlay
+//   - F9 saves the last 10 seconds as an animated GIF
+//   - F11 toggles fullscreen
+//   - Tab cycles the locked-on target from the closest ball outward, then back to auto-targeting
+//   - Arrow keys / WASD drive the human in manual control (WASD for player 1 and arrows for player 2 in co-op)
+func (a *App) setupKeyboard() {
+	canvas := a.window.Canvas()
+
+	canvas.SetOnTypedKey(func(event *fyne.KeyEvent) {
+		a.onGameLoop(func() { a.typedKey(event.Name) })
+	})
+
+	// Holding keys down needs the desktop driver's key down/up events
+	deskCanvas, ok := canvas.(desktop.Canvas)
+	if !ok {
+		return
+	}
+	deskCanvas.SetOnKeyDown(func(event *fyne.KeyEvent) {
+		a.onGameLoop(func() {
+			// Tab never reaches the typed key handler (Fyne uses it to move focus)
+			if event.Name == fyne.KeyTab {
+				a.CycleLockTarget()
+			}
+			a.keyboard.SetKey(event.Name, true)
+			a.keyboard2.SetKey(event.Name, true)
+		})
+	})
+	deskCanvas.SetOnKeyUp(func(event *fyne.KeyEvent) {
+		a.onGameLoop(func() {
+			a.keyboard.SetKey(event.Name, false)
+			a.keyboard2.SetKey(event.Name, false)
+		})
+	})
+}
+
+// typedKey runs the action bound to a typed key
+func (a *App) typedKey(name fyne.KeyName) {
+	switch name {
+	case fyne.KeySpace:
+		a.human.PressRespawn()
+	case fyne.KeyReturn, fyne.KeyEnter:
+		if a.isCoop() {
+			a.humans[1].PressRespawn()
+		}
+	case fyne.KeyM:
+		a.ToggleControlMode()
+	case fyne.KeyE:
+		a.ToggleMount()
+	case fyne.KeyF3:
+		a.ToggleDragonDebug()
+	case fyne.KeyF9:
+		a.SaveClip()
+	case fyne.KeyF11:
+		a.ToggleFullScreen()
+		a.saveSettings()
+	case fyne.KeyP:
+		a.SetFiringPattern(a.human.Firing.Pattern.Next())
+	default:
+		if slot, ok := weaponKeys[name]; ok {
+			a.human.SelectWeapon(slot)
+		}
+	}
+}
+
+// SetControlMode switches the human between AI and manual control
+func (a *App) SetControlMode(mode physics.ControlMode) {
+	a.human.Control = mode
+	if mode == physics.ControlAI {
+		a.human.ReleaseControls()
+	}
+}
+
+// ToggleMount puts player 1 on the dragon when next to it, or drops player 1 off at the dragon's position
+func (a *App) ToggleMount() {
+	if a.dragon != nil {
+		a.human.ToggleMount(a.dragon)
+	}
+}
+
+// CycleLockTarget locks player 1 onto the next ball outward, releasing the lock after the farthest one
+func (a *App) CycleLockTarget() {
+	a.human.CycleLockTarget(a.balls)
+	a.refocusGame = true
+}
+
+// ToggleControlMode flips the human between AI and manual control
+func (a *App) ToggleControlMode() {
+	if a.human.Control == physics.ControlManual {
+		a.SetControlMode(physics.ControlAI)
+	} else {
+		a.SetControlMode(physics.ControlManual)
+	}
+}
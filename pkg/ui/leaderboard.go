@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+	"github.com/atyronesmith/bouncing-balls/pkg/scores"
+)
+
+// leaderboardTimeFormat is how the leaderboard shows when each score was set
+const leaderboardTimeFormat = "2006-01-02 15:04"
+
+// finishedScore is a leaderboard-worthy score from a game that just ended, waiting for its player's name
+type finishedScore struct {
+	player int // 1 for player 1, 2 for player 2
+	entry  scores.Entry
+}
+
+// loadLeaderboard reads the saved leaderboard so this session's games compete with earlier ones
+func (a *App) loadLeaderboard() {
+	board, err := scores.Load(scores.DefaultPath())
+	if err != nil {
+		log.Printf("scores: %v", err)
+	}
+	a.leaderboard = board
+	a.bestScore = board.Best()
+}
+
+// submitHighScores asks each player with a leaderboard-worthy score for their name, one after another,
+// then saves the leaderboard and shows it with the new scores highlighted
+func (a *App) submitHighScores(finished []finishedScore, ranks []int) {
+	if len(finished) == 0 {
+		if len(ranks) == 0 {
+			return
+		}
+		if err := a.leaderboard.Save(); err != nil {
+			log.Printf("scores: %v", err)
+		}
+		a.showLeaderboard(ranks...)
+		return
+	}
+	if a.window == nil {
+		return
+	}
+
+	next := finished[0]
+	entry := widget.NewEntry()
+	entry.SetText(fmt.Sprintf("Player %d", next.player))
+	items := []*widget.FormItem{widget.NewFormItem("Name", entry)}
+
+	title := fmt.Sprintf("🏆 High Score: %d", next.entry.Score)
+	dialog.ShowForm(title, "Save", "Skip", items, func(confirmed bool) {
+		name := strings.TrimSpace(entry.Text)
+		a.onGameLoop(func() {
+			if confirmed && name != "" {
+				next.entry.Name = name
+				if rank := a.leaderboard.Add(next.entry); rank > 0 {
+					ranks = append(ranks, rank)
+				}
+			}
+			a.submitHighScores(finished[1:], ranks)
+		})
+	}, a.window)
+}
+
+// finishedScores returns the scores of the game that is ending that would make the leaderboard
+func (a *App) finishedScores() []finishedScore {
+	var finished []finishedScore
+	now := time.Now()
+	for i, human := range a.humans {
+		if !a.leaderboard.Qualifies(human.Score) {
+			continue
+		}
+		finished = append(finished, finishedScore{
+			player: i + 1,
+			entry: scores.Entry{
+				Score:      human.Score,
+				Time:       now,
+				Difficulty: physics.CurrentDifficulty.String(),
+			},
+		})
+	}
+	return finished
+}
+
+// registerLeaderboardActions adds the leaderboard to the control bar
+func (a *App) registerLeaderboardActions() {
+	a.RegisterAction(ToolbarAction{
+		ID: "scores", Label: "🏆 Scores", Group: "Share", Tooltip: "Show the leaderboard", Overflow: true,
+		Run: func() {
+			a.showLeaderboard()
+		},
+	})
+}
+
+// showLeaderboard opens the leaderboard, highlighting the given ranks (1 = best)
+func (a *App) showLeaderboard(highlight ...int) {
+	if a.window == nil {
+		return
+	}
+
+	grid := container.NewGridWithColumns(5,
+		leaderboardCell("#", true),
+		leaderboardCell("Name", true),
+		leaderboardCell("Score", true),
+		leaderboardCell("Difficulty", true),
+		leaderboardCell("Date", true),
+	)
+	for i, entry := range a.leaderboard.Entries {
+		rank := i + 1
+		bold := false
+		for _, highlighted := range highlight {
+			bold = bold || highlighted == rank
+		}
+		grid.Add(leaderboardCell(fmt.Sprintf("%d", rank), bold))
+		grid.Add(leaderboardCell(entry.Name, bold))
+		grid.Add(leaderboardCell(fmt.Sprintf("%d", entry.Score), bold))
+		grid.Add(leaderboardCell(entry.Difficulty, bold))
+		grid.Add(leaderboardCell(entry.Time.Local().Format(leaderboardTimeFormat), bold))
+	}
+
+	var content fyne.CanvasObject = grid
+	if len(a.leaderboard.Entries) == 0 {
+		content = widget.NewLabel("No high scores yet - reset a game with a score to get on the board")
+	}
+	board := dialog.NewCustom("🏆 High Scores", "Close", content, a.window)
+	board.Resize(fyne.NewSize(560, 0))
+	board.Show()
+}
+
+// leaderboardCell returns one cell of the leaderboard table
+func leaderboardCell(text string, bold bool) *widget.Label {
+	return widget.NewLabelWithStyle(text, fyne.TextAlignLeading, fyne.TextStyle{Bold: bold})
+}
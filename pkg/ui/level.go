@@ -0,0 +1,149 @@
+package ui
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// levelFileEnv names the environment variable pointing at a level file to play at launch
+const levelFileEnv = "BOUNCING_BALLS_LEVEL"
+
+// loadLevelFromEnv reads the level file named by the environment, if any, to be set up when the game starts
+func (a *App) loadLevelFromEnv() {
+	path := os.Getenv(levelFileEnv)
+	if path == "" {
+		return
+	}
+	level, err := physics.LoadLevel(path)
+	if err != nil {
+		log.Printf("level: %v", err)
+		return
+	}
+	a.level = level
+}
+
+// LoadLevel loads a level file and restarts the game on it
+func (a *App) LoadLevel(path string) error {
+	level, err := physics.LoadLevel(path)
+	if err != nil {
+		return err
+	}
+	a.level = level
+	a.applyLevel()
+	a.resetAll()
+	return nil
+}
+
+// applyLevel sets up the level's obstacles, wave schedule, walls and background. Its balls are spawned
+// with every round's starter balls (see spawnInitialBalls).
+func (a *App) applyLevel() {
+	a.removeObstacles()
+	if a.level == nil {
+		a.waves.Schedule = nil
+		return
+	}
+
+	a.obstacles = a.level.ArenaObstacles(a.currentBounds)
+	for _, obstacle := range a.obstacles {
+		for _, component := range obstacle.GetVisualComponents() {
+			a.content.Add(component)
+		}
+	}
+	a.shareObstacles()
+	a.waves.Schedule = a.level.Waves
+	if mode, ok := physics.WallModeByName(a.level.Arena.WallMode); ok {
+		a.SetWallMode(mode)
+	}
+	if a.starField != nil {
+		a.starField.ApplyBackground(a.level.Background)
+	}
+	if a.level.Name != "" {
+		a.announceWave(a.level.Name)
+	}
+}
+
+// removeObstacles takes every obstacle out of the game area
+func (a *App) removeObstacles() {
+	for _, obstacle := range a.obstacles {
+		for _, component := range obstacle.GetVisualComponents() {
+			a.content.Remove(component)
+		}
+	}
+	a.obstacles = nil
+	a.shareObstacles()
+}
+
+// shareObstacles hands the level's blocks to the players and dragons, which move around them
+func (a *App) shareObstacles() {
+	for _, human := range a.humans {
+		human.Obstacles = a.obstacles
+	}
+	if a.dragon != nil {
+		a.dragon.Obstacles = a.obstacles
+	}
+	if a.rivalDragon != nil {
+		a.rivalDragon.Obstacles = a.obstacles
+	}
+}
+
+// spawnLevelBalls spawns the level's starting balls, returning false if it has none
+func (a *App) spawnLevelBalls() bool {
+	if a.level == nil || len(a.level.Balls) == 0 {
+		return false
+	}
+	for _, ball := range a.level.Balls {
+		fill, _ := physics.ParseHexColor(ball.Color) // Checked when the level was loaded
+		kind, _ := physics.BallKindByName(ball.Kind)
+		a.SpawnBall(BallOptions{
+			X: ball.X, Y: ball.Y,
+			VX: ball.VX, VY: ball.VY,
+			Radius:    ball.Radius,
+			FillColor: fill,
+			Name:      ball.Name,
+			Kind:      kind,
+		})
+	}
+	return true
+}
+
+// showLevelPicker lets the user pick a level file to play
+func (a *App) showLevelPicker() {
+	if a.window == nil {
+		return
+	}
+	picker := dialog.NewFileOpen(func(reader fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if reader == nil {
+			return // Cancelled
+		}
+		path := reader.URI().Path()
+		reader.Close()
+		a.onGameLoop(func() {
+			if err := a.LoadLevel(path); err != nil {
+				dialog.ShowError(err, a.window)
+			}
+		})
+	}, a.window)
+	picker.SetFilter(storage.NewExtensionFileFilter([]string{".json"}))
+	picker.Show()
+}
+
+// levelLabel returns the settings label for the current level
+func (a *App) levelLabel() string {
+	if a.level == nil {
+		return "Standard"
+	}
+	if name := strings.TrimSpace(a.level.Name); name != "" {
+		return name
+	}
+	return "Custom"
+}
@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// levelUpPanel is the pause overlay offering a choice of upgrades after a level-up
+type levelUpPanel struct {
+	container *fyne.Container
+	title     *canvas.Text
+	subtitle  *canvas.Text
+}
+
+// Level-up panel layout
+const (
+	levelUpPanelWidth  = float32(380)
+	levelUpPanelHeight = float32(250)
+	levelUpButtonWidth = float32(320)
+)
+
+// createLevelUpPanel builds the hidden level-up overlay centered in the game area
+func (a *App) createLevelUpPanel(gameArea fyne.Size) *levelUpPanel {
+	left := (gameArea.Width - levelUpPanelWidth) / 2
+	top := (gameArea.Height - levelUpPanelHeight) / 2
+
+	background := &canvas.Rectangle{
+		FillColor:    color.RGBA{R: 10, G: 30, B: 20, A: 230},    // Dark green
+		StrokeColor:  color.RGBA{R: 120, G: 255, B: 150, A: 255}, // Bright green border
+		StrokeWidth:  3,
+		CornerRadius: 12,
+	}
+	background.Resize(fyne.NewSize(levelUpPanelWidth, levelUpPanelHeight))
+	background.Move(fyne.NewPos(left, top))
+
+	panel := &levelUpPanel{}
+	panel.title = &canvas.Text{
+		Color:     color.RGBA{R: 120, G: 255, B: 150, A: 255},
+		TextSize:  26,
+		TextStyle: fyne.TextStyle{Bold: true},
+		Alignment: fyne.TextAlignCenter,
+	}
+	panel.title.Resize(fyne.NewSize(levelUpPanelWidth, 36))
+	panel.title.Move(fyne.NewPos(left, top+12))
+
+	panel.subtitle = &canvas.Text{
+		Text:      "Choose an upgrade",
+		Color:     color.RGBA{R: 255, G: 255, B: 255, A: 220},
+		TextSize:  14,
+		Alignment: fyne.TextAlignCenter,
+	}
+	panel.subtitle.Resize(fyne.NewSize(levelUpPanelWidth, 20))
+	panel.subtitle.Move(fyne.NewPos(left, top+50))
+
+	objects := []fyne.CanvasObject{background, panel.title, panel.subtitle}
+	for i, upgrade := range physics.Upgrades {
+		upgrade := upgrade
+		button := widget.NewButton(fmt.Sprintf("%s - %s", upgrade, upgrade.Description()), a.gameLoopFunc(func() {
+			a.chooseUpgrade(upgrade)
+		}))
+		button.Resize(fyne.NewSize(levelUpButtonWidth, 40))
+		button.Move(fyne.NewPos(left+(levelUpPanelWidth-levelUpButtonWidth)/2, top+85+float32(i)*50))
+		objects = append(objects, button)
+	}
+
+	panel.container = container.NewWithoutLayout(objects...)
+	panel.container.Resize(gameArea)
+	panel.container.Hide()
+
+	return panel
+}
+
+// show updates the title for the level reached (naming the player in co-op) and shows the panel
+func (p *levelUpPanel) show(progress *physics.PlayerProgress, player string) {
+	level := progress.Level - progress.PendingLevels + 1
+	p.title.Text = fmt.Sprintf("⭐ %sLEVEL %d! ⭐", player, level)
+	p.title.Refresh()
+	p.container.Show()
+}
+
+// hide hides the panel
+func (p *levelUpPanel) hide() {
+	p.container.Hide()
+}
+
+// checkLevelUp pauses the game and offers upgrades once a player has a level-up to spend
+func (a *App) checkLevelUp() {
+	if a.paused || a.levelUpScreen == nil {
+		return
+	}
+	for _, human := range a.humans {
+		if human.Progress.PendingLevels > 0 {
+			a.paused = true
+			a.levelUpHuman = human
+			a.showLevelUp()
+			return
+		}
+	}
+}
+
+// showLevelUp shows the level-up screen for the player choosing an upgrade
+func (a *App) showLevelUp() {
+	player := ""
+	if a.isCoop() {
+		player = "P1 "
+		if a.levelUpHuman != a.human {
+			player = "P2 "
+		}
+	}
+	a.levelUpScreen.show(a.levelUpHuman.Progress, player)
+}
+
+// chooseUpgrade applies the picked upgrade and resumes the game, or offers the next one if
+// several levels were gained at once
+func (a *App) chooseUpgrade(upgrade physics.Upgrade) {
+	if a.levelUpHuman == nil {
+		return
+	}
+	a.levelUpHuman.ApplyUpgrade(upgrade)
+	if a.levelUpHuman.Progress.PendingLevels > 0 {
+		a.showLevelUp()
+		return
+	}
+
+	a.levelUpScreen.hide()
+	a.levelUpHuman = nil
+	a.paused = false
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// SetGameMode switches the game mode and starts a new game under its rules
+func (a *App) SetGameMode(mode physics.GameMode) {
+	a.gameMode = mode
+	a.applyGameMode()
+	a.resetAll()
+}
+
+// applyGameMode sets the world up the way the game mode plays: waves or rounds, and whether players can shoot
+func (a *App) applyGameMode() {
+	rules := a.gameMode.Rules()
+	a.SetWaveMode(rules.Waves)
+	for _, human := range a.humans {
+		human.HoldFire = !rules.Shooting
+	}
+}
+
+// resetGameMode restarts the game mode's clock, clears a finished game and brings on the boss in a boss fight
+func (a *App) resetGameMode() {
+	a.modeFrames = 0
+	a.gameOver = false
+	if a.gameMode.Rules().Boss {
+		a.startBossFight()
+	} else {
+		a.stopBossFight()
+	}
+}
+
+// updateGameMode runs the game mode's clock, scoring and win/lose conditions
+func (a *App) updateGameMode() {
+	if a.gameOver || a.human == nil {
+		return
+	}
+	rules := a.gameMode.Rules()
+	a.modeFrames++
+
+	alive := false
+	for _, human := range a.humans {
+		human.HoldFire = !rules.Shooting // Also covers a second player who joined mid-game
+		if !human.IsActive {
+			continue
+		}
+		alive = true
+		if rules.ScoreByTime {
+			human.Score = a.modeFrames / 60
+		}
+	}
+
+	switch {
+	case rules.EndOnDeath && !alive:
+		a.endGame(false)
+	case rules.TimeLimit > 0 && a.modeFrames >= rules.TimeLimit*60:
+		a.endGame(true)
+	case a.bossFight != nil && a.bossFight.Defeated():
+		a.endGame(true)
+	}
+}
+
+// endGame ends a game mode's game, freezing the world behind the results. Winning also sets off the confetti.
+func (a *App) endGame(won bool) {
+	a.gameOver = true
+	a.isVictory = true // Keeps the round's own victory check out of the way
+	a.paused = true
+
+	title := "💀 GAME OVER 💀"
+	if won {
+		title = fmt.Sprintf("🏆 %s COMPLETE! 🏆", a.gameMode)
+		if a.celebration != nil {
+			a.celebration.Start()
+		}
+	}
+
+	totals := a.teamTotals()
+	seconds := a.modeFrames / 60
+	lines := []string{
+		fmt.Sprintf("Mode:      %s", a.gameMode),
+		fmt.Sprintf("Time:      %3d:%02d.%d", seconds/60, seconds%60, a.modeFrames%60/6),
+		fmt.Sprintf("Score:     %6d", totals.score),
+		fmt.Sprintf("Deaths:    %6d", totals.deaths),
+	}
+	if a.gameMode.Rules().Shooting {
+		stats := VictoryStats{ShotsFired: totals.shotsFired, ShotsHit: totals.shotsHit}
+		lines = append(lines, fmt.Sprintf("Accuracy:  %5.1f%%", stats.Accuracy()*100))
+	}
+	if a.victoryScreen != nil {
+		a.victoryScreen.showResult(title, lines)
+	}
+}
+
+// continueFromVictory starts the next round, or a new game once the game mode has ended this one
+func (a *App) continueFromVictory() {
+	if a.gameOver {
+		a.resetAll()
+		return
+	}
+	a.nextRound()
+}
+
+// gameModeSummary returns the HUD's game mode and clock, or "" in classic mode
+func (a *App) gameModeSummary() string {
+	if a.gameMode == physics.GameClassic {
+		return ""
+	}
+	seconds := a.modeFrames / 60
+	if limit := a.gameMode.Rules().TimeLimit; limit > 0 {
+		seconds = max(limit-seconds, 0) // Count down to the win
+	}
+	return fmt.Sprintf("%s %d:%02d   ", a.gameMode, seconds/60, seconds%60)
+}
@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"github.com/atyronesmith/bouncing-balls/pkg/input"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// Player outline colors (a lone player keeps the theme's outline)
+var (
+	player1Outline = color.RGBA{R: 230, G: 60, B: 60, A: 255}  // Red
+	player2Outline = color.RGBA{R: 60, G: 140, B: 255, A: 255} // Blue
+)
+
+// Player start positions on reset
+const (
+	player1StartX, player1StartY = float32(400), float32(300)
+	player2StartX, player2StartY = float32(500), float32(300)
+)
+
+// SetCoop adds or removes a second player sharing the keyboard: player 1 moves with WASD
+// (plus the mouse and gamepads) and player 2 with the arrow keys
+func (a *App) SetCoop(enabled bool) {
+	if a.human == nil || enabled == a.isCoop() {
+		return
+	}
+	if enabled {
+		a.addPlayer2()
+	} else {
+		a.removePlayer2()
+	}
+}
+
+// isCoop reports whether a second player is in the game
+func (a *App) isCoop() bool {
+	return len(a.humans) > 1
+}
+
+// addPlayer2 creates player 2 at a safe spot and splits the keyboard between the players
+func (a *App) addPlayer2() {
+	x, y := a.randomSpawnPosition(35)
+	player2 := physics.NewHuman(x, y, 35)
+	player2.AI = a.aiScheduler
+	player2.Particles = a.particles
+	player2.Bounds = a.currentBounds
+	player2.Obstacles = a.obstacles
+	player2.Control = physics.ControlManual // Always keyboard driven (shots auto-target)
+	player2.SetPlayerColor(player2Outline)
+	player2.Firing = a.human.Firing
+	if physics.Tuning().MotionBlur {
+		player2.EnableMotionGhosts()
+	}
+	a.human.SetPlayerColor(player1Outline)
+
+	a.humans = append(a.humans, player2)
+	a.keyboard.SetKeys(input.WASDKeys)
+	a.keyboard2.SetKeys(input.ArrowKeys)
+	a.addHumanVisuals(player2)
+	if a.player2HUD != nil {
+		a.player2HUD.Show()
+	}
+}
+
+// removePlayer2 takes player 2 out of the game and gives player 1 the whole keyboard again
+func (a *App) removePlayer2() {
+	player2 := a.humans[1]
+	a.humans = a.humans[:1]
+
+	// Don't leave the game paused on player 2's upgrade choice
+	if a.levelUpHuman == player2 {
+		a.levelUpScreen.hide()
+		a.levelUpHuman = nil
+		a.paused = false
+	}
+
+	a.removeHumanVisuals(player2)
+	a.keyboard.SetKeys(input.AllKeys)
+	a.keyboard2.Release()
+	a.human.SetPlayerColor(physics.CurrentTheme.Style().HumanStroke)
+	if a.player2HUD != nil {
+		a.player2HUD.Hide()
+	}
+}
+
+// addHumanVisuals registers a human's figure with the game area and its shadow with the shadow layer
+func (a *App) addHumanVisuals(human *physics.Human) {
+	for _, component := range human.Shadow.GetVisualComponents() {
+		a.shadowLayer.Add(component)
+	}
+	for _, component := range human.GetVisualComponents() {
+		a.content.Add(component)
+	}
+}
+
+// removeHumanVisuals removes a human's figure, shadow, bullets and explosion from the game area
+func (a *App) removeHumanVisuals(human *physics.Human) {
+	for _, component := range human.Shadow.GetVisualComponents() {
+		a.shadowLayer.Remove(component)
+	}
+	for _, component := range human.GetVisualComponents() {
+		a.content.Remove(component)
+	}
+	for _, bullet := range human.GetBulletVisuals() {
+		a.content.Remove(bullet)
+	}
+}
+
+// dragonTarget returns the player the dragon protects: whoever is in the most danger
+func (a *App) dragonTarget() *physics.Human {
+	target := a.human
+	mostDanger := float32(-1)
+	for _, human := range a.humans {
+		if !human.IsActive {
+			continue
+		}
+		if danger := human.DangerLevel(a.balls); danger > mostDanger {
+			mostDanger = danger
+			target = human
+		}
+	}
+	return target
+}
+
+// maxDangerLevel returns the danger level of the most threatened player
+func (a *App) maxDangerLevel() float32 {
+	danger := float32(0)
+	for _, human := range a.humans {
+		if level := human.DangerLevel(a.balls); level > danger {
+			danger = level
+		}
+	}
+	return danger
+}
+
+// allBullets returns every player's bullets in flight
+func (a *App) allBullets() []*physics.Bullet {
+	if len(a.humans) == 1 {
+		return a.human.Bullets
+	}
+	var bullets []*physics.Bullet
+	for _, human := range a.humans {
+		bullets = append(bullets, human.Bullets...)
+	}
+	return bullets
+}
+
+// createPlayer2HUD builds player 2's (hidden) score display under player 1's
+func (a *App) createPlayer2HUD() {
+	a.player2HUD = &canvas.Text{
+		Color:     player2Outline,
+		TextStyle: fyne.TextStyle{Bold: true, Monospace: true},
+		TextSize:  14,
+	}
+	a.player2HUD.Move(fyne.NewPos(10, 40))
+	a.player2HUD.Hide()
+}
+
+// updatePlayer2HUD refreshes player 2's score display when the numbers change
+func (a *App) updatePlayer2HUD() {
+	if a.player2HUD == nil || !a.isCoop() {
+		return
+	}
+
+	text := "P2  " + playerSummary(a.humans[1])
+	if text != a.player2HUD.Text {
+		a.player2HUD.Text = text
+		a.player2HUD.Resize(a.player2HUD.MinSize())
+		a.player2HUD.Refresh()
+	}
+}
+
+// playerSummary describes a player's score, level, HP, weapon, firing pattern and mount for the HUD
+func playerSummary(human *physics.Human) string {
+	text := fmt.Sprintf("Score: %d   Deaths: %d", human.Score, human.Deaths)
+
+	progress := human.Progress
+	text += fmt.Sprintf("   Lv %d (%d/%d XP)", progress.Level, progress.XP, progress.XPForNextLevel())
+	if human.MaxHP > 1 {
+		text += fmt.Sprintf("   HP: %d/%d", human.HP, human.MaxHP)
+	}
+
+	weapon := human.CurrentWeapon()
+	if ammo := weapon.Ammo(); ammo >= 0 {
+		text += fmt.Sprintf("   %s: %d", weapon.Name(), ammo)
+	} else {
+		text += "   " + weapon.Name()
+	}
+	if pattern := human.Firing.Pattern; pattern != physics.FiringAtTarget {
+		text += " (" + pattern.String() + ")"
+	}
+	if dragon := human.Mount; dragon != nil {
+		text += fmt.Sprintf("   🐉 HP: %d/%d", dragon.HP, dragon.MaxHP)
+	}
+	return text
+}
@@ -0,0 +1,34 @@
+package ui
+
+import "github.com/atyronesmith/bouncing-balls/pkg/physics"
+
+// cloningPickupInterval is how many frames pass between cloning pickups (~15 seconds)
+const cloningPickupInterval = 900
+
+// updatePickups spawns cloning pickups now and then and clones any ball that touches one
+func (a *App) updatePickups() {
+	if a.cloner == nil {
+		return
+	}
+
+	if !a.cloner.IsActive {
+		a.pickupTimer--
+		if a.pickupTimer <= 0 {
+			a.pickupTimer = cloningPickupInterval
+			x, y := a.randomSpawnPosition(a.cloner.Radius)
+			a.cloner.Spawn(x, y)
+		}
+		return
+	}
+
+	if ball := a.cloner.Update(a.balls); ball != nil {
+		a.CloneBall(ball)
+	}
+}
+
+// CloneBall adds a copy of the ball flying off with mirrored velocity
+func (a *App) CloneBall(ball *physics.Ball) *physics.Ball {
+	clone := ball.Clone()
+	a.addBall(clone)
+	return clone
+}
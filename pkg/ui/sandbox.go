@@ -0,0 +1,208 @@
+package ui
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// Sandbox tuning
+const (
+	flingSmoothing = float32(0.5) // share of each frame's drag movement blended into the fling velocity
+	maxFlingSpeed  = float32(12)  // fastest a released ball can be flung, in pixels per frame
+	paletteMargin  = float32(8)   // gap between the palette and the game area's top-left corner
+)
+
+// sandbox is the sandbox mode's state: balls can be dragged and flung with the mouse, spawned and deleted
+// from a palette, and the simulation paused and stepped a frame at a time
+type sandbox struct {
+	enabled      bool          // whether sandbox mode is on
+	paused       bool          // whether the simulation is paused
+	steps        int           // frames still to run while paused
+	deleting     bool          // clicking a ball deletes it instead of doing nothing
+	held         *physics.Ball // ball being dragged (nil = none)
+	target       fyne.Position // where the pointer is dragging the held ball
+	flingVX      float32       // smoothed drag velocity, given to the ball when it's released
+	flingVY      float32
+	palette      *fyne.Container // spawn, delete, pause and step buttons
+	pauseButton  *widget.Button  // pause/resume button, relabeled as it toggles
+	deleteButton *widget.Button  // delete tool button, relabeled as it toggles
+}
+
+// registerSandboxActions adds the sandbox toggle to the control bar
+func (a *App) registerSandboxActions() {
+	a.RegisterAction(ToolbarAction{
+		ID: "sandbox", Label: "🧪 Sandbox", Group: "Game", Tooltip: "Toggle sandbox mode: drag, fling, spawn and delete balls", Shortcut: fyne.KeyX, Overflow: true,
+		Run: func() {
+			a.SetSandbox(!a.sandbox.enabled)
+		},
+	})
+}
+
+// SetSandbox turns sandbox mode on or off. Turning it off drops any held ball and resumes the simulation.
+func (a *App) SetSandbox(enabled bool) {
+	a.sandbox.enabled = enabled
+	if !enabled {
+		a.releaseHeldBall()
+		a.setSandboxPaused(false)
+		a.setSandboxDeleting(false)
+	}
+	if a.sandbox.palette == nil && enabled {
+		a.createSandboxPalette()
+	}
+	if a.sandbox.palette != nil {
+		setVisible(a.sandbox.palette, enabled)
+	}
+}
+
+// createSandboxPalette builds the sandbox's button palette in the game area's top-left corner
+func (a *App) createSandboxPalette() {
+	spawn := func(kind physics.BallKind) func() {
+		return a.gameLoopFunc(func() {
+			a.SpawnBall(BallOptions{Kind: kind})
+		})
+	}
+
+	a.sandbox.pauseButton = widget.NewButton("⏸️ Pause", a.gameLoopFunc(func() {
+		a.setSandboxPaused(!a.sandbox.paused)
+	}))
+	stepButton := widget.NewButton("⏭️ Step", a.gameLoopFunc(a.StepSandbox))
+	a.sandbox.deleteButton = widget.NewButton("🗑️ Delete", a.gameLoopFunc(func() {
+		a.setSandboxDeleting(!a.sandbox.deleting)
+	}))
+
+	a.sandbox.palette = container.NewVBox(
+		widget.NewButton("➕ Standard", spawn(physics.KindStandard)),
+		widget.NewButton("👻 Ghost", spawn(physics.KindGhost)),
+		widget.NewButton("💥 Explosive", spawn(physics.KindExplosive)),
+		widget.NewButton("🪨 Heavy", spawn(physics.KindHeavy)),
+		widget.NewButton("👑 Boss", spawn(physics.KindBoss)),
+		a.sandbox.deleteButton,
+		a.sandbox.pauseButton,
+		stepButton,
+	)
+	a.sandbox.palette.Resize(a.sandbox.palette.MinSize())
+	a.sandbox.palette.Move(fyne.NewPos(paletteMargin, paletteMargin))
+	a.content.Add(a.sandbox.palette)
+}
+
+// setSandboxPaused pauses or resumes the simulation
+func (a *App) setSandboxPaused(paused bool) {
+	a.sandbox.paused = paused
+	a.sandbox.steps = 0
+	if a.sandbox.pauseButton == nil {
+		return
+	}
+	if paused {
+		a.sandbox.pauseButton.SetText("▶️ Resume")
+	} else {
+		a.sandbox.pauseButton.SetText("⏸️ Pause")
+	}
+}
+
+// setSandboxDeleting turns the delete tool on or off
+func (a *App) setSandboxDeleting(deleting bool) {
+	a.sandbox.deleting = deleting
+	if a.sandbox.deleteButton == nil {
+		return
+	}
+	if deleting {
+		a.sandbox.deleteButton.SetText("🗑️ Deleting…")
+	} else {
+		a.sandbox.deleteButton.SetText("🗑️ Delete")
+	}
+}
+
+// StepSandbox pauses the simulation if it is running and advances it by one frame
+func (a *App) StepSandbox() {
+	if !a.sandbox.paused {
+		a.setSandboxPaused(true)
+	}
+	a.sandbox.steps++
+}
+
+// sandboxAdvances reports whether the simulation runs this frame: always outside sandbox mode, and while
+// paused only for the frames asked for with StepSandbox
+func (a *App) sandboxAdvances() bool {
+	if !a.sandbox.enabled || !a.sandbox.paused {
+		return true
+	}
+	if a.sandbox.steps > 0 {
+		a.sandbox.steps--
+		return true
+	}
+	return false
+}
+
+// sandboxTap deletes the clicked ball while the delete tool is on
+func (a *App) sandboxTap(pos fyne.Position) {
+	if !a.sandbox.enabled || !a.sandbox.deleting {
+		return
+	}
+	if ball := a.ballAt(pos); ball != nil {
+		a.RemoveBall(ball.ID) // Also lets go of it if it is being dragged
+	}
+}
+
+// sandboxDrag grabs the ball under the pointer at the start of a drag and pulls it along after that
+func (a *App) sandboxDrag(pos fyne.Position) {
+	if !a.sandbox.enabled {
+		return
+	}
+	if a.sandbox.held == nil {
+		ball := a.ballAt(pos)
+		if ball == nil || ball.CarriedBy != nil {
+			return
+		}
+		a.sandbox.held = ball
+		a.sandbox.flingVX, a.sandbox.flingVY = 0, 0
+		ball.IsAnimated = false // Held still by the pointer, not its velocity
+	}
+	a.sandbox.target = pos
+}
+
+// sandboxDragEnd releases the held ball, flinging it with the speed it was being dragged at
+func (a *App) sandboxDragEnd() {
+	a.releaseHeldBall()
+}
+
+// releaseHeldBall lets go of the held ball with its fling velocity
+func (a *App) releaseHeldBall() {
+	ball := a.sandbox.held
+	if ball == nil {
+		return
+	}
+	a.sandbox.held = nil
+
+	vx, vy := a.sandbox.flingVX, a.sandbox.flingVY
+	if speed := float32(math.Sqrt(float64(vx*vx + vy*vy))); speed > maxFlingSpeed {
+		vx, vy = vx/speed*maxFlingSpeed, vy/speed*maxFlingSpeed
+	}
+	ball.VX, ball.VY = vx, vy
+	ball.IsAnimated = !ball.IsDestroyed && !ball.Frozen
+}
+
+// updateSandboxDrag moves the held ball to the pointer, measuring how fast it's being dragged.
+// It runs even while the simulation is paused so balls can be placed between steps.
+func (a *App) updateSandboxDrag() {
+	ball := a.sandbox.held
+	if ball == nil {
+		return
+	}
+	if ball.IsDestroyed {
+		a.sandbox.held = nil
+		return
+	}
+
+	dx := a.sandbox.target.X - ball.X
+	dy := a.sandbox.target.Y - ball.Y
+	a.sandbox.flingVX += (dx - a.sandbox.flingVX) * flingSmoothing
+	a.sandbox.flingVY += (dy - a.sandbox.flingVY) * flingSmoothing
+	ball.X = a.sandbox.target.X
+	ball.Y = a.sandbox.target.Y
+	ball.Trail.Clear() // The ribbon would streak across the jump
+	ball.UpdatePosition()
+}
@@ -0,0 +1,440 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/effects"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// appID identifies the app to Fyne, which keys the saved preferences by it
+const appID = "io.github.atyronesmith.bouncingballs"
+
+// Setting defaults and slider ranges
+const (
+	defaultStarCount = 400 // stars in an 800x600 field at full effects quality
+	defaultFPSCap    = 60
+	simulationRate   = 60   // fixed simulation steps per second, whatever the FPS cap
+	minBallSpeed     = 0.25 // ball speed multiplier
+	maxBallSpeed     = 2.0
+	minBallCount     = 1 // at least one ball, so a round never starts already won
+	maxBallCount     = 20
+	minHumanSpeed    = 1.0
+	maxHumanSpeed    = 10.0
+	maxStarCount     = 1000
+)
+
+// fpsCaps are the frame rates offered in the settings. The simulation keeps its own fixed rate,
+// so lower caps draw fewer frames without slowing the game down.
+var fpsCaps = []int{30, 45, 60}
+
+// Preference keys the settings are saved under
+const (
+	prefBallSpeed      = "ball_speed"
+	prefBallCount      = "ball_count"
+	prefHumanSpeed     = "human_speed"
+	prefDragon         = "dragon"
+	prefStarCount      = "star_count"
+	prefEffectsQuality = "effects_quality"
+	prefFPSCap         = "fps_cap"
+	prefFullScreen     = "full_screen"
+	prefDifficulty     = "difficulty"
+	prefGameMode       = "game_mode"
+	prefTheme          = "theme"
+	prefUIMode         = "ui_mode"
+	prefBackdropColor  = "backdrop_color"
+	prefPlainDemo      = "plain_demo"
+	prefToolbar        = "toolbar"
+)
+
+// SetEffectsQuality scales the particle counts, trail lengths, star count and twinkle rate to the quality preset
+func (a *App) SetEffectsQuality(quality effects.Quality) {
+	a.effectsQuality = quality
+	a.applyEffectsQuality()
+}
+
+// applyEffectsQuality applies the current effects quality on top of the tuning values it scales
+func (a *App) applyEffectsQuality() {
+	scales := a.effectsQuality.Scales()
+	a.particles.Quality = scales.Particles
+	physics.TrailLength = scales.Trails
+	if a.starField != nil {
+		stars := float32(a.starCount) / defaultStarCount
+		a.starField.SetTargetDensity(a.baseStarDensity * stars * scales.Stars)
+		a.starField.SetTwinkleStride(physics.Tuning().TwinkleStride * scales.TwinkleStride)
+	}
+}
+
+// SetDifficulty switches the difficulty, mid-game included: balls, wave spawns and shots follow it from the
+// next frame, and the players' health and the dragon's reach are rescaled straight away
+func (a *App) SetDifficulty(difficulty physics.Difficulty) {
+	physics.CurrentDifficulty = difficulty
+	for _, human := range a.humans {
+		human.ApplyDifficulty()
+	}
+	if a.dragon != nil {
+		a.dragon.ApplyDifficulty()
+	}
+	a.toolbar.SetLabel(actionDifficulty, difficultyLabel(difficulty))
+}
+
+// SetTheme redraws every ball, human and dragon in the theme; entities created later pick it up too
+func (a *App) SetTheme(theme physics.Theme) {
+	physics.CurrentTheme = theme
+	for _, ball := range a.balls {
+		ball.ApplyTheme()
+	}
+	for _, human := range a.humans {
+		human.ApplyTheme()
+	}
+	if a.isCoop() {
+		a.human.SetPlayerColor(player1Outline)
+		a.humans[1].SetPlayerColor(player2Outline)
+	}
+	if a.dragon != nil {
+		a.dragon.ApplyTheme()
+	}
+	if a.rivalDragon != nil {
+		a.rivalDragon.ApplyTheme()
+	}
+}
+
+// SetBallSpeed scales how fast every ball moves (1 = normal speed)
+func (a *App) SetBallSpeed(multiplier float32) {
+	physics.BallSpeedScale = min(max(multiplier, minBallSpeed), maxBallSpeed)
+}
+
+// SetBallCount sets how many balls start each game and spawns or removes balls so that many are in play now
+func (a *App) SetBallCount(count int) {
+	a.ballCount = min(max(count, minBallCount), maxBallCount)
+	if a.content == nil {
+		return // Not running yet; the starter balls are spawned to the count
+	}
+	for len(a.balls) < a.ballCount {
+		a.SpawnBall(BallOptions{})
+	}
+	for len(a.balls) > a.ballCount {
+		a.RemoveBall(a.balls[len(a.balls)-1].ID)
+	}
+}
+
+// SetHumanSpeed sets every player's movement speed, now and for players created later
+func (a *App) SetHumanSpeed(speed float32) {
+	speed = min(max(speed, minHumanSpeed), maxHumanSpeed)
+	physics.UpdateTuning(func(t *physics.TuningValues) { t.HumanSpeed = speed })
+	for _, human := range a.humans {
+		human.Speed = speed
+	}
+}
+
+// SetStarCount sets how many stars the field holds at full effects quality (lower qualities draw fewer)
+func (a *App) SetStarCount(count int) {
+	a.starCount = min(max(count, 0), maxStarCount)
+	a.applyEffectsQuality()
+}
+
+// SetFPSCap sets how many animation frames run per second. The game runs at the same speed at every cap.
+func (a *App) SetFPSCap(fps int) {
+	if fps <= 0 {
+		fps = defaultFPSCap
+	}
+	a.fpsCap = fps
+	if a.animationTicker != nil {
+		a.animationTicker.Reset(frameInterval(fps))
+	}
+}
+
+// frameInterval returns the time between frames at the given frame rate
+func frameInterval(fps int) time.Duration {
+	return time.Second / time.Duration(fps)
+}
+
+// simulationSteps returns how many fixed simulation steps are due this frame, carrying any fraction of a
+// step over to the next one (at 45 FPS frames run 1, 1 and then 2 steps)
+func (a *App) simulationSteps() int {
+	a.stepCarry += float32(simulationRate) / float32(a.fpsCap)
+	steps := int(a.stepCarry)
+	a.stepCarry -= float32(steps)
+	return steps
+}
+
+// loadSettings restores the settings saved by the settings dialog, falling back to the current values
+func (a *App) loadSettings() {
+	prefs := a.fyneApp.Preferences()
+	a.SetDifficulty(physics.DifficultyByName(prefs.StringWithFallback(prefDifficulty, physics.CurrentDifficulty.String())))
+	a.SetTheme(physics.ThemeByName(prefs.StringWithFallback(prefTheme, physics.CurrentTheme.String())))
+	a.gameMode = physics.GameModeByName(prefs.StringWithFallback(prefGameMode, a.gameMode.String())) // Applied once the game is built
+	a.SetUIMode(UIModeByName(prefs.StringWithFallback(prefUIMode, a.uiMode.String())))
+	if backdrop, err := physics.ParseHexColor(prefs.String(prefBackdropColor)); err == nil && backdrop.A > 0 {
+		a.SetBackdropColor(backdrop)
+	}
+	a.plainDemo = prefs.BoolWithFallback(prefPlainDemo, a.plainDemo) // Applied once the space layer is built
+	a.toolbar.SetPlacement(prefs.String(prefToolbar))
+	a.SetBallSpeed(float32(prefs.FloatWithFallback(prefBallSpeed, float64(physics.BallSpeedScale))))
+	a.SetBallCount(prefs.IntWithFallback(prefBallCount, a.ballCount))
+	a.SetHumanSpeed(float32(prefs.FloatWithFallback(prefHumanSpeed, float64(physics.Tuning().HumanSpeed))))
+	a.dragonOff = !prefs.BoolWithFallback(prefDragon, !a.dragonOff)
+	a.starCount = min(max(prefs.IntWithFallback(prefStarCount, a.starCount), 0), maxStarCount)
+	quality := prefs.StringWithFallback(prefEffectsQuality, physics.Tuning().EffectsQuality)
+	physics.UpdateTuning(func(t *physics.TuningValues) { t.EffectsQuality = quality })
+	a.SetFPSCap(prefs.IntWithFallback(prefFPSCap, a.fpsCap))
+	a.SetFullScreen(prefs.BoolWithFallback(prefFullScreen, false))
+}
+
+// saveSettings saves the current settings so the next launch starts with them
+func (a *App) saveSettings() {
+	prefs := a.fyneApp.Preferences()
+	prefs.SetString(prefDifficulty, physics.CurrentDifficulty.String())
+	prefs.SetString(prefGameMode, a.gameMode.String())
+	prefs.SetString(prefTheme, physics.CurrentTheme.String())
+	prefs.SetString(prefUIMode, a.uiMode.String())
+	prefs.SetString(prefBackdropColor, physics.FormatHexColor(a.backdropColor))
+	prefs.SetBool(prefPlainDemo, a.plainDemo)
+	prefs.SetString(prefToolbar, a.toolbar.Placement())
+	prefs.SetFloat(prefBallSpeed, float64(physics.BallSpeedScale))
+	prefs.SetInt(prefBallCount, a.ballCount)
+	prefs.SetFloat(prefHumanSpeed, float64(physics.Tuning().HumanSpeed))
+	prefs.SetBool(prefDragon, !a.dragonOff)
+	prefs.SetInt(prefStarCount, a.starCount)
+	prefs.SetString(prefEffectsQuality, a.effectsQuality.String())
+	prefs.SetInt(prefFPSCap, a.fpsCap)
+	if a.window != nil {
+		prefs.SetBool(prefFullScreen, a.window.FullScreen())
+	}
+}
+
+// showSettings opens the settings dialog. Every change applies to the running game straight away
+// and is saved for the next launch.
+func (a *App) showSettings() {
+	if a.window == nil {
+		return
+	}
+
+	var difficultyNames []string
+	for _, difficulty := range physics.Difficulties {
+		difficultyNames = append(difficultyNames, difficulty.String())
+	}
+	difficultySelect := widget.NewSelect(difficultyNames, func(name string) {
+		a.onGameLoop(func() {
+			a.SetDifficulty(physics.DifficultyByName(name))
+			a.saveSettings()
+		})
+	})
+	difficultySelect.SetSelected(physics.CurrentDifficulty.String())
+
+	var modeNames []string
+	for _, mode := range physics.GameModes {
+		modeNames = append(modeNames, mode.String())
+	}
+	modeSelect := widget.NewSelect(modeNames, nil)
+	modeSelect.SetSelected(a.gameMode.String())
+	modeSelect.OnChanged = func(name string) { // Set after the initial selection, which would restart the game
+		a.onGameLoop(func() {
+			a.SetGameMode(physics.GameModeByName(name))
+			a.saveSettings()
+		})
+	}
+
+	// Sliders apply every step of a drag but save once they are let go
+	saveOnRelease := func(float64) {
+		a.onGameLoop(a.saveSettings)
+	}
+
+	ballSpeedLabel := widget.NewLabel("")
+	ballSpeedSlider := widget.NewSlider(minBallSpeed, maxBallSpeed)
+	ballSpeedSlider.Step = 0.05
+	ballSpeedSlider.OnChanged = func(value float64) {
+		ballSpeedLabel.SetText(fmt.Sprintf("%.2f×", value))
+		a.onGameLoop(func() {
+			a.SetBallSpeed(float32(value))
+		})
+	}
+	ballSpeedSlider.OnChangeEnded = saveOnRelease
+	ballSpeedSlider.SetValue(float64(physics.BallSpeedScale))
+
+	ballCountLabel := widget.NewLabel("")
+	ballCountSlider := widget.NewSlider(minBallCount, maxBallCount)
+	ballCountSlider.OnChanged = func(value float64) {
+		ballCountLabel.SetText(fmt.Sprintf("%d", int(value)))
+		a.onGameLoop(func() {
+			a.SetBallCount(int(value))
+		})
+	}
+	ballCountSlider.OnChangeEnded = saveOnRelease
+	ballCountSlider.SetValue(float64(a.ballCount))
+
+	humanSpeedLabel := widget.NewLabel("")
+	humanSpeedSlider := widget.NewSlider(minHumanSpeed, maxHumanSpeed)
+	humanSpeedSlider.Step = 0.5
+	humanSpeedSlider.OnChanged = func(value float64) {
+		humanSpeedLabel.SetText(fmt.Sprintf("%.1f px", value))
+		a.onGameLoop(func() {
+			a.SetHumanSpeed(float32(value))
+		})
+	}
+	humanSpeedSlider.OnChangeEnded = saveOnRelease
+	humanSpeedSlider.SetValue(float64(physics.Tuning().HumanSpeed))
+
+	dragonCheck := widget.NewCheck("Enabled", func(enabled bool) {
+		a.onGameLoop(func() {
+			a.SetDragonEnabled(enabled)
+			a.saveSettings()
+		})
+	})
+	dragonCheck.SetChecked(!a.dragonOff)
+
+	starCountLabel := widget.NewLabel("")
+	starCountSlider := widget.NewSlider(0, maxStarCount)
+	starCountSlider.Step = 50
+	starCountSlider.OnChanged = func(value float64) {
+		starCountLabel.SetText(fmt.Sprintf("%d", int(value)))
+		a.onGameLoop(func() {
+			a.SetStarCount(int(value))
+		})
+	}
+	starCountSlider.OnChangeEnded = saveOnRelease
+	starCountSlider.SetValue(float64(a.starCount))
+
+	var qualityNames []string
+	for _, quality := range effects.Qualities {
+		qualityNames = append(qualityNames, quality.String())
+	}
+	qualitySelect := widget.NewSelect(qualityNames, func(name string) {
+		a.onGameLoop(func() {
+			a.SetEffectsQuality(effects.QualityByName(name))
+			a.saveSettings()
+		})
+	})
+	qualitySelect.SetSelected(a.effectsQuality.String())
+
+	var themeNames []string
+	for _, theme := range physics.Themes {
+		themeNames = append(themeNames, theme.String())
+	}
+	themeSelect := widget.NewSelect(themeNames, func(name string) {
+		a.onGameLoop(func() {
+			a.SetTheme(physics.ThemeByName(name))
+			a.saveSettings()
+		})
+	})
+	themeSelect.SetSelected(physics.CurrentTheme.String())
+
+	var uiModeNames []string
+	for _, mode := range UIModes {
+		uiModeNames = append(uiModeNames, mode.String())
+	}
+	uiModeSelect := widget.NewSelect(uiModeNames, func(name string) {
+		a.onGameLoop(func() {
+			a.SetUIMode(UIModeByName(name))
+			a.saveSettings()
+		})
+	})
+	uiModeSelect.SetSelected(a.uiMode.String())
+
+	// The backdrop is picked from the presets or typed in as "#rrggbb"
+	backdropEntry := widget.NewEntry()
+	backdropEntry.SetText(physics.FormatHexColor(a.backdropColor))
+	var backdropNames []string
+	for _, preset := range backdropPresets {
+		backdropNames = append(backdropNames, preset.name)
+	}
+	backdropSelect := widget.NewSelect(backdropNames, func(name string) {
+		for _, preset := range backdropPresets {
+			if preset.name == name {
+				backdropEntry.SetText(physics.FormatHexColor(preset.color))
+				a.onGameLoop(func() {
+					a.SetBackdropColor(preset.color)
+					a.saveSettings()
+				})
+			}
+		}
+	})
+	backdropSelect.PlaceHolder = "Custom"
+	backdropSelect.SetSelected(backdropPresetName(a.backdropColor)) // Stays on the placeholder for a custom color
+	backdropEntry.OnSubmitted = func(hex string) {
+		backdrop, err := physics.ParseHexColor(strings.TrimSpace(hex))
+		if err != nil || backdrop.A == 0 {
+			dialog.ShowError(fmt.Errorf("backdrop color %q is not #rrggbb", hex), a.window)
+			return
+		}
+		if name := backdropPresetName(backdrop); name == "Custom" {
+			backdropSelect.ClearSelected() // Back to the "Custom" placeholder
+		} else {
+			backdropSelect.SetSelected(name)
+		}
+		a.onGameLoop(func() {
+			a.SetBackdropColor(backdrop)
+			a.saveSettings()
+		})
+	}
+
+	plainDemoCheck := widget.NewCheck("Plain physics demo (no space)", func(plain bool) {
+		a.onGameLoop(func() {
+			a.SetPlainDemo(plain)
+			a.saveSettings()
+		})
+	})
+	plainDemoCheck.SetChecked(a.plainDemo)
+
+	var fpsNames []string
+	for _, fps := range fpsCaps {
+		fpsNames = append(fpsNames, strconv.Itoa(fps))
+	}
+	fpsSelect := widget.NewSelect(fpsNames, func(name string) {
+		fps, err := strconv.Atoi(name)
+		if err != nil {
+			return
+		}
+		a.onGameLoop(func() {
+			a.SetFPSCap(fps)
+			a.saveSettings()
+		})
+	})
+	fpsSelect.SetSelected(strconv.Itoa(a.fpsCap))
+
+	levelLabel := widget.NewLabel(a.levelLabel())
+	levelButton := widget.NewButton("📂 Load…", a.gameLoopFunc(a.showLevelPicker))
+
+	fullScreenCheck := widget.NewCheck("Fullscreen (F11)", func(fullScreen bool) {
+		a.onGameLoop(func() {
+			a.SetFullScreen(fullScreen)
+			a.saveSettings()
+		})
+	})
+	fullScreenCheck.SetChecked(a.window.FullScreen())
+
+	var settings dialog.Dialog
+	tutorialButton := widget.NewButton("🎓 Start", func() {
+		settings.Hide()
+		a.onGameLoop(a.StartTutorial)
+	})
+
+	form := widget.NewForm(
+		widget.NewFormItem("Game mode", modeSelect),
+		widget.NewFormItem("Difficulty", difficultySelect),
+		widget.NewFormItem("Ball speed", container.NewBorder(nil, nil, nil, ballSpeedLabel, ballSpeedSlider)),
+		widget.NewFormItem("Ball count", container.NewBorder(nil, nil, nil, ballCountLabel, ballCountSlider)),
+		widget.NewFormItem("Human speed", container.NewBorder(nil, nil, nil, humanSpeedLabel, humanSpeedSlider)),
+		widget.NewFormItem("Dragon", dragonCheck),
+		widget.NewFormItem("Stars", container.NewBorder(nil, nil, nil, starCountLabel, starCountSlider)),
+		widget.NewFormItem("Theme", themeSelect),
+		widget.NewFormItem("UI mode", uiModeSelect),
+		widget.NewFormItem("Backdrop", container.NewGridWithColumns(2, backdropSelect, backdropEntry)),
+		widget.NewFormItem("Background", plainDemoCheck),
+		widget.NewFormItem("Effects quality", qualitySelect),
+		widget.NewFormItem("FPS cap", fpsSelect),
+		widget.NewFormItem("Display", fullScreenCheck),
+		widget.NewFormItem("Level", container.NewBorder(nil, nil, nil, levelButton, levelLabel)),
+		widget.NewFormItem("Tutorial", tutorialButton),
+	)
+	settings = dialog.NewCustom("⚙️ Settings", "Close", form, a.window)
+	settings.Resize(fyne.NewSize(420, 0))
+	settings.Show()
+}
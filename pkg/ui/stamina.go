@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Stamina bar layout (just below the HUD text)
+const (
+	staminaBarWidth  = float32(120)
+	staminaBarHeight = float32(6)
+	staminaBarX      = float32(10)
+	staminaBarY      = float32(30)
+)
+
+// Stamina bar colors
+var (
+	staminaReadyColor     = color.RGBA{R: 80, G: 220, B: 120, A: 230}  // Green while sprinting is available
+	staminaSprintColor    = color.RGBA{R: 120, G: 220, B: 255, A: 230} // Light blue while sprinting
+	staminaExhaustedColor = color.RGBA{R: 230, G: 90, B: 60, A: 230}   // Red until stamina recovers
+)
+
+// staminaBar shows the human's sprint stamina
+type staminaBar struct {
+	background *canvas.Rectangle
+	fill       *canvas.Rectangle
+}
+
+// createStaminaBar builds the stamina bar
+func (a *App) createStaminaBar() *staminaBar {
+	bar := &staminaBar{
+		background: &canvas.Rectangle{
+			FillColor:    color.RGBA{R: 255, G: 255, B: 255, A: 40},
+			StrokeColor:  color.RGBA{R: 255, G: 255, B: 255, A: 120},
+			StrokeWidth:  1,
+			CornerRadius: 2,
+		},
+		fill: &canvas.Rectangle{
+			FillColor:    staminaReadyColor,
+			CornerRadius: 2,
+		},
+	}
+	bar.background.Resize(fyne.NewSize(staminaBarWidth, staminaBarHeight))
+	bar.background.Move(fyne.NewPos(staminaBarX, staminaBarY))
+	bar.fill.Resize(fyne.NewSize(staminaBarWidth, staminaBarHeight))
+	bar.fill.Move(fyne.NewPos(staminaBarX, staminaBarY))
+	return bar
+}
+
+// GetVisualComponents returns the bar's canvas objects
+func (b *staminaBar) GetVisualComponents() []fyne.CanvasObject {
+	return []fyne.CanvasObject{b.background, b.fill}
+}
+
+// updateStaminaBar sizes and colors the stamina bar to match the human
+func (a *App) updateStaminaBar() {
+	if a.staminaBar == nil || a.human == nil {
+		return
+	}
+
+	fill := a.staminaBar.fill
+	fill.FillColor = staminaReadyColor
+	if a.human.IsExhausted() {
+		fill.FillColor = staminaExhaustedColor
+	} else if a.human.IsSprinting {
+		fill.FillColor = staminaSprintColor
+	}
+	fill.Resize(fyne.NewSize(staminaBarWidth*a.human.StaminaFraction(), staminaBarHeight))
+	fill.Refresh()
+}
@@ -0,0 +1,19 @@
+package ui
+
+import "github.com/atyronesmith/bouncing-balls/pkg/physics"
+
+// announceBlackHoles shows a banner (using the wave banner) whenever a black hole drifts into view
+func (a *App) announceBlackHoles() {
+	a.starField.BlackHole.Events = a.events
+	a.events.Subscribe(physics.EventBlackHole, func(physics.Event) {
+		a.announceWave("🕳️ BLACK HOLE AHEAD")
+	})
+}
+
+// watchSupernovae pushes the balls away from every star that goes supernova
+func (a *App) watchSupernovae() {
+	a.starField.Supernova.Events = a.events
+	a.events.Subscribe(physics.EventSupernova, func(event physics.Event) {
+		physics.ApplySupernovaPush(a.balls, event.X, event.Y)
+	})
+}
@@ -0,0 +1,59 @@
+package ui
+
+import "github.com/atyronesmith/bouncing-balls/pkg/physics"
+
+// updateRandomEvents starts the random event that is due, if any, and runs the one in progress.
+// The plain physics demo has no space events.
+func (a *App) updateRandomEvents() {
+	if a.plainDemo {
+		return
+	}
+	switch a.randomEvents.Update(a.storm != nil) {
+	case physics.RandomEventSpaceStorm:
+		a.startStorm()
+	}
+
+	a.updateStorm()
+}
+
+// startStorm announces a space storm and adds its warning columns to the UI
+func (a *App) startStorm() {
+	a.storm = physics.NewSpaceStorm(a.currentBounds)
+	a.storm.Events = a.events
+	for _, component := range a.storm.GetVisualComponents() {
+		a.content.Add(component)
+	}
+	a.announceWave("⚡ SPACE STORM")
+}
+
+// updateStorm lands the storm's bolts, stunning the balls and killing the players they strike,
+// and steers the AI players out of the warned columns
+func (a *App) updateStorm() {
+	if a.storm != nil {
+		a.storm.Update()
+		a.storm.StunBalls(a.balls)
+		for _, human := range a.humans {
+			if a.storm.CheckHuman(human) {
+				a.explodeHuman(human)
+			}
+		}
+		a.addLightning(a.storm.TakePendingBolts())
+
+		if a.storm.IsFinished {
+			a.removeStorm()
+		}
+	}
+
+	a.storm.DodgeHumans(a.humans)
+}
+
+// removeStorm removes the current storm's warning columns from the UI
+func (a *App) removeStorm() {
+	if a.storm == nil {
+		return
+	}
+	for _, component := range a.storm.GetVisualComponents() {
+		a.content.Remove(component)
+	}
+	a.storm = nil
+}
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"math/rand"
+
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// LinkBalls ties two balls together with a spring or rod and adds the tether to the game area
+func (a *App) LinkBalls(first, second *physics.Ball, kind physics.TetherKind) *physics.Tether {
+	tether := physics.NewTether(first, second, kind)
+	a.tethers = append(a.tethers, tether)
+	if a.content != nil {
+		a.content.Add(tether.Line)
+	}
+	return tether
+}
+
+// linkNearestBalls tethers the closest pair of balls that aren't already linked, with a random tether kind
+func (a *App) linkNearestBalls() {
+	var first, second *physics.Ball
+	bestDistance := float32(-1)
+
+	for i := 0; i < len(a.balls); i++ {
+		for j := i + 1; j < len(a.balls); j++ {
+			p, q := a.balls[i], a.balls[j]
+			if p.IsDestroyed || p.IsAbsorbed || q.IsDestroyed || q.IsAbsorbed || a.areLinked(p, q) {
+				continue
+			}
+
+			dx := q.X - p.X
+			dy := q.Y - p.Y
+			if distance := dx*dx + dy*dy; bestDistance < 0 || distance < bestDistance {
+				first, second, bestDistance = p, q, distance
+			}
+		}
+	}
+
+	if first == nil {
+		return
+	}
+	kind := physics.TetherSpring
+	if rand.Intn(2) == 0 {
+		kind = physics.TetherRod
+	}
+	a.LinkBalls(first, second, kind)
+}
+
+// areLinked reports whether an intact tether already joins the two balls
+func (a *App) areLinked(first, second *physics.Ball) bool {
+	for _, tether := range a.tethers {
+		if !tether.IsSevered && tether.Links(first) && tether.Links(second) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateTethers solves every tether, lets bullets cut them and removes severed ones
+func (a *App) updateTethers() {
+	var bullets []*physics.Bullet
+	if a.human != nil {
+		bullets = a.allBullets()
+	}
+
+	for i := len(a.tethers) - 1; i >= 0; i-- {
+		tether := a.tethers[i]
+		tether.Solve()
+		tether.CheckBullets(bullets)
+
+		if tether.IsSevered {
+			if a.content != nil {
+				a.content.Remove(tether.Line)
+			}
+			a.tethers = append(a.tethers[:i], a.tethers[i+1:]...)
+			continue
+		}
+		tether.UpdatePosition()
+	}
+}
+
+// severTethers cuts every tether attached to the ball
+func (a *App) severTethers(ball *physics.Ball) {
+	for _, tether := range a.tethers {
+		if tether.Links(ball) {
+			tether.Sever()
+		}
+	}
+}
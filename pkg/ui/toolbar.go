@@ -0,0 +1,442 @@
+package ui
+
+import (
+	"image/color"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Tooltip layout
+const (
+	tooltipTextSize = float32(12)
+	tooltipPadding  = float32(6)
+	tooltipTop      = float32(4) // gap between the control bar and the tooltip
+)
+
+// ToolbarAction is a command on the control bar, or in its overflow menu when it is moved there or doesn't fit.
+// Subsystems add their own with RegisterAction.
+type ToolbarAction struct {
+	ID       string       // unique key, used to remember where the player put the action
+	Label    string       // button and menu text, emoji first
+	Group    string       // actions of a group sit together; groups appear in the order they were first used
+	Tooltip  string       // shown while the pointer rests on the button
+	Shortcut fyne.KeyName // runs the action with Ctrl (⌘ on macOS) from anywhere ("" = none)
+	Overflow bool         // starts out in the overflow menu rather than on the bar
+	Run      func()
+}
+
+// toolbar is the control bar: registered actions as buttons, grouped and laid out left to right, with an
+// overflow menu (⋯) at the right end holding the actions moved off the bar and those that don't fit
+type toolbar struct {
+	actions    []*ToolbarAction
+	groups     []string                  // group names in the order they were first used
+	buttons    map[string]*toolbarButton // by action ID
+	placement  map[string]bool           // the player's choices: true = on the bar, false = in the menu
+	placeMu    sync.Mutex                // guards placement, which the game loop reads when saving the settings
+	overflowed map[string]bool           // actions that didn't fit on the bar at the last layout
+	onChange   func()                    // called when the player moves an action (to save the placement)
+
+	bar    *fyne.Container // the buttons, group separators and overflow button (see Layout)
+	more   *widget.Button
+	window fyne.Window // for shortcuts, the overflow menu and the customize dialog (nil until attached)
+
+	tipLayer *fyne.Container // tooltip, shown at the top of the game area
+	tipBox   *canvas.Rectangle
+	tipText  *canvas.Text
+}
+
+// newToolbar creates an empty control bar
+func newToolbar() *toolbar {
+	t := &toolbar{
+		buttons:    make(map[string]*toolbarButton),
+		placement:  make(map[string]bool),
+		overflowed: make(map[string]bool),
+	}
+	t.more = widget.NewButton("⋯", t.showOverflow)
+	t.bar = container.New(t, t.more)
+
+	t.tipBox = &canvas.Rectangle{
+		FillColor:    color.RGBA{R: 20, G: 20, B: 30, A: 230},
+		StrokeColor:  color.RGBA{R: 120, G: 200, B: 255, A: 255},
+		StrokeWidth:  1,
+		CornerRadius: 4,
+	}
+	t.tipText = &canvas.Text{Color: color.White, TextSize: tooltipTextSize}
+	t.tipLayer = container.NewWithoutLayout(t.tipBox, t.tipText)
+	t.tipLayer.Hide()
+	return t
+}
+
+// RegisterAction adds an action to the control bar, at the end of its group. Its Run is called on the game
+// loop, whether from the bar, the overflow menu or the shortcut.
+func (a *App) RegisterAction(action ToolbarAction) {
+	if action.Run != nil {
+		action.Run = a.gameLoopFunc(action.Run)
+	}
+	a.toolbar.register(action)
+}
+
+// register adds an action at the end of its group and rebuilds the bar
+func (t *toolbar) register(action ToolbarAction) {
+	if t == nil {
+		return
+	}
+	if _, ok := t.buttons[action.ID]; ok {
+		return // Registered already
+	}
+	registered := &action
+	t.actions = append(t.actions, registered)
+	if !slices.Contains(t.groups, action.Group) {
+		t.groups = append(t.groups, action.Group)
+	}
+	t.buttons[action.ID] = newToolbarButton(t, registered)
+	t.addShortcut(registered)
+	t.rebuild()
+}
+
+// attach ties the toolbar to the window, registering the actions' shortcuts on its canvas
+func (t *toolbar) attach(window fyne.Window) {
+	t.window = window
+	for _, action := range t.actions {
+		t.addShortcut(action)
+	}
+}
+
+// addShortcut makes the action's shortcut run it, once the toolbar has a window
+func (t *toolbar) addShortcut(action *ToolbarAction) {
+	if t.window == nil || action.Shortcut == "" {
+		return
+	}
+	t.window.Canvas().AddShortcut(shortcutFor(action.Shortcut), func(fyne.Shortcut) {
+		action.Run()
+	})
+}
+
+// rebuild puts the buttons back on the bar grouped, with a separator between groups
+func (t *toolbar) rebuild() {
+	var objects []fyne.CanvasObject
+	for i, group := range t.groups {
+		if i > 0 {
+			objects = append(objects, widget.NewSeparator())
+		}
+		for _, action := range t.grouped(group) {
+			objects = append(objects, t.buttons[action.ID])
+		}
+	}
+	t.bar.Objects = append(objects, t.more)
+	t.bar.Refresh()
+}
+
+// grouped returns the group's actions in the order they were registered
+func (t *toolbar) grouped(group string) []*ToolbarAction {
+	var actions []*ToolbarAction
+	for _, action := range t.actions {
+		if action.Group == group {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// SetLabel relabels an action, for actions whose label shows a current setting
+func (t *toolbar) SetLabel(id, label string) {
+	if t == nil {
+		return
+	}
+	button, ok := t.buttons[id]
+	if !ok {
+		return
+	}
+	button.action.Label = label
+	button.SetText(label)
+	t.bar.Refresh() // The button's width may have changed
+}
+
+// onBar reports whether the action belongs on the bar, by the player's choice or else by default
+func (t *toolbar) onBar(action *ToolbarAction) bool {
+	t.placeMu.Lock()
+	onBar, ok := t.placement[action.ID]
+	t.placeMu.Unlock()
+	if ok {
+		return onBar
+	}
+	return !action.Overflow
+}
+
+// Layout places the buttons on the bar left to right, skipping those moved to the overflow menu. From the
+// first button that doesn't fit on, the rest go in the overflow menu too. The overflow button sits at the
+// right end.
+func (t *toolbar) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	if len(objects) == 0 {
+		return
+	}
+	more := objects[len(objects)-1]
+	moreWidth := more.MinSize().Width
+	more.Move(fyne.NewPos(size.Width-moreWidth, 0))
+	more.Resize(fyne.NewSize(moreWidth, size.Height))
+
+	room := size.Width - moreWidth - theme.Padding()
+	x := float32(0)
+	full := false
+	var separator fyne.CanvasObject // separator waiting for the next button placed
+	t.overflowed = make(map[string]bool)
+	for _, object := range objects[:len(objects)-1] {
+		button, ok := object.(*toolbarButton)
+		if !ok {
+			// Group separators only show between two buttons on the bar
+			object.Hide()
+			if x > 0 {
+				separator = object
+			}
+			continue
+		}
+		if !t.onBar(button.action) {
+			button.Hide()
+			continue
+		}
+
+		width := button.MinSize().Width
+		separatorWidth := float32(0)
+		if separator != nil {
+			separatorWidth = separator.MinSize().Width + 2*theme.Padding()
+		}
+		if full || x+separatorWidth+width > room {
+			full = true
+			t.overflowed[button.action.ID] = true
+			button.Hide()
+			continue
+		}
+
+		if separator != nil {
+			separator.Move(fyne.NewPos(x+theme.Padding(), theme.Padding()))
+			separator.Resize(fyne.NewSize(separator.MinSize().Width, size.Height-2*theme.Padding()))
+			separator.Show()
+			x += separatorWidth
+			separator = nil
+		}
+		button.Move(fyne.NewPos(x, 0))
+		button.Resize(fyne.NewSize(width, size.Height))
+		button.Show()
+		x += width
+	}
+}
+
+// MinSize is wide enough for the overflow button (everything else can go in its menu) and as tall as the
+// tallest button
+func (t *toolbar) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	height := float32(0)
+	for _, object := range objects {
+		height = max(height, object.MinSize().Height)
+	}
+	return fyne.NewSize(t.more.MinSize().Width, height)
+}
+
+// showOverflow opens the overflow menu under its button: the actions that aren't on the bar, grouped, and
+// the entry customizing the bar
+func (t *toolbar) showOverflow() {
+	if t.window == nil {
+		return
+	}
+	var items []*fyne.MenuItem
+	for _, group := range t.groups {
+		var groupItems []*fyne.MenuItem
+		for _, action := range t.grouped(group) {
+			if t.onBar(action) && !t.overflowed[action.ID] {
+				continue
+			}
+			item := fyne.NewMenuItem(action.Label, action.Run)
+			if action.Shortcut != "" {
+				item.Shortcut = shortcutFor(action.Shortcut)
+			}
+			groupItems = append(groupItems, item)
+		}
+		if len(groupItems) > 0 && len(items) > 0 {
+			items = append(items, fyne.NewMenuItemSeparator())
+		}
+		items = append(items, groupItems...)
+	}
+	if len(items) > 0 {
+		items = append(items, fyne.NewMenuItemSeparator())
+	}
+	items = append(items, fyne.NewMenuItem("🛠️ Customize toolbar…", t.showCustomize))
+
+	position := fyne.CurrentApp().Driver().AbsolutePositionForObject(t.more)
+	position.Y += t.more.Size().Height
+	widget.ShowPopUpMenuAtPosition(fyne.NewMenu("", items...), t.window.Canvas(), position)
+}
+
+// showCustomize opens a dialog choosing which actions sit on the bar and which in the overflow menu
+func (t *toolbar) showCustomize() {
+	if t.window == nil {
+		return
+	}
+	checks := make(map[string]*widget.Check) // by action ID
+	list := container.NewVBox()
+	for _, group := range t.groups {
+		list.Add(widget.NewLabelWithStyle(group, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+		for _, action := range t.grouped(group) {
+			check := widget.NewCheck(action.Label, nil)
+			check.SetChecked(t.onBar(action))
+			check.OnChanged = func(onBar bool) { // Set after the initial state
+				t.placeMu.Lock()
+				t.placement[action.ID] = onBar
+				t.placeMu.Unlock()
+				t.changed()
+			}
+			checks[action.ID] = check
+			list.Add(check)
+		}
+	}
+
+	resetButton := widget.NewButton("Restore defaults", func() {
+		t.placeMu.Lock()
+		t.placement = make(map[string]bool)
+		t.placeMu.Unlock()
+		for _, action := range t.actions {
+			check := checks[action.ID]
+			onChanged := check.OnChanged
+			check.OnChanged = nil // Don't record the defaults as choices
+			check.SetChecked(!action.Overflow)
+			check.OnChanged = onChanged
+		}
+		t.changed()
+	})
+
+	note := widget.NewLabel("Checked actions sit on the bar; the rest are in the ⋯ menu. Actions that don't fit move to the menu too.")
+	note.Wrapping = fyne.TextWrapWord
+	content := container.NewBorder(note, resetButton, nil, nil, container.NewVScroll(list))
+	customize := dialog.NewCustom("🛠️ Customize toolbar", "Close", content, t.window)
+	customize.Resize(fyne.NewSize(420, 520))
+	customize.Show()
+}
+
+// changed lays the bar out again after the player moved an action and lets the app save it
+func (t *toolbar) changed() {
+	t.bar.Refresh()
+	if t.onChange != nil {
+		t.onChange()
+	}
+}
+
+// Placement returns the player's choices as "id=bar" and "id=menu" entries joined by commas, for saving
+func (t *toolbar) Placement() string {
+	if t == nil {
+		return ""
+	}
+	t.placeMu.Lock()
+	defer t.placeMu.Unlock()
+	var entries []string
+	for id, onBar := range t.placement {
+		if onBar {
+			entries = append(entries, id+"=bar")
+		} else {
+			entries = append(entries, id+"=menu")
+		}
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// SetPlacement restores the player's choices saved by Placement, ignoring malformed entries
+func (t *toolbar) SetPlacement(saved string) {
+	if t == nil {
+		return
+	}
+	placement := make(map[string]bool)
+	for _, entry := range strings.Split(saved, ",") {
+		id, where, ok := strings.Cut(entry, "=")
+		if !ok || (where != "bar" && where != "menu") {
+			continue
+		}
+		placement[id] = where == "bar"
+	}
+	t.placeMu.Lock()
+	t.placement = placement
+	t.placeMu.Unlock()
+	t.bar.Refresh()
+}
+
+// showTip shows the button's tooltip just under it, at the top of the game area
+func (t *toolbar) showTip(button *toolbarButton) {
+	text := button.action.Tooltip
+	if button.action.Shortcut != "" {
+		text += " (" + shortcutText(button.action.Shortcut) + ")"
+	}
+	if text == "" {
+		return
+	}
+	t.tipText.Text = text
+	t.tipText.Refresh()
+
+	size := t.tipText.MinSize()
+	boxSize := fyne.NewSize(size.Width+2*tooltipPadding, size.Height+2*tooltipPadding)
+	driver := fyne.CurrentApp().Driver()
+	x := driver.AbsolutePositionForObject(button).X - driver.AbsolutePositionForObject(t.tipLayer).X
+	x = max(min(x, t.tipLayer.Size().Width-boxSize.Width), 0) // Keep it inside the game area
+
+	t.tipBox.Move(fyne.NewPos(x, tooltipTop))
+	t.tipBox.Resize(boxSize)
+	t.tipText.Move(fyne.NewPos(x+tooltipPadding, tooltipTop+tooltipPadding))
+	t.tipLayer.Show()
+	t.tipLayer.Refresh()
+}
+
+// hideTip hides the tooltip
+func (t *toolbar) hideTip() {
+	t.tipLayer.Hide()
+}
+
+// toolbarButton is an action's button on the bar, showing the action's tooltip while hovered
+type toolbarButton struct {
+	widget.Button
+	action  *ToolbarAction
+	toolbar *toolbar
+}
+
+// newToolbarButton creates the button running the action
+func newToolbarButton(t *toolbar, action *ToolbarAction) *toolbarButton {
+	button := &toolbarButton{action: action, toolbar: t}
+	button.Text = action.Label
+	button.OnTapped = func() {
+		t.hideTip()
+		action.Run()
+	}
+	button.ExtendBaseWidget(button)
+	return button
+}
+
+// MouseIn highlights the button and shows its tooltip
+func (b *toolbarButton) MouseIn(event *desktop.MouseEvent) {
+	b.Button.MouseIn(event)
+	b.toolbar.showTip(b)
+}
+
+// MouseOut takes the highlight and the tooltip away
+func (b *toolbarButton) MouseOut() {
+	b.Button.MouseOut()
+	b.toolbar.hideTip()
+}
+
+// shortcutFor returns the Ctrl (⌘ on macOS) shortcut for a key
+func shortcutFor(key fyne.KeyName) *desktop.CustomShortcut {
+	return &desktop.CustomShortcut{KeyName: key, Modifier: fyne.KeyModifierShortcutDefault}
+}
+
+// shortcutText returns how a key's shortcut is written in tooltips
+func shortcutText(key fyne.KeyName) string {
+	if runtime.GOOS == "darwin" {
+		return "⌘" + string(key)
+	}
+	return "Ctrl+" + string(key)
+}
@@ -0,0 +1,279 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// Tutorial layout and pacing
+const (
+	tutorialPanelWidth   = float32(460)
+	tutorialPanelHeight  = float32(96)
+	tutorialPanelMargin  = float32(16)  // gap between the panel and the bottom of the game area
+	tutorialReadFrames   = 90           // every step stays up at least this long (1.5 seconds)
+	tutorialMoveDistance = float32(120) // how far the eye has to be driven to finish the movement step
+	highlightPadding     = float32(10)  // gap between a highlighted region and its outline
+)
+
+// prefTutorialDone is the preference key recording that the tutorial was finished or skipped
+const prefTutorialDone = "tutorial_done"
+
+// tutorial is the guided tutorial in progress: a scripted sequence of prompts, each waiting for the player
+// to try what it describes, with the region it talks about outlined
+type tutorial struct {
+	sequence  *physics.Sequence
+	panel     *fyne.Container   // prompt panel at the bottom of the game area
+	prompt    *widget.Label     // current step's prompt
+	progress  *canvas.Text      // "Step 2 of 6"
+	highlight *canvas.Rectangle // pulsing outline around the step's region
+	pulse     int               // frames since the tutorial started, for the outline's pulse
+
+	wallMode physics.WallMode                // wall mode to restore afterwards
+	lastPos  map[*physics.Ball]fyne.Position // ball positions last frame, to spot wrap-arounds
+}
+
+// StartTutorial clears the arena for a classic game and starts the guided tutorial
+func (a *App) StartTutorial() {
+	a.stopTutorial()
+	a.SetGameMode(physics.GameClassic)
+	a.removeAllBalls()
+
+	a.tutorial = &tutorial{wallMode: a.world.WallMode}
+	a.tutorial.sequence = physics.NewSequence(a.tutorialSteps()...)
+	a.createTutorialPanel()
+}
+
+// tutorialSteps scripts the tutorial: movement, shooting, the dragon and wrap-around walls
+func (a *App) tutorialSteps() []physics.SequenceStep {
+	var startX, startY float32 // where the eye was when the movement step started
+	var shotsHit int           // hits when the shooting step started
+	wrapped := false
+
+	return []physics.SequenceStep{
+		{
+			Prompt:    "👋 Welcome to Bouncing Balls! This tutorial walks you through the basics. Each step waits for you to try it.",
+			MinFrames: 240,
+		},
+		{
+			Prompt: "🕹️ Press M to take control of your eye, then drive it around with WASD or the arrow keys.",
+			Enter: func() {
+				startX, startY = a.human.X, a.human.Y
+			},
+			Done: func() bool {
+				dx, dy := a.human.X-startX, a.human.Y-startY
+				return a.human.Control == physics.ControlManual && dx*dx+dy*dy > tutorialMoveDistance*tutorialMoveDistance
+			},
+			Highlight: a.humanRegion,
+			MinFrames: tutorialReadFrames,
+		},
+		{
+			Prompt: "🔫 Your eye fires at its target by itself. Press Tab to lock onto a ball and 1-6 to switch weapons. Hit this one!",
+			Enter: func() {
+				shotsHit = a.human.ShotsHit
+				a.SpawnBall(BallOptions{X: a.currentBounds.Width * 0.75, Y: a.currentBounds.Height / 2, VX: 0.6, VY: 0.4})
+			},
+			Done: func() bool {
+				return a.human.ShotsHit > shotsHit
+			},
+			Highlight: a.firstBallRegion,
+			MinFrames: tutorialReadFrames,
+		},
+		{
+			Prompt: "🐉 The dragon guards you and hunts the balls. Walk up to it and press E to ride it (E again to climb off).",
+			Enter: func() {
+				a.SetDragonEnabled(true)
+			},
+			Done: func() bool {
+				return a.human.IsRiding()
+			},
+			Highlight: a.dragonRegion,
+			MinFrames: tutorialReadFrames,
+		},
+		{
+			Prompt: "🌀 With 🌀 Wrap walls, balls leaving one edge come back in on the opposite side. Watch one go through!",
+			Enter: func() {
+				a.SetWallMode(physics.WallWrap)
+				a.SpawnBall(BallOptions{X: a.currentBounds.Width / 2, Y: a.currentBounds.Height / 4, VX: 4, VY: 0.5})
+				a.SpawnBall(BallOptions{X: a.currentBounds.Width / 2, Y: a.currentBounds.Height * 3 / 4, VX: -4, VY: -0.5})
+			},
+			Done: func() bool {
+				wrapped = wrapped || a.ballWrapped()
+				return wrapped
+			},
+			Highlight: func() (fyne.Position, fyne.Size) {
+				return fyne.NewPos(0, 0), a.currentBounds // The arena's edges
+			},
+			MinFrames: tutorialReadFrames,
+		},
+		{
+			Prompt:    "🎉 That's it! Pick game modes and difficulty in ⚙️ Settings, and replay this tutorial from there. Have fun!",
+			MinFrames: 240,
+		},
+	}
+}
+
+// createTutorialPanel builds the prompt panel and the highlight outline
+func (a *App) createTutorialPanel() {
+	t := a.tutorial
+	left := (a.currentBounds.Width - tutorialPanelWidth) / 2
+	top := a.currentBounds.Height - tutorialPanelHeight - tutorialPanelMargin
+
+	background := &canvas.Rectangle{
+		FillColor:    color.RGBA{R: 10, G: 10, B: 40, A: 220},
+		StrokeColor:  color.RGBA{R: 120, G: 200, B: 255, A: 255},
+		StrokeWidth:  2,
+		CornerRadius: 10,
+	}
+	background.Resize(fyne.NewSize(tutorialPanelWidth, tutorialPanelHeight))
+	background.Move(fyne.NewPos(left, top))
+
+	t.prompt = widget.NewLabel("")
+	t.prompt.Wrapping = fyne.TextWrapWord
+	t.prompt.Resize(fyne.NewSize(tutorialPanelWidth-16, tutorialPanelHeight-36))
+	t.prompt.Move(fyne.NewPos(left+8, top+4))
+
+	t.progress = &canvas.Text{
+		Color:    color.RGBA{R: 180, G: 200, B: 220, A: 255},
+		TextSize: 12,
+	}
+	t.progress.Move(fyne.NewPos(left+14, top+tutorialPanelHeight-26))
+
+	skipButton := widget.NewButton("Skip tutorial", a.gameLoopFunc(a.finishTutorial))
+	skipButton.Resize(fyne.NewSize(120, 28))
+	skipButton.Move(fyne.NewPos(left+tutorialPanelWidth-128, top+tutorialPanelHeight-34))
+
+	t.highlight = &canvas.Rectangle{
+		FillColor:    color.Transparent,
+		StrokeWidth:  3,
+		CornerRadius: 8,
+	}
+	t.highlight.Hide()
+
+	t.panel = container.NewWithoutLayout(t.highlight, background, t.prompt, t.progress, skipButton)
+	t.panel.Resize(a.currentBounds)
+	a.content.Add(t.panel)
+}
+
+// updateTutorial runs the tutorial's script, refreshing the prompt when a step starts and keeping the
+// outline on the step's region
+func (a *App) updateTutorial() {
+	t := a.tutorial
+	if t == nil {
+		return
+	}
+
+	if t.sequence.Update() {
+		step, ok := t.sequence.Current()
+		if !ok {
+			a.finishTutorial()
+			return
+		}
+		t.prompt.SetText(step.Prompt)
+		t.progress.Text = fmt.Sprintf("Step %d of %d", t.sequence.Index()+1, len(t.sequence.Steps))
+		t.progress.Refresh()
+	}
+	a.trackBallPositions()
+
+	// Outline the region the step talks about, pulsing gently
+	t.pulse++
+	step, _ := t.sequence.Current()
+	if step.Highlight == nil {
+		t.highlight.Hide()
+		return
+	}
+	pos, size := step.Highlight()
+	if size.Width == 0 || size.Height == 0 {
+		t.highlight.Hide()
+		return
+	}
+	alpha := uint8(160 + 95*math.Sin(float64(t.pulse)*0.1))
+	t.highlight.StrokeColor = color.RGBA{R: 255, G: 220, B: 60, A: alpha}
+	t.highlight.Move(fyne.NewPos(pos.X-highlightPadding, pos.Y-highlightPadding))
+	t.highlight.Resize(fyne.NewSize(size.Width+2*highlightPadding, size.Height+2*highlightPadding))
+	t.highlight.Show()
+	t.highlight.Refresh()
+}
+
+// finishTutorial ends the tutorial, remembers not to start it again at launch and starts a fresh game
+func (a *App) finishTutorial() {
+	if a.tutorial == nil {
+		return
+	}
+	a.stopTutorial()
+	a.fyneApp.Preferences().SetBool(prefTutorialDone, true)
+	a.resetAll()
+}
+
+// stopTutorial takes the tutorial's panel down and restores the wall mode it changed
+func (a *App) stopTutorial() {
+	t := a.tutorial
+	if t == nil {
+		return
+	}
+	a.tutorial = nil
+	a.content.Remove(t.panel)
+	a.SetWallMode(t.wallMode)
+}
+
+// startTutorialOnFirstLaunch starts the tutorial unless it has been finished or skipped before
+func (a *App) startTutorialOnFirstLaunch() {
+	if !a.fyneApp.Preferences().BoolWithFallback(prefTutorialDone, false) {
+		a.StartTutorial()
+	}
+}
+
+// trackBallPositions remembers where every ball is, for ballWrapped next frame
+func (a *App) trackBallPositions() {
+	t := a.tutorial
+	t.lastPos = make(map[*physics.Ball]fyne.Position, len(a.balls))
+	for _, ball := range a.balls {
+		t.lastPos[ball] = fyne.NewPos(ball.X, ball.Y)
+	}
+}
+
+// ballWrapped reports whether a ball jumped across the arena since last frame, i.e. wrapped around an edge
+func (a *App) ballWrapped() bool {
+	for _, ball := range a.balls {
+		last, ok := a.tutorial.lastPos[ball]
+		if !ok {
+			continue
+		}
+		if math.Abs(float64(ball.X-last.X)) > float64(a.currentBounds.Width/2) ||
+			math.Abs(float64(ball.Y-last.Y)) > float64(a.currentBounds.Height/2) {
+			return true
+		}
+	}
+	return false
+}
+
+// humanRegion returns the area around player 1
+func (a *App) humanRegion() (fyne.Position, fyne.Size) {
+	size := a.human.Size
+	return fyne.NewPos(a.human.X-size/2, a.human.Y-size/2), fyne.NewSize(size, size)
+}
+
+// firstBallRegion returns the area around the first live ball, or a zero size if there is none
+func (a *App) firstBallRegion() (fyne.Position, fyne.Size) {
+	for _, ball := range a.balls {
+		if !ball.IsDestroyed {
+			return fyne.NewPos(ball.X-ball.Radius, ball.Y-ball.Radius), fyne.NewSize(ball.Radius*2, ball.Radius*2)
+		}
+	}
+	return fyne.Position{}, fyne.Size{}
+}
+
+// dragonRegion returns the area around the dragon, or a zero size while it is hidden
+func (a *App) dragonRegion() (fyne.Position, fyne.Size) {
+	if a.dragon == nil || a.dragonOff {
+		return fyne.Position{}, fyne.Size{}
+	}
+	size := a.dragon.Size
+	return fyne.NewPos(a.dragon.X-size/2, a.dragon.Y-size/2), fyne.NewSize(size, size)
+}
@@ -0,0 +1,241 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// VictoryStats summarizes a cleared round
+type VictoryStats struct {
+	Round      int     // round that was cleared
+	Score      int     // points earned this round
+	Deaths     int     // deaths this round
+	ShotsFired int     // bullets fired this round
+	ShotsHit   int     // bullets that hit a ball this round
+	Seconds    float32 // time taken to clear the round
+}
+
+// Accuracy returns the fraction of shots that hit, from 0 to 1
+func (s VictoryStats) Accuracy() float32 {
+	if s.ShotsFired == 0 {
+		return 0
+	}
+	return float32(s.ShotsHit) / float32(s.ShotsFired)
+}
+
+// roundSnapshot records the players' combined running totals
+type roundSnapshot struct {
+	score, deaths, shotsFired, shotsHit int
+}
+
+// victoryPanel is the overlay shown after every ball is destroyed
+type victoryPanel struct {
+	container  *fyne.Container
+	title      *canvas.Text
+	statLines  []*canvas.Text
+	nextButton *widget.Button
+}
+
+// Victory panel layout
+const (
+	victoryPanelWidth  = float32(360)
+	victoryPanelHeight = float32(250)
+	victoryStatLines   = 5
+)
+
+// SetVictoryEnabled turns the clear-all-balls victory condition on or off
+func (a *App) SetVictoryEnabled(enabled bool) {
+	a.victoryEnabled = enabled
+}
+
+// SetOnVictory sets a hook called when a round is cleared (e.g. to play a fanfare)
+func (a *App) SetOnVictory(onVictory func(stats VictoryStats)) {
+	a.onVictory = onVictory
+}
+
+// createVictoryPanel builds the hidden victory overlay centered in the game area
+func (a *App) createVictoryPanel(gameArea fyne.Size) *victoryPanel {
+	left := (gameArea.Width - victoryPanelWidth) / 2
+	top := (gameArea.Height - victoryPanelHeight) / 2
+
+	background := &canvas.Rectangle{
+		FillColor:    color.RGBA{R: 10, G: 10, B: 40, A: 220},   // Deep space blue
+		StrokeColor:  color.RGBA{R: 255, G: 200, B: 50, A: 255}, // Gold border
+		StrokeWidth:  3,
+		CornerRadius: 12,
+	}
+	background.Resize(fyne.NewSize(victoryPanelWidth, victoryPanelHeight))
+	background.Move(fyne.NewPos(left, top))
+
+	panel := &victoryPanel{}
+	panel.title = &canvas.Text{
+		Text:      "🏆 VICTORY! 🏆",
+		Color:     color.RGBA{R: 255, G: 200, B: 50, A: 255},
+		TextSize:  28,
+		TextStyle: fyne.TextStyle{Bold: true},
+		Alignment: fyne.TextAlignCenter,
+	}
+	panel.title.Resize(fyne.NewSize(victoryPanelWidth, 40))
+	panel.title.Move(fyne.NewPos(left, top+12))
+
+	objects := []fyne.CanvasObject{background, panel.title}
+	for i := 0; i < victoryStatLines; i++ {
+		line := &canvas.Text{
+			Color:     color.RGBA{R: 255, G: 255, B: 255, A: 230},
+			TextSize:  15,
+			TextStyle: fyne.TextStyle{Monospace: true},
+			Alignment: fyne.TextAlignCenter,
+		}
+		line.Resize(fyne.NewSize(victoryPanelWidth, 22))
+		line.Move(fyne.NewPos(left, top+62+float32(i)*24))
+		panel.statLines = append(panel.statLines, line)
+		objects = append(objects, line)
+	}
+
+	panel.nextButton = widget.NewButton("Next Round ▶", a.gameLoopFunc(a.continueFromVictory))
+	panel.nextButton.Resize(fyne.NewSize(160, 36))
+	panel.nextButton.Move(fyne.NewPos(left+(victoryPanelWidth-160)/2, top+victoryPanelHeight-50))
+	objects = append(objects, panel.nextButton)
+
+	panel.container = container.NewWithoutLayout(objects...)
+	panel.container.Resize(gameArea)
+	panel.container.Hide()
+
+	return panel
+}
+
+// show fills in the stats and shows the panel
+func (p *victoryPanel) show(stats VictoryStats) {
+	p.title.Text = fmt.Sprintf("🏆 ROUND %d CLEARED! 🏆", stats.Round)
+	p.title.Refresh()
+
+	minutes := int(stats.Seconds) / 60
+	seconds := int(stats.Seconds) % 60
+	lines := []string{
+		fmt.Sprintf("Score:     %6d", stats.Score),
+		fmt.Sprintf("Time:      %3d:%02d", minutes, seconds),
+		fmt.Sprintf("Accuracy:  %5.1f%%", stats.Accuracy()*100),
+		fmt.Sprintf("Deaths:    %6d", stats.Deaths),
+		"Next round: +1 ball",
+	}
+	p.nextButton.SetText("Next Round ▶")
+	p.setLines(lines)
+	p.container.Show()
+}
+
+// showResult shows a game mode's final results, offering a new game instead of the next round
+func (p *victoryPanel) showResult(title string, lines []string) {
+	p.title.Text = title
+	p.title.Refresh()
+	p.nextButton.SetText("Play Again ▶")
+	p.setLines(lines)
+	p.container.Show()
+}
+
+// setLines fills the stat lines in order, blanking any left over
+func (p *victoryPanel) setLines(lines []string) {
+	for i, line := range p.statLines {
+		line.Text = ""
+		if i < len(lines) {
+			line.Text = lines[i]
+		}
+		line.Refresh()
+	}
+}
+
+// hide hides the panel
+func (p *victoryPanel) hide() {
+	p.container.Hide()
+}
+
+// checkVictory starts the victory flow once every ball in the round has been destroyed
+func (a *App) checkVictory() {
+	// Wave mode and boss fights have their own clear conditions, and the tutorial has none
+	if !a.victoryEnabled || a.isVictory || a.human == nil || a.waves.IsActive || a.bossFight != nil || a.tutorial != nil {
+		return
+	}
+	a.roundFrames++
+
+	if len(a.balls) > 0 {
+		return
+	}
+	if a.gameMode.Rules().EndOnClear {
+		a.endGame(true) // Time trial: the clock stops with the last ball
+		return
+	}
+	a.startVictory()
+}
+
+// startVictory plays the celebration, shows the stats and calls the victory hook
+func (a *App) startVictory() {
+	a.isVictory = true
+
+	totals := a.teamTotals()
+	stats := VictoryStats{
+		Round:      a.round,
+		Score:      totals.score - a.roundStart.score,
+		Deaths:     totals.deaths - a.roundStart.deaths,
+		ShotsFired: totals.shotsFired - a.roundStart.shotsFired,
+		ShotsHit:   totals.shotsHit - a.roundStart.shotsHit,
+		Seconds:    float32(a.roundFrames) / 60,
+	}
+
+	if a.celebration != nil {
+		a.celebration.Start()
+	}
+	if a.victoryScreen != nil {
+		a.victoryScreen.show(stats)
+	}
+	if a.onVictory != nil {
+		a.onVictory(stats)
+	}
+}
+
+// nextRound starts the next round with one more ball than the last
+func (a *App) nextRound() {
+	a.round++
+	a.startRound()
+
+	// Each cleared round unlocks one extra (random kind) ball
+	for i := 1; i < a.round; i++ {
+		a.SpawnBall(BallOptions{Kind: physics.RandomBallKind()})
+	}
+}
+
+// startRound clears any victory state, spawns the starter balls, refills the weapons and snapshots the stats
+func (a *App) startRound() {
+	a.isVictory = false
+	if a.celebration != nil {
+		a.celebration.Stop()
+	}
+	if a.victoryScreen != nil {
+		a.victoryScreen.hide()
+	}
+
+	a.removeAllBalls()
+	a.spawnInitialBalls()
+
+	a.roundFrames = 0
+	for _, human := range a.humans {
+		human.RefillWeapons()
+	}
+	a.roundStart = a.teamTotals()
+}
+
+// teamTotals adds up every player's running totals
+func (a *App) teamTotals() roundSnapshot {
+	var totals roundSnapshot
+	for _, human := range a.humans {
+		totals.score += human.Score
+		totals.deaths += human.Deaths
+		totals.shotsFired += human.ShotsFired
+		totals.shotsHit += human.ShotsHit
+	}
+	return totals
+}
@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"github.com/atyronesmith/bouncing-balls/pkg/physics"
+)
+
+// waveBannerFrames is how long a wave announcement stays on screen (2 seconds)
+const waveBannerFrames = 120
+
+// SetWaveMode switches between escalating waves and the normal clear-all-balls rounds
+func (a *App) SetWaveMode(enabled bool) {
+	if enabled == a.waves.IsActive {
+		return
+	}
+
+	if enabled {
+		a.startWaves()
+		return
+	}
+
+	a.waves.Stop()
+	a.hideWaveBanner()
+	a.startRound()
+}
+
+// startWaves clears the arena and starts wave 1
+func (a *App) startWaves() {
+	a.startRound() // Clears any victory screen and snapshots the stats
+	a.removeAllBalls()
+	a.waves.Start()
+}
+
+// updateWaves spawns the balls the current wave asks for
+func (a *App) updateWaves() {
+	for _, spawn := range a.waves.Update(len(a.balls)) {
+		angle := rand.Float64() * 2 * math.Pi
+		a.SpawnBall(BallOptions{
+			VX:     float32(math.Cos(angle)) * spawn.Speed,
+			VY:     float32(math.Sin(angle)) * spawn.Speed,
+			Radius: spawn.Radius,
+			Kind:   spawn.Kind,
+		})
+	}
+
+	// Fade out the wave announcement
+	if a.waveBannerTimer > 0 {
+		a.waveBannerTimer--
+		if a.waveBannerTimer == 0 {
+			a.hideWaveBanner()
+		} else if a.waveBannerTimer < 30 {
+			a.waveBanner.Color = color.RGBA{R: 120, G: 200, B: 255, A: uint8(255 * a.waveBannerTimer / 30)}
+			a.waveBanner.Refresh()
+		}
+	}
+}
+
+// createWaveBanner builds the (hidden) wave announcement text and subscribes it to wave events
+// (a cleared wave also gets a burst of confetti)
+func (a *App) createWaveBanner(gameArea fyne.Size) {
+	a.waveBanner = &canvas.Text{
+		Color:     color.RGBA{R: 120, G: 200, B: 255, A: 255},
+		TextSize:  36,
+		TextStyle: fyne.TextStyle{Bold: true},
+		Alignment: fyne.TextAlignCenter,
+	}
+	a.waveBanner.Resize(fyne.NewSize(gameArea.Width, 50))
+	a.waveBanner.Move(fyne.NewPos(0, gameArea.Height/3))
+	a.waveBanner.Hide()
+
+	a.events.Subscribe(physics.EventWaveStarted, func(event physics.Event) {
+		a.announceWave(fmt.Sprintf("🌊 WAVE %d", event.Value))
+	})
+	a.events.Subscribe(physics.EventWaveCleared, func(event physics.Event) {
+		a.announceWave(fmt.Sprintf("✅ WAVE %d CLEARED", event.Value))
+		a.burstConfetti()
+	})
+}
+
+// announceWave shows the wave banner with the given text
+func (a *App) announceWave(text string) {
+	if a.waveBanner == nil {
+		return
+	}
+
+	a.waveBanner.Text = text
+	a.waveBanner.Color = color.RGBA{R: 120, G: 200, B: 255, A: 255}
+	a.waveBanner.Show()
+	a.waveBanner.Refresh()
+	a.waveBannerTimer = waveBannerFrames
+}
+
+// hideWaveBanner hides the wave announcement
+func (a *App) hideWaveBanner() {
+	a.waveBannerTimer = 0
+	if a.waveBanner != nil {
+		a.waveBanner.Hide()
+	}
+}